@@ -0,0 +1,261 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package beater
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"sync"
+
+	"github.com/njcx/libbeat_v7/api"
+	"github.com/njcx/libbeat_v7/common/atomic"
+	"github.com/njcx/libbeat_v7/logp"
+
+	"github.com/njcx/packetbeat7_dpdk/clock"
+	"github.com/njcx/packetbeat7_dpdk/config"
+	"github.com/njcx/packetbeat7_dpdk/protos"
+	"github.com/njcx/packetbeat7_dpdk/sniffer"
+)
+
+// bpfFilterAPI is the path of the HTTP endpoint listing and replacing the
+// BPF filters of the running sniffers. The endpoint is served by the HTTP
+// server of the beat, if enabled (http.enabled). The HTTP server registers
+// its handlers before the beat is configured, so the handler is always
+// registered and answers only if enabled by packetbeat.bpf_filter_api.
+const bpfFilterAPI = "/bpf_filter"
+
+var filterAPIEnabled atomic.Bool
+
+func init() {
+	if err := api.AddHandlerFunc(bpfFilterAPI, handleBPFFilter); err != nil {
+		panic(err)
+	}
+}
+
+var errUnknownInterface = errors.New("no sniffer captures on this interface")
+
+// filterTarget is the sniffer of an interface together with the protocols
+// its default BPF filter is derived from.
+type filterTarget struct {
+	name      string
+	sniffer   *sniffer.Sniffer
+	protocols *protos.ProtocolsStruct
+}
+
+// interfaceFilter is the BPF filter of the sniffer of an interface, as
+// reported and accepted by the HTTP endpoint.
+type interfaceFilter struct {
+	Interface string `json:"interface,omitempty"`
+	Filter    string `json:"filter"`
+}
+
+// Filters returns the BPF filters of the sniffers.
+func (p *processor) Filters() []interfaceFilter {
+	filters := make([]interfaceFilter, len(p.filters))
+	for i, t := range p.filters {
+		filters[i] = interfaceFilter{Interface: t.name, Filter: t.sniffer.Filter()}
+	}
+	return filters
+}
+
+// SetFilter replaces the BPF filter of the sniffer capturing on the named
+// interface, or of all sniffers if name is empty.
+func (p *processor) SetFilter(name, expr string) error {
+	var (
+		targets []filterTarget
+		filters []string
+	)
+	for _, t := range p.filters {
+		if name == "" || t.name == name {
+			targets = append(targets, t)
+			filters = append(filters, expr)
+		}
+	}
+	if len(targets) == 0 {
+		return errUnknownInterface
+	}
+	return setFilters(targets, filters)
+}
+
+// applyFilters replaces the BPF filters of the sniffers with the ones of
+// cfg. cfg must only differ from the configuration the processor has been
+// created with in its filters.
+func (p *processor) applyFilters(cfg config.Config) error {
+	ifaces := cfg.InterfaceConfigs()
+	if len(ifaces) != len(p.filters) {
+		return fmt.Errorf("expected %d interfaces, got %d", len(p.filters), len(ifaces))
+	}
+
+	filters := make([]string, len(ifaces))
+	for i, iface := range ifaces {
+		filter, err := snifferFilter(cfg, iface, p.filters[i].protocols)
+		if err != nil {
+			return err
+		}
+		filters[i] = filter
+	}
+	return setFilters(p.filters, filters)
+}
+
+// setFilters sets the filters of all targets, or none. If a filter can not
+// be applied, the filters already replaced are restored.
+func setFilters(targets []filterTarget, filters []string) error {
+	previous := make([]string, len(targets))
+	for i, t := range targets {
+		previous[i] = t.sniffer.Filter()
+		if err := t.sniffer.SetFilter(filters[i]); err != nil {
+			for j := i - 1; j >= 0; j-- {
+				if restoreErr := targets[j].sniffer.SetFilter(previous[j]); restoreErr != nil {
+					logp.Err("Failed to restore BPF filter of %s: %v", targets[j].name, restoreErr)
+				}
+			}
+			if t.name != "" {
+				return fmt.Errorf("interface %s: %v", t.name, err)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// processorSet holds the running processors, whose filters can be changed
+// through the HTTP endpoint. The global settings of the processors are
+// applied as processors are started and stopped, such that processors
+// created only to check a config leave them alone.
+type processorSet struct {
+	mu         sync.Mutex
+	processors []*processor
+}
+
+var liveProcessors processorSet
+
+func (s *processorSet) add(p *processor) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.processors = append(s.processors, p)
+	s.apply()
+}
+
+func (s *processorSet) remove(p *processor) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, other := range s.processors {
+		if other == p {
+			s.processors = append(s.processors[:i], s.processors[i+1:]...)
+			s.apply()
+			return
+		}
+	}
+}
+
+// apply enables the HTTP endpoint and the virtual clock if any of the
+// processors does. s.mu must be held.
+func (s *processorSet) apply() {
+	filterAPI, virtualClock := false, false
+	for _, p := range s.processors {
+		filterAPI = filterAPI || p.filterAPI
+		virtualClock = virtualClock || p.virtualClock
+	}
+	filterAPIEnabled.Store(filterAPI)
+	clock.SetVirtual(virtualClock)
+}
+
+func (s *processorSet) list() []*processor {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*processor(nil), s.processors...)
+}
+
+// handleBPFFilter lists the BPF filters of the running sniffers on GET. A
+// POST or PUT of {"interface": "eth0", "filter": "tcp port 80"} replaces
+// the filter of the sniffer capturing on eth0, or of all sniffers if no
+// interface is given. Requests must be sent as application/json.
+func handleBPFFilter(w http.ResponseWriter, r *http.Request) {
+	if !filterAPIEnabled.Load() {
+		writeFilterError(w, http.StatusNotFound, errors.New("endpoint disabled, see packetbeat.bpf_filter_api.enabled"))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPost, http.MethodPut:
+		if typ, _, err := mime.ParseMediaType(r.Header.Get("Content-Type")); err != nil || typ != "application/json" {
+			writeFilterError(w, http.StatusUnsupportedMediaType, errors.New("Content-Type must be application/json"))
+			return
+		}
+		var req interfaceFilter
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeFilterError(w, http.StatusBadRequest, fmt.Errorf("invalid request: %v", err))
+			return
+		}
+		if status, err := setLiveFilter(req.Interface, req.Filter); err != nil {
+			writeFilterError(w, status, err)
+			return
+		}
+	default:
+		w.Header().Set("Allow", "GET, POST, PUT")
+		writeFilterError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return
+	}
+
+	filters := []interfaceFilter{}
+	for _, p := range liveProcessors.list() {
+		filters = append(filters, p.Filters()...)
+	}
+	writeFilterResponse(w, http.StatusOK, map[string]interface{}{"filters": filters})
+}
+
+// setLiveFilter sets the filter of the named interface on all running
+// processors and returns the HTTP status reporting the result.
+func setLiveFilter(name, expr string) (int, error) {
+	processors := liveProcessors.list()
+	if len(processors) == 0 {
+		return http.StatusServiceUnavailable, errors.New("no sniffers running")
+	}
+
+	found := false
+	for _, p := range processors {
+		err := p.SetFilter(name, expr)
+		if err == errUnknownInterface {
+			continue
+		}
+		if err != nil {
+			return http.StatusBadRequest, err
+		}
+		found = true
+	}
+	if !found {
+		return http.StatusNotFound, fmt.Errorf("no sniffer captures on interface %s", name)
+	}
+	return http.StatusOK, nil
+}
+
+func writeFilterError(w http.ResponseWriter, status int, err error) {
+	writeFilterResponse(w, status, map[string]interface{}{"error": err.Error()})
+}
+
+func writeFilterResponse(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		logp.Debug("main", "Failed to write %s response: %v", bpfFilterAPI, err)
+	}
+}
@@ -0,0 +1,79 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !integration
+// +build !integration
+
+package beater
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/njcx/packetbeat7_dpdk/clock"
+)
+
+func TestHandleBPFFilter(t *testing.T) {
+	request := func(method, contentType string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, bpfFilterAPI, strings.NewReader(`{"filter": "tcp port 80"}`))
+		if contentType != "" {
+			r.Header.Set("Content-Type", contentType)
+		}
+		w := httptest.NewRecorder()
+		handleBPFFilter(w, r)
+		return w
+	}
+
+	// disabled by default
+	assert.Equal(t, http.StatusNotFound, request(http.MethodGet, "").Code)
+	assert.Equal(t, http.StatusNotFound, request(http.MethodPut, "application/json").Code)
+
+	filterAPIEnabled.Store(true)
+	defer filterAPIEnabled.Store(false)
+
+	w := request(http.MethodGet, "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"filters": []}`, w.Body.String())
+
+	assert.Equal(t, http.StatusUnsupportedMediaType, request(http.MethodPut, "").Code)
+	assert.Equal(t, http.StatusUnsupportedMediaType, request(http.MethodPost, "text/plain").Code)
+	assert.Equal(t, http.StatusServiceUnavailable, request(http.MethodPut, "application/json; charset=utf-8").Code)
+	assert.Equal(t, http.StatusMethodNotAllowed, request(http.MethodDelete, "").Code)
+}
+
+func TestProcessorSetSettings(t *testing.T) {
+	var set processorSet
+	running := &processor{filterAPI: true, virtualClock: true}
+	checked := &processor{}
+
+	set.add(running)
+	assert.True(t, filterAPIEnabled.Load())
+	assert.True(t, clock.Virtual())
+
+	// processors only created to check a config are never added
+	set.remove(checked)
+	assert.True(t, filterAPIEnabled.Load())
+	assert.True(t, clock.Virtual())
+
+	set.remove(running)
+	assert.False(t, filterAPIEnabled.Load())
+	assert.False(t, clock.Virtual())
+}
@@ -49,8 +49,6 @@ type flags struct {
 	file       *string
 	loop       *int
 	oneAtAtime *bool
-	dpdkPort   *string
-	dpdkStatus *string
 	topSpeed   *bool
 	dumpfile   *string
 }
@@ -59,13 +57,11 @@ var CmdLineArgs flags
 
 func init() {
 	CmdLineArgs = flags{
-		file:       flag.String("I", "", "Read packet data from specified file"),
+		file:       flag.String("I", "", "Read packet data from specified pcap or pcapng file, directory or glob pattern"),
 		loop:       flag.Int("l", 1, "Loop file. 0 - loop forever"),
-		dpdkStatus: flag.String("dpdk_status", "", "Set dpdk status,  enable, disable or nil"),
-		dpdkPort:   flag.String("dpdk_port", "", "Set dpdk port"),
 		oneAtAtime: flag.Bool("O", false, "Read packets one at a time (press Enter)"),
 		topSpeed:   flag.Bool("t", false, "Read packets as fast as possible, without sleeping"),
-		dumpfile:   flag.String("dump", "", "Write all captured packets to this libpcap file"),
+		dumpfile:   flag.String("dump", "", "Write all captured packets to this pcap file, see packetbeat.dump for rotating dumps"),
 	}
 }
 
@@ -131,12 +127,24 @@ func (pb *packetbeat) runStatic(b *beat.Beat, factory *processorFactory) error {
 
 	logp.Debug("main", "Waiting for the runner to finish")
 
-	select {
-	case <-pb.done:
-	case err := <-factory.err:
-		close(pb.done)
-		return err
+	// Sniffers reading files finish at the end of their file. The beat stops
+	// once all sniffers have finished or the first one has failed.
+	remaining := 1
+	if p, ok := runner.(*processor); ok {
+		remaining = len(p.sniffers)
+	}
+	for ; remaining > 0; remaining-- {
+		select {
+		case <-pb.done:
+			return nil
+		case err := <-factory.err:
+			if err != nil {
+				close(pb.done)
+				return err
+			}
+		}
 	}
+	close(pb.done)
 	return nil
 }
 
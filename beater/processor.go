@@ -19,6 +19,7 @@ package beater
 
 import (
 	"fmt"
+	"strconv"
 	"sync"
 	"time"
 
@@ -29,6 +30,7 @@ import (
 	"github.com/njcx/libbeat_v7/publisher/pipeline"
 
 	"github.com/njcx/packetbeat7_dpdk/config"
+	"github.com/njcx/packetbeat7_dpdk/decoder"
 	"github.com/njcx/packetbeat7_dpdk/flows"
 	"github.com/njcx/packetbeat7_dpdk/procs"
 	"github.com/njcx/packetbeat7_dpdk/protos"
@@ -39,17 +41,23 @@ import (
 type processor struct {
 	wg              sync.WaitGroup
 	publisher       *publish.TransactionPublisher
-	flows           *flows.Flows
-	sniffer         *sniffer.Sniffer
+	flows           []*flows.Flows
+	sniffers        []*sniffer.Sniffer
+	filters         []filterTarget
+	triggers        []*captureTrigger
 	shutdownTimeout time.Duration
 	err             chan error
+
+	// global settings, applied while the processor runs
+	filterAPI    bool
+	virtualClock bool
 }
 
-func newProcessor(shutdownTimeout time.Duration, publisher *publish.TransactionPublisher, flows *flows.Flows, sniffer *sniffer.Sniffer, err chan error) *processor {
+func newProcessor(shutdownTimeout time.Duration, publisher *publish.TransactionPublisher, flows []*flows.Flows, sniffers []*sniffer.Sniffer, err chan error) *processor {
 	return &processor{
 		publisher:       publisher,
 		flows:           flows,
-		sniffer:         sniffer,
+		sniffers:        sniffers,
 		err:             err,
 		shutdownTimeout: shutdownTimeout,
 	}
@@ -60,26 +68,36 @@ func (p *processor) String() string {
 }
 
 func (p *processor) Start() {
-	if p.flows != nil {
-		p.flows.Start()
+	liveProcessors.add(p)
+	for _, f := range p.flows {
+		if f != nil {
+			f.Start()
+		}
 	}
-	p.wg.Add(1)
-	go func() {
-		defer p.wg.Done()
+	for _, s := range p.sniffers {
+		p.wg.Add(1)
+		go func(s *sniffer.Sniffer) {
+			defer p.wg.Done()
 
-		err := p.sniffer.Run()
-		if err != nil {
-			p.err <- fmt.Errorf("sniffer loop failed: %v", err)
-			return
-		}
-		p.err <- nil
-	}()
+			err := s.Run()
+			if err != nil {
+				p.err <- fmt.Errorf("sniffer loop failed: %v", err)
+				return
+			}
+			p.err <- nil
+		}(s)
+	}
 }
 
 func (p *processor) Stop() {
-	p.sniffer.Stop()
-	if p.flows != nil {
-		p.flows.Stop()
+	liveProcessors.remove(p)
+	for _, s := range p.sniffers {
+		s.Stop()
+	}
+	for _, f := range p.flows {
+		if f != nil {
+			f.Stop()
+		}
 	}
 	p.wg.Wait()
 	// wait for shutdownTimeout to let the publisher flush
@@ -88,6 +106,9 @@ func (p *processor) Stop() {
 		time.Sleep(p.shutdownTimeout)
 	}
 	p.publisher.Stop()
+	for _, t := range p.triggers {
+		t.close()
+	}
 }
 
 type processorFactory struct {
@@ -113,12 +134,17 @@ func (p *processorFactory) Create(pipeline beat.PipelineConnector, cfg *common.C
 		return nil, err
 	}
 
+	// packet dumps record the name of the beat as sensor name by default
+	if config.Dump.SensorName == "" {
+		config.Dump.SensorName = p.beat.Info.Name
+	}
+
 	publisher, err := publish.NewTransactionPublisher(
 		p.beat.Info.Name,
 		p.beat.Publisher,
 		config.IgnoreOutgoing,
-		config.Interfaces.File == "",
-		config.Interfaces.InternalNetworks,
+		!config.ReadsFile(),
+		config.InternalNetworks(),
 	)
 	if err != nil {
 		return nil, err
@@ -126,7 +152,7 @@ func (p *processorFactory) Create(pipeline beat.PipelineConnector, cfg *common.C
 
 	watcher := procs.ProcessesWatcher{}
 	// Enable the process watcher only if capturing live traffic
-	if config.Interfaces.File == "" {
+	if !config.ReadsFile() {
 		err = watcher.Init(config.Procs)
 		if err != nil {
 			logp.Critical(err.Error())
@@ -136,22 +162,226 @@ func (p *processorFactory) Create(pipeline beat.PipelineConnector, cfg *common.C
 		logp.Info("Process watcher disabled when file input is used")
 	}
 
-	logp.Debug("main", "Initializing protocol plugins")
-	protocols := protos.NewProtocols()
-	err = protocols.Init(false, publisher, watcher, config.Protocols, config.ProtocolsList)
-	if err != nil {
-		return nil, fmt.Errorf("Initializing protocol analyzers failed: %v", err)
+	// Every interface gets its own sniffer, flows and protocol analyzers.
+	// Events are tagged with the interface they have been captured on.
+	var (
+		sniffers  []*sniffer.Sniffer
+		flowsList []*flows.Flows
+		filters   []filterTarget
+		triggers  []*captureTrigger
+	)
+	for _, iface := range config.InterfaceConfigs() {
+		name := sniffer.InterfaceName(iface)
+		sampling := samplingFields(iface.Sampling)
+		fields := mergeFields(interfaceFields(name), sampling)
+		trigger, err := setupTrigger(config.Trigger, config.Decoder.Tunnels, p.beat.Info.Name)
+		if err != nil {
+			return nil, err
+		}
+		reporters := fieldsReporterFactory{
+			publisher: publisher,
+			fields:    fields,
+			trigger:   trigger,
+			tunnels:   decoder.NewTunnels(config.Decoder.Tunnels.MaxConnections),
+		}
+
+		logp.Debug("main", "Initializing protocol plugins")
+		initProtocols := func(reporters reporterFactory) (*protos.ProtocolsStruct, error) {
+			protocols := protos.NewProtocols()
+			err := protocols.Init(false, reporters, watcher, config.Protocols, config.ProtocolsList)
+			if err != nil {
+				return nil, fmt.Errorf("Initializing protocol analyzers failed: %v", err)
+			}
+			return protocols, nil
+		}
+		newProtocols := func() (*protos.ProtocolsStruct, error) {
+			return initProtocols(reporters)
+		}
+		protocols, err := newProtocols()
+		if err != nil {
+			return nil, err
+		}
+		flows, err := setupFlows(pipeline, watcher, config, fields)
+		if err != nil {
+			return nil, err
+		}
+		ifaceWorkers := interfaceWorkerFactory(reporters, sampling, initProtocols, watcher, flows, config)
+		sniffer, err := setupSniffer(config, iface, protocols, workerFactory(reporters, protocols, newProtocols, watcher, flows, config))
+		if err != nil {
+			return nil, err
+		}
+		sniffer.SetInterfaceWorkerFactory(ifaceWorkers)
+		if trigger != nil {
+			sniffer.SetPacketRing(trigger.ring)
+			triggers = append(triggers, trigger)
+		}
+		if iface.CaptureStats.Enabled {
+			client, err := pipeline.ConnectWith(beat.ClientConfig{
+				Processing: beat.ProcessingConfig{Fields: fields},
+			})
+			if err != nil {
+				return nil, err
+			}
+			sniffer.SetStatsReporter(client.Publish)
+		}
+
+		sniffers = append(sniffers, sniffer)
+		flowsList = append(flowsList, flows)
+		filters = append(filters, filterTarget{name: name, sniffer: sniffer, protocols: protocols})
 	}
-	flows, err := setupFlows(pipeline, watcher, config)
-	if err != nil {
-		return nil, err
+
+	runner := newProcessor(config.ShutdownTimeout, publisher, flowsList, sniffers, p.err)
+	runner.filters = filters
+	runner.triggers = triggers
+	runner.filterAPI = config.FilterAPI.Enabled
+	runner.virtualClock = config.VirtualClock()
+	return runner, nil
+}
+
+// fieldsReporterFactory creates reporters adding fields to all events
+// reported. Events of connections seen in tunnels, MPLS label stacks or
+// PPPoE sessions are tagged with them. Events are passed to the capture
+// trigger, if any, before they are published.
+type fieldsReporterFactory struct {
+	publisher *publish.TransactionPublisher
+	fields    common.MapStr
+	trigger   *captureTrigger
+	tunnels   *decoder.Tunnels
+}
+
+func (f fieldsReporterFactory) CreateReporter(cfg *common.Config) (func(beat.Event), error) {
+	var hook publish.EventHook
+	if f.trigger != nil || f.tunnels != nil {
+		hook = f.onEvent
 	}
-	sniffer, err := setupSniffer(config, protocols, workerFactory(publisher, protocols, watcher, flows, config))
-	if err != nil {
-		return nil, err
+	return f.publisher.CreateReporterWithHook(cfg, f.fields, hook)
+}
+
+func (f fieldsReporterFactory) onEvent(event *beat.Event) {
+	if f.tunnels != nil {
+		addTunnelFields(f.tunnels, event)
+	}
+	if f.trigger != nil {
+		f.trigger.onEvent(event)
+	}
+}
+
+// addTunnelFields tags an event with the tunnel, MPLS labels and PPPoE
+// session of its connection, if the connection has been seen in any.
+func addTunnelFields(tunnels *decoder.Tunnels, event *beat.Event) {
+	conn, ok := eventConnection(event.Fields)
+	if !ok {
+		return
+	}
+	tunnel, ok := tunnels.Lookup(conn.Transport, conn.IP1, conn.Port1, conn.IP2, conn.Port2)
+	if !ok {
+		return
+	}
+	if tunnel.SrcMPLSLabels != nil {
+		event.Fields.Put("mpls.source.labels", tunnel.SrcMPLSLabels)
+	}
+	if tunnel.DstMPLSLabels != nil {
+		event.Fields.Put("mpls.destination.labels", tunnel.DstMPLSLabels)
+	}
+	if tunnel.HasPPPoE {
+		event.Fields["pppoe"] = common.MapStr{"session_id": tunnel.PPPoESession}
+	}
+	if tunnel.Type == 0 {
+		return
+	}
+	fields := common.MapStr{
+		"type":        tunnel.Type.String(),
+		"source":      common.MapStr{"ip": tunnel.SrcIP.String()},
+		"destination": common.MapStr{"ip": tunnel.DstIP.String()},
+	}
+	if tunnel.Type != flows.TunnelGTP {
+		fields["id"] = tunnel.ID
+	}
+	if tunnel.HasSrcTEID {
+		fields.Put("source.teid", tunnel.SrcTEID)
+	}
+	if tunnel.HasDstTEID {
+		fields.Put("destination.teid", tunnel.DstTEID)
+	}
+	if tunnel.HasPort {
+		fields["port"] = tunnel.Port
+	}
+	if tunnel.Direction != 0 {
+		fields["direction"] = tunnel.Direction.String()
 	}
+	event.Fields["tunnel"] = fields
+}
+
+// interfaceFields returns the fields events captured on the named interface
+// are tagged with.
+func interfaceFields(name string) common.MapStr {
+	if name == "" {
+		return nil
+	}
+	return common.MapStr{
+		"observer": common.MapStr{
+			"ingress": common.MapStr{
+				"interface": common.MapStr{
+					"name": name,
+				},
+			},
+		},
+	}
+}
 
-	return newProcessor(config.ShutdownTimeout, publisher, flows, sniffer, p.err), nil
+// samplingFields returns the fields recording the sampling of the packets
+// events are derived from, such that counts can be scaled by the rate.
+func samplingFields(cfg config.SamplingConfig) common.MapStr {
+	if !cfg.Enabled() {
+		return nil
+	}
+	return common.MapStr{
+		"sampling": common.MapStr{
+			"mode": cfg.SampleMode(),
+			"rate": cfg.Rate,
+		},
+	}
+}
+
+// mergeFields merges the given fields, returning nil if all are empty.
+func mergeFields(fields ...common.MapStr) common.MapStr {
+	var merged common.MapStr
+	for _, f := range fields {
+		if len(f) == 0 {
+			continue
+		}
+		if merged == nil {
+			merged = common.MapStr{}
+		}
+		merged.DeepUpdate(f)
+	}
+	return merged
+}
+
+// captureInterfaceFields returns the fields events of packets read from a
+// pcapng file are tagged with. The description of the interface is reported
+// as its alias. Interfaces of plain pcap files have neither and events are
+// not tagged.
+func captureInterfaceFields(iface sniffer.Interface) common.MapStr {
+	if iface.Name == "" && iface.Description == "" {
+		return nil
+	}
+	fields := common.MapStr{
+		"id": strconv.Itoa(iface.Index),
+	}
+	if iface.Name != "" {
+		fields["name"] = iface.Name
+	}
+	if iface.Description != "" {
+		fields["alias"] = iface.Description
+	}
+	return common.MapStr{
+		"observer": common.MapStr{
+			"ingress": common.MapStr{
+				"interface": fields,
+			},
+		},
+	}
 }
 
 func (p *processorFactory) CheckConfig(config *common.Config) error {
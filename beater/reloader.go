@@ -19,25 +19,159 @@ package beater
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/njcx/libbeat_v7/beat"
 	"github.com/njcx/libbeat_v7/cfgfile"
+	"github.com/njcx/libbeat_v7/common"
 	"github.com/njcx/libbeat_v7/common/reload"
+	"github.com/njcx/libbeat_v7/logp"
 )
 
+// reloader runs a processor per input configuration. Processors whose
+// configuration only changes in its BPF filters are kept running, with the
+// filters replaced on their live sniffers, so TCP stream state survives.
 type reloader struct {
 	*cfgfile.RunnerList
+	factory *processorFactory
+
+	mu      sync.Mutex
+	running map[uint64]*filterRunner // by hash of the config without filters
 }
 
 func newReloader(name string, factory *processorFactory, pipeline beat.PipelineConnector) *reloader {
-	return &reloader{
-		RunnerList: cfgfile.NewRunnerList(name, factory, pipeline),
+	r := &reloader{
+		factory: factory,
+		running: map[uint64]*filterRunner{},
 	}
+	r.RunnerList = cfgfile.NewRunnerList(name, r, pipeline)
+	return r
 }
 
 func (r *reloader) Reload(configs []*reload.ConfigWithMeta) error {
 	if len(configs) > maxSniffers {
 		return fmt.Errorf("only %d inputs are currently supported", maxSniffers)
 	}
-	return r.RunnerList.Reload(configs)
+
+	configs, filterErr := r.applyFilters(configs)
+	if err := r.RunnerList.Reload(configs); err != nil {
+		return err
+	}
+	return filterErr
+}
+
+// applyFilters replaces the BPF filters of the running processors whose
+// configuration only differs in its filters. Their configs are replaced by
+// the ones they have been created with, such that the RunnerList keeps
+// them running. If the new filters can not be applied, the processors keep
+// running with their previous filters.
+func (r *reloader) applyFilters(configs []*reload.ConfigWithMeta) ([]*reload.ConfigWithMeta, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var errs []error
+	updated := make([]*reload.ConfigWithMeta, len(configs))
+	for i, c := range configs {
+		updated[i] = c
+
+		key, err := hashWithoutFilters(c.Config)
+		if err != nil {
+			continue
+		}
+		running, ok := r.running[key]
+		if !ok {
+			continue
+		}
+
+		cfg, err := r.factory.configurator(c.Config)
+		if err != nil {
+			continue
+		}
+		if err := running.applyFilters(cfg); err != nil {
+			logp.Err("Failed to update BPF filters, keeping the previous filters: %v", err)
+			errs = append(errs, err)
+		}
+		updated[i] = &reload.ConfigWithMeta{Config: running.config, Meta: c.Meta}
+	}
+
+	if len(errs) > 0 {
+		return updated, fmt.Errorf("failed to update BPF filters: %v", errs)
+	}
+	return updated, nil
+}
+
+// Create creates the processor of an input configuration and records it,
+// such that its filters can be updated by later reloads.
+func (r *reloader) Create(pipeline beat.PipelineConnector, cfg *common.Config) (cfgfile.Runner, error) {
+	runner, err := r.factory.Create(pipeline, cfg)
+	if err != nil {
+		return nil, err
+	}
+	p, ok := runner.(*processor)
+	if !ok {
+		return runner, nil
+	}
+	key, err := hashWithoutFilters(cfg)
+	if err != nil {
+		return runner, nil
+	}
+
+	fr := &filterRunner{processor: p, config: cfg}
+	fr.onStop = func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if r.running[key] == fr {
+			delete(r.running, key)
+		}
+	}
+
+	r.mu.Lock()
+	r.running[key] = fr
+	r.mu.Unlock()
+	return fr, nil
+}
+
+func (r *reloader) CheckConfig(cfg *common.Config) error {
+	return r.factory.CheckConfig(cfg)
+}
+
+// filterRunner is a processor created by the reloader.
+type filterRunner struct {
+	*processor
+	config *common.Config // config the processor has been created with
+	onStop func()
+}
+
+func (f *filterRunner) Stop() {
+	f.onStop()
+	f.processor.Stop()
+}
+
+// hashWithoutFilters hashes cfg ignoring all bpf_filter settings.
+func hashWithoutFilters(cfg *common.Config) (uint64, error) {
+	var fields map[string]interface{}
+	if err := cfg.Unpack(&fields); err != nil {
+		return 0, err
+	}
+	removeFilters(fields)
+
+	stripped, err := common.NewConfigFrom(fields)
+	if err != nil {
+		return 0, err
+	}
+	return cfgfile.HashConfig(stripped)
+}
+
+func removeFilters(v interface{}) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		delete(v, "bpf_filter")
+		for _, elem := range v {
+			removeFilters(elem)
+		}
+	case []interface{}:
+		for _, elem := range v {
+			removeFilters(elem)
+		}
+	}
 }
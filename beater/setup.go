@@ -28,21 +28,32 @@ import (
 	"github.com/njcx/packetbeat7_dpdk/sniffer"
 )
 
-func setupSniffer(cfg config.Config, protocols *protos.ProtocolsStruct, workerFactory sniffer.WorkerFactory) (*sniffer.Sniffer, error) {
-	icmp, err := cfg.ICMP()
+func setupSniffer(cfg config.Config, iface config.InterfacesConfig, protocols *protos.ProtocolsStruct, workerFactory sniffer.WorkerFactory) (*sniffer.Sniffer, error) {
+	filter, err := snifferFilter(cfg, iface, protocols)
 	if err != nil {
 		return nil, err
 	}
 
-	filter := cfg.Interfaces.BpfFilter
-	if filter == "" && !cfg.Flows.IsEnabled() {
-		filter = protocols.BpfFilter(cfg.Interfaces.WithVlans, icmp.Enabled())
+	return sniffer.New(false, filter, workerFactory, iface)
+}
+
+// snifferFilter returns the BPF filter of the sniffer capturing on iface.
+// Unless configured, the filter is derived from the ports of the protocols
+// analyzed, if flows are disabled.
+func snifferFilter(cfg config.Config, iface config.InterfacesConfig, protocols *protos.ProtocolsStruct) (string, error) {
+	icmp, err := cfg.ICMP()
+	if err != nil {
+		return "", err
 	}
 
-	return sniffer.New(false, filter, workerFactory, cfg.Interfaces)
+	filter := iface.BpfFilter
+	if filter == "" && !cfg.Flows.IsEnabled() {
+		filter = protocols.BpfFilter(iface.WithVlans, icmp.Enabled())
+	}
+	return filter, nil
 }
 
-func setupFlows(pipeline beat.Pipeline, watcher procs.ProcessesWatcher, cfg config.Config) (*flows.Flows, error) {
+func setupFlows(pipeline beat.Pipeline, watcher procs.ProcessesWatcher, cfg config.Config, fields common.MapStr) (*flows.Flows, error) {
 	if !cfg.Flows.IsEnabled() {
 		return nil, nil
 	}
@@ -55,6 +66,7 @@ func setupFlows(pipeline beat.Pipeline, watcher procs.ProcessesWatcher, cfg conf
 	clientConfig := beat.ClientConfig{
 		Processing: beat.ProcessingConfig{
 			EventMetadata: cfg.Flows.EventMetadata,
+			Fields:        fields,
 			Processor:     processors,
 			KeepNull:      cfg.Flows.KeepNull,
 		},
@@ -0,0 +1,316 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package beater
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/njcx/libbeat_v7/beat"
+	"github.com/njcx/libbeat_v7/common"
+	"github.com/njcx/libbeat_v7/conditions"
+	"github.com/njcx/libbeat_v7/logp"
+	"github.com/njcx/libbeat_v7/monitoring"
+	"github.com/njcx/libbeat_v7/paths"
+
+	"github.com/njcx/packetbeat7_dpdk/config"
+	"github.com/njcx/packetbeat7_dpdk/decoder"
+	"github.com/njcx/packetbeat7_dpdk/sniffer"
+)
+
+const (
+	defaultTriggerMaxAge        = 60 * time.Second
+	defaultTriggerMaxSizeMb     = 64
+	defaultTriggerPath          = "triggered"
+	defaultTriggerNumberOfFiles = 100
+
+	// triggerQueueSize is the number of connections waiting to be written.
+	triggerQueueSize = 16
+
+	triggerFilePrefix = "packetbeat-"
+	triggerTimeLayout = "20060102-150405.000"
+)
+
+var (
+	triggerFilePattern = regexp.MustCompile(`^` + triggerFilePrefix + `\d{8}-\d{6}\.\d{3}-\d+\.pcapng$`)
+
+	// triggerSeq keeps the names of the files written by the triggers of
+	// all interfaces unique.
+	triggerSeq uint64
+
+	triggerDropped = monitoring.NewInt(nil, "trigger.writes_dropped")
+)
+
+// captureTrigger keeps the packets captured recently in a packet ring. Once
+// an event matches the condition, the packets of the connection of the event
+// are written to a pcapng file, referenced from the event in pcap.file.
+// Further events of the connection matching the condition while the
+// connection is tracked replace the file with the packets kept by then.
+//
+// Files are written in the background, such that publishing events is not
+// held up. A connection is queued once until its file is being written, and
+// writes are dropped while the queue is full.
+type captureTrigger struct {
+	cfg    config.TriggerConfig
+	cond   conditions.Condition
+	ring   *sniffer.PacketRing
+	sensor string
+
+	mu     sync.Mutex
+	files  map[string]*triggerFile // by connection
+	closed bool
+
+	writes chan triggerWrite
+	done   chan struct{}
+}
+
+type triggerFile struct {
+	path    string
+	last    time.Time
+	pending bool // queued to be written
+	written bool
+}
+
+type triggerWrite struct {
+	file *triggerFile
+	conn sniffer.Connection
+}
+
+// setupTrigger creates the capture trigger of an interface, if enabled.
+// Tunneled packets are kept by the connection decapsulated with tunnels.
+func setupTrigger(cfg config.TriggerConfig, tunnels config.TunnelsConfig, sensor string) (*captureTrigger, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	if cfg.MaxAge == 0 {
+		cfg.MaxAge = defaultTriggerMaxAge
+	}
+	if cfg.MaxSizeMb == 0 {
+		cfg.MaxSizeMb = defaultTriggerMaxSizeMb
+	}
+	if cfg.Path == "" {
+		cfg.Path = defaultTriggerPath
+	}
+	cfg.Path = paths.Resolve(paths.Data, cfg.Path)
+	if cfg.NumberOfFiles == 0 {
+		cfg.NumberOfFiles = defaultTriggerNumberOfFiles
+	}
+
+	if cfg.MaxAge < 0 || cfg.MaxSizeMb < 0 || cfg.NumberOfFiles < 0 {
+		return nil, errors.New("triggered_capture max_age, max_size_mb and number_of_files must not be negative")
+	}
+	if cfg.When == nil {
+		return nil, errors.New("triggered_capture requires a when condition")
+	}
+	cond, err := conditions.NewCondition(cfg.When)
+	if err != nil {
+		return nil, fmt.Errorf("invalid triggered_capture condition: %v", err)
+	}
+
+	t := newCaptureTrigger(cfg, cond, decoder.NewTunnelPorts(tunnels), sensor)
+	go t.run()
+	return t, nil
+}
+
+func newCaptureTrigger(cfg config.TriggerConfig, cond conditions.Condition, ports decoder.TunnelPorts, sensor string) *captureTrigger {
+	return &captureTrigger{
+		cfg:    cfg,
+		cond:   cond,
+		ring:   sniffer.NewPacketRing(cfg.MaxAge, cfg.MaxSizeMb*1024*1024, ports),
+		sensor: sensor,
+		files:  map[string]*triggerFile{},
+		writes: make(chan triggerWrite, triggerQueueSize),
+		done:   make(chan struct{}),
+	}
+}
+
+// onEvent is called with every event before it is published.
+func (t *captureTrigger) onEvent(event *beat.Event) {
+	if !t.cond.Check(event) {
+		return
+	}
+	conn, ok := eventConnection(event.Fields)
+	if !ok {
+		logp.Debug("trigger", "Event matching the capture trigger has no connection")
+		return
+	}
+
+	key := fmt.Sprintf("%s %s:%d %s:%d", conn.Transport, conn.IP1, conn.Port1, conn.IP2, conn.Port2)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closed {
+		return
+	}
+
+	for k, f := range t.files {
+		if event.Timestamp.Sub(f.last) > t.cfg.MaxAge && !f.pending {
+			delete(t.files, k)
+		}
+	}
+	file, exists := t.files[key]
+	if !exists {
+		name := fmt.Sprintf("%s%s-%d.pcapng", triggerFilePrefix,
+			event.Timestamp.UTC().Format(triggerTimeLayout), atomic.AddUint64(&triggerSeq, 1))
+		file = &triggerFile{path: filepath.Join(t.cfg.Path, name)}
+		t.files[key] = file
+	}
+	file.last = event.Timestamp
+
+	if !file.pending {
+		select {
+		case t.writes <- triggerWrite{file: file, conn: conn}:
+			file.pending = true
+		default:
+			triggerDropped.Inc()
+			logp.Debug("trigger", "Triggered capture queue is full, dropping write of %s", file.path)
+		}
+	}
+	if file.pending || file.written {
+		event.Fields.Put("pcap.file", file.path)
+	}
+}
+
+// run writes the queued connections until the trigger is closed.
+func (t *captureTrigger) run() {
+	defer close(t.done)
+	for w := range t.writes {
+		t.mu.Lock()
+		w.file.pending = false
+		t.mu.Unlock()
+
+		t.write(w)
+	}
+}
+
+func (t *captureTrigger) write(w triggerWrite) {
+	if err := os.MkdirAll(t.cfg.Path, 0750); err != nil {
+		logp.Err("Failed to create triggered capture directory: %v", err)
+		return
+	}
+	n, err := t.ring.WriteConnection(w.file.path, w.conn, t.sensor)
+	if err != nil {
+		logp.Err("Failed to write triggered capture %s: %v", w.file.path, err)
+		return
+	}
+	if n == 0 {
+		return
+	}
+
+	t.mu.Lock()
+	first := !w.file.written
+	w.file.written = true
+	t.mu.Unlock()
+	if first {
+		t.removeOldFiles()
+	}
+}
+
+// close stops queueing writes and waits for the queued writes to finish.
+func (t *captureTrigger) close() {
+	t.mu.Lock()
+	if !t.closed {
+		t.closed = true
+		close(t.writes)
+	}
+	t.mu.Unlock()
+	<-t.done
+}
+
+// removeOldFiles removes the oldest files, such that at most number_of_files
+// files are kept.
+func (t *captureTrigger) removeOldFiles() {
+	entries, err := os.ReadDir(t.cfg.Path)
+	if err != nil {
+		logp.Warn("Failed to list triggered capture files: %v", err)
+		return
+	}
+
+	var files []string
+	for _, e := range entries {
+		if e.Type().IsRegular() && triggerFilePattern.MatchString(e.Name()) {
+			files = append(files, e.Name())
+		}
+	}
+	if len(files) <= t.cfg.NumberOfFiles {
+		return
+	}
+
+	sort.Strings(files)
+	for _, name := range files[:len(files)-t.cfg.NumberOfFiles] {
+		if err := os.Remove(filepath.Join(t.cfg.Path, name)); err != nil {
+			logp.Warn("Failed to remove triggered capture file: %v", err)
+		}
+	}
+}
+
+// eventConnection returns the connection of an event, identified by the
+// source and destination fields.
+func eventConnection(fields common.MapStr) (sniffer.Connection, bool) {
+	var conn sniffer.Connection
+	conn.IP1 = eventIP(fields, "source.ip")
+	conn.IP2 = eventIP(fields, "destination.ip")
+	if conn.IP1 == nil || conn.IP2 == nil {
+		return conn, false
+	}
+	conn.Port1 = eventPort(fields, "source.port")
+	conn.Port2 = eventPort(fields, "destination.port")
+	if transport, err := fields.GetValue("network.transport"); err == nil {
+		conn.Transport, _ = transport.(string)
+	}
+	return conn, true
+}
+
+func eventIP(fields common.MapStr, key string) net.IP {
+	v, err := fields.GetValue(key)
+	if err != nil {
+		return nil
+	}
+	s, _ := v.(string)
+	return net.ParseIP(s)
+}
+
+func eventPort(fields common.MapStr, key string) uint16 {
+	v, err := fields.GetValue(key)
+	if err != nil {
+		return 0
+	}
+	switch port := v.(type) {
+	case int:
+		return uint16(port)
+	case int64:
+		return uint16(port)
+	case uint16:
+		return port
+	case uint32:
+		return uint16(port)
+	case uint64:
+		return uint16(port)
+	default:
+		return 0
+	}
+}
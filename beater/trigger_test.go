@@ -0,0 +1,180 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !integration
+// +build !integration
+
+package beater
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/njcx/libbeat_v7/beat"
+	"github.com/njcx/libbeat_v7/common"
+	"github.com/njcx/libbeat_v7/conditions"
+
+	"github.com/njcx/gopacket_dpdk"
+	"github.com/njcx/gopacket_dpdk/layers"
+
+	"github.com/njcx/packetbeat7_dpdk/config"
+	"github.com/njcx/packetbeat7_dpdk/sniffer"
+)
+
+var triggerIface = sniffer.Interface{Name: "eth0", LinkType: layers.LinkTypeEthernet, Snaplen: 65535}
+
+// newTestTrigger creates a trigger matching HTTP events, writing to a
+// temporary directory. The writer is not started.
+func newTestTrigger(t *testing.T) *captureTrigger {
+	var when conditions.Config
+	require.NoError(t, common.MustNewConfigFrom(map[string]interface{}{
+		"equals": map[string]interface{}{"type": "http"},
+	}).Unpack(&when))
+	cond, err := conditions.NewCondition(&when)
+	require.NoError(t, err)
+
+	cfg := config.TriggerConfig{
+		MaxAge:        time.Minute,
+		MaxSizeMb:     1,
+		Path:          t.TempDir(),
+		NumberOfFiles: 10,
+	}
+	return newCaptureTrigger(cfg, cond, nil, "sensor")
+}
+
+func triggerPacket(t *testing.T, src string, sport uint16, dst string, dport uint16) []byte {
+	eth := &layers.Ethernet{
+		SrcMAC:       net.HardwareAddr{0, 1, 2, 3, 4, 5},
+		DstMAC:       net.HardwareAddr{0, 1, 2, 3, 4, 6},
+		EthernetType: layers.EthernetTypeIPv4,
+	}
+	ip := &layers.IPv4{
+		Version:  4,
+		TTL:      64,
+		Protocol: layers.IPProtocolTCP,
+		SrcIP:    net.ParseIP(src).To4(),
+		DstIP:    net.ParseIP(dst).To4(),
+	}
+	tcp := &layers.TCP{SrcPort: layers.TCPPort(sport), DstPort: layers.TCPPort(dport), ACK: true}
+	require.NoError(t, tcp.SetNetworkLayerForChecksum(ip))
+
+	buf := gopacket_dpdk.NewSerializeBuffer()
+	opts := gopacket_dpdk.SerializeOptions{FixLengths: true, ComputeChecksums: true}
+	require.NoError(t, gopacket_dpdk.SerializeLayers(buf, opts, eth, ip, tcp, gopacket_dpdk.Payload("data")))
+	return buf.Bytes()
+}
+
+func triggerEvent(ts time.Time, typ string, sport uint16) *beat.Event {
+	return &beat.Event{
+		Timestamp: ts,
+		Fields: common.MapStr{
+			"type":        typ,
+			"source":      common.MapStr{"ip": "10.0.0.1", "port": sport},
+			"destination": common.MapStr{"ip": "10.0.0.2", "port": uint16(80)},
+			"network":     common.MapStr{"transport": "tcp"},
+		},
+	}
+}
+
+func hasPcapFile(event *beat.Event) bool {
+	_, err := event.Fields.GetValue("pcap.file")
+	return err == nil
+}
+
+func TestCaptureTrigger_writesConnection(t *testing.T) {
+	trigger := newTestTrigger(t)
+	go trigger.run()
+
+	ts := time.Now()
+	for _, sport := range []uint16{1000, 1001} {
+		data := triggerPacket(t, "10.0.0.1", sport, "10.0.0.2", 80)
+		trigger.ring.Add(triggerIface, data, &gopacket_dpdk.CaptureInfo{Timestamp: ts, CaptureLength: len(data), Length: len(data)})
+	}
+
+	other := triggerEvent(ts, "dns", 1000)
+	trigger.onEvent(other)
+	assert.False(t, hasPcapFile(other))
+
+	event := triggerEvent(ts, "http", 1000)
+	trigger.onEvent(event)
+	path, err := event.Fields.GetValue("pcap.file")
+	require.NoError(t, err)
+
+	// the connection is written to the same file again
+	again := triggerEvent(ts.Add(time.Second), "http", 1000)
+	trigger.onEvent(again)
+	assert.Equal(t, common.MapStr{"file": path}, again.Fields["pcap"])
+
+	trigger.close()
+	_, err = os.Stat(path.(string))
+	assert.NoError(t, err)
+
+	// no events are tagged once closed
+	closed := triggerEvent(ts, "http", 1001)
+	trigger.onEvent(closed)
+	assert.False(t, hasPcapFile(closed))
+}
+
+func TestCaptureTrigger_debounce(t *testing.T) {
+	trigger := newTestTrigger(t)
+
+	// a connection is queued once until it is written
+	ts := time.Now()
+	for i := 0; i < 3; i++ {
+		trigger.onEvent(triggerEvent(ts, "http", 1000))
+	}
+	assert.Len(t, trigger.writes, 1)
+
+	// connections are dropped once the queue is full, without blocking
+	dropped := triggerDropped.Get()
+	for i := 1; i < triggerQueueSize+2; i++ {
+		event := triggerEvent(ts, "http", uint16(1000+i))
+		trigger.onEvent(event)
+		if i < triggerQueueSize {
+			assert.True(t, hasPcapFile(event), fmt.Sprint(i))
+		} else {
+			assert.False(t, hasPcapFile(event), fmt.Sprint(i))
+		}
+	}
+	assert.Len(t, trigger.writes, triggerQueueSize)
+	assert.Equal(t, dropped+2, triggerDropped.Get())
+
+	// the writer takes the queued connections, none of which has packets
+	go trigger.run()
+	trigger.close()
+	entries, err := os.ReadDir(trigger.cfg.Path)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestSetupTrigger(t *testing.T) {
+	trigger, err := setupTrigger(config.TriggerConfig{}, config.TunnelsConfig{}, "sensor")
+	assert.NoError(t, err)
+	assert.Nil(t, trigger)
+
+	_, err = setupTrigger(config.TriggerConfig{Enabled: true}, config.TunnelsConfig{}, "sensor")
+	assert.Error(t, err, "when condition required")
+
+	_, err = setupTrigger(config.TriggerConfig{Enabled: true, MaxAge: -time.Second, When: &conditions.Config{}}, config.TunnelsConfig{}, "sensor")
+	assert.Error(t, err)
+}
@@ -20,6 +20,9 @@ package beater
 import (
 	"github.com/njcx/gopacket_dpdk/layers"
 
+	"github.com/njcx/libbeat_v7/beat"
+	"github.com/njcx/libbeat_v7/common"
+
 	"github.com/njcx/packetbeat7_dpdk/config"
 	"github.com/njcx/packetbeat7_dpdk/decoder"
 	"github.com/njcx/packetbeat7_dpdk/flows"
@@ -28,12 +31,58 @@ import (
 	"github.com/njcx/packetbeat7_dpdk/protos/icmp"
 	"github.com/njcx/packetbeat7_dpdk/protos/tcp"
 	"github.com/njcx/packetbeat7_dpdk/protos/udp"
-	"github.com/njcx/packetbeat7_dpdk/publish"
 	"github.com/njcx/packetbeat7_dpdk/sniffer"
 )
 
-func workerFactory(publisher *publish.TransactionPublisher, protocols *protos.ProtocolsStruct, watcher procs.ProcessesWatcher, flows *flows.Flows, cfg config.Config) func(dl layers.LinkType) (sniffer.Worker, error) {
+type reporterFactory interface {
+	CreateReporter(*common.Config) (func(beat.Event), error)
+}
+
+// interfaceWorkerFactory creates the workers for the interfaces recorded in
+// pcapng files. Every interface gets its own protocol analyzers reporting
+// events tagged with the interface and the given fields.
+func interfaceWorkerFactory(
+	base fieldsReporterFactory,
+	fields common.MapStr,
+	initProtocols func(reporterFactory) (*protos.ProtocolsStruct, error),
+	watcher procs.ProcessesWatcher,
+	flows *flows.Flows,
+	cfg config.Config,
+) sniffer.InterfaceWorkerFactory {
+	return func(iface sniffer.Interface) (sniffer.Worker, error) {
+		reporters := base
+		reporters.fields = mergeFields(captureInterfaceFields(iface), fields)
+		protocols, err := initProtocols(reporters)
+		if err != nil {
+			return nil, err
+		}
+		return workerFactory(reporters, protocols, nil, watcher, flows, cfg)(iface.LinkType)
+	}
+}
+
+func workerFactory(
+	publisher fieldsReporterFactory,
+	protocols *protos.ProtocolsStruct,
+	newProtocols func() (*protos.ProtocolsStruct, error),
+	watcher procs.ProcessesWatcher,
+	flows *flows.Flows,
+	cfg config.Config,
+) func(dl layers.LinkType) (sniffer.Worker, error) {
+	shared := protocols
 	return func(dl layers.LinkType) (sniffer.Worker, error) {
+		// The first worker uses the protocol analyzers the BPF filter has been
+		// computed from. Additional workers, one per receive queue, get their
+		// own analyzers, so parser state is never shared between goroutines.
+		protocols := shared
+		shared = nil
+		if protocols == nil {
+			var err error
+			protocols, err = newProtocols()
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		var icmp4 icmp.ICMPv4Processor
 		var icmp6 icmp.ICMPv6Processor
 		config, err := cfg.ICMP()
@@ -69,6 +118,8 @@ func workerFactory(publisher *publish.TransactionPublisher, protocols *protos.Pr
 		if err != nil {
 			return nil, err
 		}
+		worker.SetDefragConfig(cfg.Decoder.Defrag)
+		worker.SetTunnelConfig(cfg.Decoder.Tunnels, publisher.tunnels)
 
 		return worker, nil
 	}
@@ -0,0 +1,247 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package clock provides the time packet processing is based on. By default
+// this is the wall clock. In virtual mode, the sniffer advances the clock to
+// the timestamps of the packets processed, such that the timeouts of
+// transactions and flows follow packet time when replaying capture files.
+package clock
+
+import (
+	"container/heap"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var (
+	virtual atomic.Bool
+
+	mu     sync.Mutex
+	now    time.Time // virtual time, zero until the first packet
+	timers timerHeap
+	active = map[*Timer]struct{}{} // timers neither stopped nor fired
+)
+
+// SetVirtual switches between wall and virtual time. Virtual time starts at
+// the timestamp of the first packet. Pending timers are moved to the new
+// clock, restarting their period or delay.
+func SetVirtual(enabled bool) {
+	mu.Lock()
+	defer mu.Unlock()
+	if virtual.Load() == enabled {
+		return
+	}
+	for t := range active {
+		t.cancel()
+	}
+	virtual.Store(enabled)
+	now = time.Time{}
+	timers = nil
+	for t := range active {
+		t.start()
+	}
+}
+
+// Virtual returns true if the clock follows packet time.
+func Virtual() bool {
+	return virtual.Load()
+}
+
+// Now returns the current time.
+func Now() time.Time {
+	if !virtual.Load() {
+		return time.Now()
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	return now
+}
+
+// Advance advances virtual time to ts. The functions of the timers due are
+// called in the order of their due times, with the clock set to the due
+// time. Time never goes backwards.
+func Advance(ts time.Time) {
+	mu.Lock()
+	if now.IsZero() {
+		// timers created before the first packet start with it
+		for _, t := range timers {
+			t.at = ts.Add(t.at.Sub(time.Time{}))
+		}
+	}
+	for len(timers) > 0 && !timers[0].at.After(ts) {
+		t := timers[0]
+		if t.at.After(now) {
+			now = t.at
+		}
+		if t.period > 0 {
+			t.at = t.at.Add(t.period)
+			heap.Fix(&timers, 0)
+		} else {
+			heap.Pop(&timers)
+			delete(active, t)
+		}
+
+		mu.Unlock()
+		t.f()
+		mu.Lock()
+	}
+	if ts.After(now) {
+		now = ts
+	}
+	mu.Unlock()
+}
+
+// Timer calls a function once or periodically.
+type Timer struct {
+	f      func()
+	period time.Duration
+	delay  time.Duration // of timers calling f once
+	gen    int           // incremented on every start, guards stale wall timers
+
+	// virtual time
+	at    time.Time
+	index int // index in timers, -1 if not scheduled
+
+	// wall time
+	wall *time.Timer
+	quit chan struct{}
+}
+
+// AfterFunc calls f once d has elapsed. With wall time, f is called in its
+// own goroutine, with virtual time by the goroutine advancing the clock.
+func AfterFunc(d time.Duration, f func()) *Timer {
+	return add(&Timer{f: f, delay: d, index: -1})
+}
+
+// Every calls f every period until the timer is stopped. With wall time, f
+// is called by a goroutine of the timer, with virtual time by the goroutine
+// advancing the clock.
+func Every(period time.Duration, f func()) *Timer {
+	return add(&Timer{f: f, period: period, index: -1})
+}
+
+func add(t *Timer) *Timer {
+	mu.Lock()
+	defer mu.Unlock()
+	active[t] = struct{}{}
+	t.start()
+	return t
+}
+
+// start schedules the timer on the current clock. mu must be held.
+func (t *Timer) start() {
+	d := t.period
+	if d == 0 {
+		d = t.delay
+	}
+	t.gen++
+
+	switch {
+	case virtual.Load():
+		t.at = now.Add(d)
+		heap.Push(&timers, t)
+
+	case t.period > 0:
+		quit := make(chan struct{})
+		t.quit = quit
+		go func() {
+			ticker := time.NewTicker(d)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ticker.C:
+					t.f()
+				case <-quit:
+					return
+				}
+			}
+		}()
+
+	default:
+		gen := t.gen
+		t.wall = time.AfterFunc(d, func() {
+			mu.Lock()
+			_, ok := active[t]
+			ok = ok && t.gen == gen
+			if ok {
+				delete(active, t)
+				t.wall = nil
+			}
+			mu.Unlock()
+			if ok {
+				t.f()
+			}
+		})
+	}
+}
+
+// cancel unschedules the timer from the current clock. mu must be held.
+func (t *Timer) cancel() {
+	if t.wall != nil {
+		t.wall.Stop()
+		t.wall = nil
+	}
+	if t.quit != nil {
+		close(t.quit)
+		t.quit = nil
+	}
+	if t.index >= 0 && t.index < len(timers) && timers[t.index] == t {
+		heap.Remove(&timers, t.index)
+	}
+}
+
+// Stop stops the timer. It returns false if the timer has already been
+// stopped or its function has been called once.
+func (t *Timer) Stop() bool {
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := active[t]; !ok {
+		return false
+	}
+	delete(active, t)
+	t.cancel()
+	return true
+}
+
+// timerHeap orders timers by due time.
+type timerHeap []*Timer
+
+func (h timerHeap) Len() int { return len(h) }
+
+func (h timerHeap) Less(i, j int) bool { return h[i].at.Before(h[j].at) }
+
+func (h timerHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *timerHeap) Push(x interface{}) {
+	t := x.(*Timer)
+	t.index = len(*h)
+	*h = append(*h, t)
+}
+
+func (h *timerHeap) Pop() interface{} {
+	old := *h
+	t := old[len(old)-1]
+	old[len(old)-1] = nil
+	t.index = -1
+	*h = old[:len(old)-1]
+	return t
+}
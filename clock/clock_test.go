@@ -0,0 +1,156 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !integration
+// +build !integration
+
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/njcx/libbeat_v7/common"
+)
+
+func withVirtualClock(t *testing.T) {
+	SetVirtual(true)
+	t.Cleanup(func() { SetVirtual(false) })
+}
+
+func TestVirtualTimers(t *testing.T) {
+	withVirtualClock(t)
+
+	var calls []string
+	record := func(name string) func() {
+		return func() {
+			calls = append(calls, name+"@"+Now().Format("05"))
+		}
+	}
+
+	// timers created before the first packet start with it
+	every := Every(2*time.Second, record("every"))
+	AfterFunc(5*time.Second, record("once"))
+
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	Advance(t0)
+	assert.Equal(t, t0, Now())
+	assert.Empty(t, calls)
+
+	Advance(t0.Add(3 * time.Second))
+	assert.Equal(t, []string{"every@02"}, calls)
+	assert.Equal(t, t0.Add(3*time.Second), Now())
+
+	Advance(t0.Add(6 * time.Second))
+	assert.Equal(t, []string{"every@02", "every@04", "once@05", "every@06"}, calls)
+
+	assert.True(t, every.Stop())
+	assert.False(t, every.Stop())
+	Advance(t0.Add(20 * time.Second))
+	assert.Len(t, calls, 4)
+
+	// time never goes backwards
+	Advance(t0)
+	assert.Equal(t, t0.Add(20*time.Second), Now())
+}
+
+func TestVirtualTimerStop(t *testing.T) {
+	withVirtualClock(t)
+
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	Advance(t0)
+
+	called := 0
+	timer := AfterFunc(time.Second, func() { called++ })
+	assert.True(t, timer.Stop())
+	Advance(t0.Add(time.Minute))
+	assert.Equal(t, 0, called)
+
+	timer = AfterFunc(time.Second, func() { called++ })
+	Advance(t0.Add(2 * time.Minute))
+	assert.Equal(t, 1, called)
+	assert.False(t, timer.Stop())
+}
+
+func TestVirtualTimerStopInCallback(t *testing.T) {
+	withVirtualClock(t)
+
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	Advance(t0)
+
+	called := 0
+	var timer *Timer
+	timer = Every(time.Second, func() {
+		called++
+		timer.Stop()
+	})
+	Advance(t0.Add(time.Minute))
+	assert.Equal(t, 1, called)
+}
+
+func TestCacheExpiresInPacketTime(t *testing.T) {
+	withVirtualClock(t)
+
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	Advance(t0)
+
+	var removed []common.Key
+	cache := NewCacheWithRemovalListener(10*time.Second, 8, func(k common.Key, v common.Value) {
+		removed = append(removed, k)
+	})
+	cache.StartJanitor(time.Second)
+	defer cache.StopJanitor()
+
+	cache.Put("a", 1)
+	Advance(t0.Add(5 * time.Second))
+	assert.Equal(t, 1, cache.Get("a"))
+
+	// the access extends the lifetime of a by another 10s
+	Advance(t0.Add(12 * time.Second))
+	assert.Equal(t, 1, cache.Size())
+	assert.Empty(t, removed)
+
+	Advance(t0.Add(17 * time.Second))
+	assert.Nil(t, cache.Get("a"))
+	assert.Equal(t, []common.Key{"a"}, removed)
+	assert.Equal(t, 0, cache.Size())
+}
+
+func TestSetVirtualMovesTimers(t *testing.T) {
+	called := 0
+	once := AfterFunc(time.Hour, func() { called++ })
+	every := Every(time.Hour, func() { called++ })
+	defer every.Stop()
+
+	// timers created on the wall clock follow packet time once switched
+	withVirtualClock(t)
+
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	Advance(t0)
+	Advance(t0.Add(90 * time.Minute))
+	assert.Equal(t, 2, called)
+	assert.False(t, once.Stop())
+
+	// and are moved back to the wall clock
+	SetVirtual(false)
+	Advance(t0.Add(10 * time.Hour))
+	assert.Equal(t, 2, called)
+	assert.True(t, every.Stop())
+	assert.False(t, every.Stop())
+}
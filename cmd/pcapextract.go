@@ -0,0 +1,120 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/njcx/libbeat_v7/cmd/instance"
+	"github.com/njcx/libbeat_v7/common/cli"
+
+	"github.com/njcx/packetbeat7_dpdk/config"
+	"github.com/njcx/packetbeat7_dpdk/sniffer"
+)
+
+func genPcapExtractCommand(settings instance.Settings) *cobra.Command {
+	var (
+		communityID, from, to string
+		path, output          string
+	)
+	command := &cobra.Command{
+		Use:   "pcap-extract",
+		Short: "Extract the packets of a connection from the packet archive",
+		Long: `Extract the packets of the connection with the given community ID from the
+packet archive written by packetbeat.archive to a pcap file. The time range
+is given in RFC 3339 format, e.g. 2021-03-01T12:00:00Z.`,
+		Run: cli.RunWith(func(cmd *cobra.Command, args []string) error {
+			return pcapExtract(settings, communityID, from, to, path, output)
+		}),
+	}
+	command.Flags().StringVar(&communityID, "community-id", "", "Community ID of the connection")
+	command.Flags().StringVar(&from, "from", "", "Extract packets captured at or after this time")
+	command.Flags().StringVar(&to, "to", "", "Extract packets captured at or before this time")
+	command.Flags().StringVar(&path, "path", "", "Archive directory, defaults to the path configured in packetbeat.archive")
+	command.Flags().StringVarP(&output, "output", "o", "-", "File to write the packets to, - for stdout")
+	command.MarkFlagRequired("community-id")
+	return command
+}
+
+func pcapExtract(settings instance.Settings, communityID, from, to, path, output string) error {
+	fromTime, err := parseExtractTime(from)
+	if err != nil {
+		return fmt.Errorf("invalid --from: %v", err)
+	}
+	toTime, err := parseExtractTime(to)
+	if err != nil {
+		return fmt.Errorf("invalid --to: %v", err)
+	}
+
+	if path == "" {
+		path, err = configuredArchiveDir(settings)
+		if err != nil {
+			return err
+		}
+	}
+
+	var w io.Writer = os.Stdout
+	if output != "-" {
+		f, err := os.OpenFile(output, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	n, err := sniffer.ExtractArchive(path, communityID, fromTime, toTime, w)
+	if err != nil {
+		return fmt.Errorf("error extracting packets from %s: %v", path, err)
+	}
+	fmt.Fprintf(os.Stderr, "%d packets extracted\n", n)
+	return nil
+}
+
+func parseExtractTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339Nano, s)
+}
+
+// configuredArchiveDir loads the beat configuration and returns the archive
+// directory configured in it.
+func configuredArchiveDir(settings instance.Settings) (string, error) {
+	b, err := instance.NewInitializedBeat(settings)
+	if err != nil {
+		return "", fmt.Errorf("error initializing beat: %s", err)
+	}
+	beatConfig, err := b.BeatConfig()
+	if err != nil {
+		return "", err
+	}
+
+	var cfg config.Config
+	if beatConfig != nil {
+		if err := beatConfig.Unpack(&cfg); err != nil {
+			return "", err
+		}
+	}
+	return sniffer.ArchiveDir(cfg.Archive), nil
+}
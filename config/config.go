@@ -19,21 +19,31 @@ package config
 
 import (
 	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/njcx/libbeat_v7/common"
+	"github.com/njcx/libbeat_v7/conditions"
 	"github.com/njcx/libbeat_v7/processors"
 	"github.com/njcx/packetbeat7_dpdk/procs"
 )
 
 type Config struct {
 	Interfaces      InterfacesConfig          `config:"interfaces"`
+	InterfacesList  []InterfacesConfig        `config:"interfaces"`
 	Flows           *Flows                    `config:"flows"`
 	Protocols       map[string]*common.Config `config:"protocols"`
 	ProtocolsList   []*common.Config          `config:"protocols"`
 	Procs           procs.ProcsConfig         `config:"procs"`
 	IgnoreOutgoing  bool                      `config:"ignore_outgoing"`
 	ShutdownTimeout time.Duration             `config:"shutdown_timeout"`
+	Dump            DumpConfig                `config:"dump"`
+	Trigger         TriggerConfig             `config:"triggered_capture"`
+	Archive         ArchiveConfig             `config:"archive"`
+	Decoder         DecoderConfig             `config:"decoder"`
+	FilterAPI       FilterAPIConfig           `config:"bpf_filter_api"`
 }
 
 // FromStatic initializes a configuration given a common.Config
@@ -74,23 +84,411 @@ func (c Config) ICMP() (*common.Config, error) {
 	return icmp, nil
 }
 
+// InterfaceConfigs returns the configurations of all interfaces to capture
+// from. packetbeat.interfaces accepts a single interface or a list of
+// interfaces. Settings only available on the command line are applied to
+// all interfaces. Reading from a file given on the command line replaces
+// the configured interfaces.
+func (c Config) InterfaceConfigs() []InterfacesConfig {
+	if len(c.InterfacesList) == 0 || c.Interfaces.File != "" {
+		iface := c.Interfaces
+		iface.Dump = c.Dump
+		iface.Archive = c.Archive
+		iface.Tunnels = c.Decoder.Tunnels
+		return []InterfacesConfig{iface}
+	}
+
+	list := make([]InterfacesConfig, len(c.InterfacesList))
+	for i, iface := range c.InterfacesList {
+		iface.TopSpeed = c.Interfaces.TopSpeed
+		iface.OneAtATime = c.Interfaces.OneAtATime
+		iface.Loop = c.Interfaces.Loop
+		iface.Dumpfile = c.Interfaces.Dumpfile
+		if iface.Dumpfile != "" && len(c.InterfacesList) > 1 {
+			ext := filepath.Ext(iface.Dumpfile)
+			iface.Dumpfile = fmt.Sprintf("%s-%d%s", strings.TrimSuffix(iface.Dumpfile, ext), i, ext)
+		}
+		iface.Dump = c.Dump
+		if iface.Dump.Enabled && len(c.InterfacesList) > 1 {
+			name := iface.Dump.Filename
+			if name == "" {
+				name = "packetbeat"
+			}
+			iface.Dump.Filename = fmt.Sprintf("%s-%d", name, i)
+		}
+		iface.Archive = c.Archive
+		if iface.Archive.Enabled && len(c.InterfacesList) > 1 {
+			name := iface.Archive.Filename
+			if name == "" {
+				name = "packetbeat"
+			}
+			iface.Archive.Filename = fmt.Sprintf("%s-%d", name, i)
+		}
+		if iface.Watch.Enabled && len(c.InterfacesList) > 1 {
+			// each interface removes the files it does not see from its
+			// registry
+			name := iface.Watch.RegistryFile
+			if name == "" {
+				name = "packetbeat-files.json"
+			}
+			ext := filepath.Ext(name)
+			iface.Watch.RegistryFile = fmt.Sprintf("%s-%d%s", strings.TrimSuffix(name, ext), i, ext)
+		}
+		iface.Tunnels = c.Decoder.Tunnels
+		list[i] = iface
+	}
+	return list
+}
+
+// Validate checks the settings shared by the interfaces. The DPDK EAL is
+// initialized once per process, so all dpdk interfaces must pass the same
+// eal_args. The virtual clock is global to the process as well, the timers
+// of live interfaces would stop once the files replayed end. The process
+// watcher, dropping events on a full pipeline and the internal networks are
+// set for all interfaces, so interfaces can not mix files and live capture
+// and must have the same internal_networks.
+func (c Config) Validate() error {
+	ifaces := c.InterfaceConfigs()
+	if c.VirtualClock() {
+		for _, iface := range ifaces {
+			if iface.File == "" {
+				return errors.New("replay.virtual_clock requires all interfaces to read files")
+			}
+		}
+	}
+	for _, iface := range ifaces[1:] {
+		if (iface.File != "") != (ifaces[0].File != "") {
+			return errors.New("interfaces can not mix reading files and capturing live")
+		}
+		if !equalStrings(iface.InternalNetworks, ifaces[0].InternalNetworks) {
+			return fmt.Errorf("all interfaces must have the same internal_networks, got %v and %v",
+				ifaces[0].InternalNetworks, iface.InternalNetworks)
+		}
+	}
+
+	var ealArgs []string
+	dpdk := false
+	for _, iface := range ifaces {
+		if iface.Type != "dpdk" {
+			continue
+		}
+		if dpdk && !equalStrings(ealArgs, iface.Dpdk.EalArgs) {
+			return fmt.Errorf("all dpdk interfaces must have the same eal_args, got %v and %v",
+				ealArgs, iface.Dpdk.EalArgs)
+		}
+		ealArgs, dpdk = iface.Dpdk.EalArgs, true
+	}
+	return nil
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// ReadsFile returns true if the sniffers read from files instead of live
+// interfaces. Validate rejects interfaces mixing both.
+func (c Config) ReadsFile() bool {
+	return c.InterfaceConfigs()[0].File != ""
+}
+
+// VirtualClock returns true if any of the sniffers reading a file replays
+// it with a virtual clock. The clock is shared by all interfaces.
+func (c Config) VirtualClock() bool {
+	for _, iface := range c.InterfaceConfigs() {
+		if iface.File != "" && iface.Replay.VirtualClock {
+			return true
+		}
+	}
+	return false
+}
+
+// InternalNetworks returns the internal networks of the interfaces, which
+// Validate requires to be the same for all of them.
+func (c Config) InternalNetworks() []string {
+	return c.InterfaceConfigs()[0].InternalNetworks
+}
+
 type InterfacesConfig struct {
-	Device                string   `config:"device"`
-	Type                  string   `config:"type"`
-	File                  string   `config:"file"`
-	WithVlans             bool     `config:"with_vlans"`
-	BpfFilter             string   `config:"bpf_filter"`
-	Snaplen               int      `config:"snaplen"`
-	BufferSizeMb          int      `config:"buffer_size_mb"`
-	EnableAutoPromiscMode bool     `config:"auto_promisc_mode"`
-	InternalNetworks      []string `config:"internal_networks"`
-	DpdkOptions           []string `config:"dpdk_options"`
+	Device                string             `config:"device"`
+	Type                  string             `config:"type"`
+	File                  string             `config:"file"`
+	WithVlans             bool               `config:"with_vlans"`
+	BpfFilter             string             `config:"bpf_filter"`
+	Snaplen               int                `config:"snaplen"`
+	BufferSizeMb          int                `config:"buffer_size_mb"`
+	EnableAutoPromiscMode bool               `config:"auto_promisc_mode"`
+	InternalNetworks      []string           `config:"internal_networks"`
+	Dpdk                  DpdkConfig         `config:"dpdk"`
+	CaptureStats          CaptureStatsConfig `config:"capture_stats"`
+	Fanout                FanoutConfig       `config:"fanout"`
+	AfXdp                 AfXdpConfig        `config:"af_xdp"`
+	Watch                 FileWatchConfig    `config:"watch"`
+	Stream                StreamConfig       `config:"stream"`
+	Replay                ReplayConfig       `config:"replay"`
+	Sampling              SamplingConfig     `config:"sampling"`
+	DecodeQueue           DecodeQueueConfig  `config:"decode_queue"`
+	Dump                  DumpConfig         `config:",ignore"`
+	Archive               ArchiveConfig      `config:",ignore"`
+	Tunnels               TunnelsConfig      `config:",ignore"`
 	TopSpeed              bool
 	Dumpfile              string
 	OneAtATime            bool
 	Loop                  int
 }
 
+// DumpConfig configures writing the captured packets to pcapng or pcap
+// files, rotated by size and age. Set in packetbeat.dump, it applies to all
+// interfaces.
+type DumpConfig struct {
+	Enabled        bool          `config:"enabled"`
+	Path           string        `config:"path"`
+	Filename       string        `config:"filename"`
+	Format         string        `config:"format"`
+	RotateEveryKb  int           `config:"rotate_every_kb"`
+	RotateInterval time.Duration `config:"rotate_interval"`
+	NumberOfFiles  int           `config:"number_of_files"`
+	BpfFilter      string        `config:"bpf_filter"`
+	SensorName     string        `config:"sensor_name"`
+}
+
+// ArchiveConfig configures archiving the captured packets in pcap chunks,
+// indexed by community ID. Chunks are rotated by size and age, the oldest
+// chunks are removed once the archive exceeds max_size_mb. Set in
+// packetbeat.archive, it applies to all interfaces.
+type ArchiveConfig struct {
+	Enabled       bool          `config:"enabled"`
+	Path          string        `config:"path"`
+	Filename      string        `config:"filename"`
+	ChunkSizeMb   int           `config:"chunk_size_mb"`
+	ChunkInterval time.Duration `config:"chunk_interval"`
+	MaxSizeMb     int           `config:"max_size_mb"`
+}
+
+// TriggerConfig configures keeping the packets captured recently in memory.
+// Once an event matches the condition given in when, the packets of its
+// connection are written to a pcapng file referenced from the event.
+type TriggerConfig struct {
+	Enabled       bool               `config:"enabled"`
+	MaxAge        time.Duration      `config:"max_age"`
+	MaxSizeMb     int                `config:"max_size_mb"`
+	Path          string             `config:"path"`
+	NumberOfFiles int                `config:"number_of_files"`
+	When          *conditions.Config `config:"when"`
+}
+
+// CaptureStatsConfig configures the collection of capture statistics. The
+// statistics are always exposed through monitoring and are additionally
+// published as capture_stats events if enabled.
+type CaptureStatsConfig struct {
+	Enabled bool          `config:"enabled"`
+	Period  time.Duration `config:"period"`
+}
+
+// FileWatchConfig configures watching the directory or glob pattern given
+// as file for new capture files. Files read completely are recorded in the
+// registry file, such that they are not read again after a restart.
+type FileWatchConfig struct {
+	Enabled      bool          `config:"enabled"`
+	Interval     time.Duration `config:"interval"`
+	MinAge       time.Duration `config:"min_age"`
+	RegistryFile string        `config:"registry_file"`
+}
+
+// StreamConfig holds the settings of the stream sniffer type, which reads a
+// pcap or pcapng byte stream from stdin ("-"), a named pipe, a pcap-over-IP
+// server ("tcp://host:port") or from connections accepted on Listen.
+type StreamConfig struct {
+	Source     string        `config:"source"`
+	Listen     string        `config:"listen"`
+	Reconnect  *bool         `config:"reconnect"`
+	Backoff    time.Duration `config:"backoff"`
+	MaxBackoff time.Duration `config:"max_backoff"`
+}
+
+// ReconnectEnabled reports whether a new stream is awaited once a stream
+// ends. Reconnecting is enabled unless explicitly disabled. Streams read
+// from stdin end the sniffer.
+func (c StreamConfig) ReconnectEnabled() bool {
+	return c.Source != "-" && (c.Reconnect == nil || *c.Reconnect)
+}
+
+// ReplayConfig configures how packets read from files are replayed. Speed
+// scales the pace of the replay, 2 replays twice as fast as captured. If
+// RebaseTimestamps is set, packet timestamps are shifted to start at the
+// time the replay starts. With VirtualClock, transaction and flow timeouts
+// follow the timestamps of the packets instead of the wall clock, making
+// the results of a replay independent of its speed.
+type ReplayConfig struct {
+	Speed            float64 `config:"speed"`
+	RebaseTimestamps bool    `config:"rebase_timestamps"`
+	VirtualClock     bool    `config:"virtual_clock"`
+}
+
+// SamplingConfig configures sampling the packets read by a sniffer. In
+// packet mode, 1 in Rate packets is analyzed. In flow mode, packets are kept
+// or dropped by a hash of their 5-tuple, such that 1 in Rate connections is
+// analyzed with all its packets. Sampling is disabled for rates below 2.
+type SamplingConfig struct {
+	Mode string `config:"mode"`
+	Rate int    `config:"rate"`
+}
+
+// Enabled returns true if packets are sampled.
+func (c SamplingConfig) Enabled() bool {
+	return c.Rate > 1
+}
+
+// SampleMode returns the sampling mode, flow sampling unless configured.
+func (c SamplingConfig) SampleMode() string {
+	if c.Mode == "" {
+		return "flow"
+	}
+	return c.Mode
+}
+
+// DecodeQueueConfig configures the bounded queue between reading packets and
+// decoding them. Packets are copied into the queue and decoded by a separate
+// goroutine, such that bursts and slow protocol parsers do not stall
+// reading. Packets arriving while Size packets are queued are dropped.
+type DecodeQueueConfig struct {
+	Size int `config:"size"`
+}
+
+// Enabled returns true if packets are decoded from a queue.
+func (c DecodeQueueConfig) Enabled() bool {
+	return c.Size > 0
+}
+
+// FanoutConfig configures the PACKET_FANOUT group af_packet sockets join.
+// Sockets of the same group, in this or other processes, share the packets
+// received on the interface. With more than one worker, packetbeat opens
+// one socket per worker in the group.
+type FanoutConfig struct {
+	Group   *uint16 `config:"group"`
+	Mode    string  `config:"mode"`
+	Workers int     `config:"workers"`
+}
+
+// Enabled returns true if the af_packet sockets join a fanout group.
+func (c FanoutConfig) Enabled() bool {
+	return c.Group != nil || c.Workers > 1
+}
+
+// AfXdpConfig holds the settings of the af_xdp sniffer type. Packets
+// received on the configured queue of the device are redirected into an XDP
+// socket by a small XDP program attached when the sniffer starts.
+type AfXdpConfig struct {
+	Queue     int    `config:"queue"`
+	Mode      string `config:"mode"`
+	ZeroCopy  *bool  `config:"zero_copy"`
+	FrameSize int    `config:"frame_size"`
+	NumFrames int    `config:"num_frames"`
+	RingSize  int    `config:"ring_size"`
+}
+
+// DpdkConfig holds the settings of the dpdk sniffer type. The EAL is
+// initialized and the port is configured when the sniffer is started.
+type DpdkConfig struct {
+	EalArgs       []string `config:"eal_args"`
+	Port          uint16   `config:"port"`
+	PortName      string   `config:"port_name"`
+	Vdevs         []string `config:"vdevs"`
+	RxQueues      int      `config:"rx_queues"`
+	MbufPoolSize  int      `config:"mbuf_pool_size"`
+	RxDescriptors int      `config:"rx_descriptors"`
+	BurstSize     int      `config:"burst_size"`
+	Promiscuous   *bool    `config:"promiscuous"`
+	MTU           int      `config:"mtu"`
+}
+
+// PromiscEnabled reports whether the port is to be put into promiscuous
+// mode. Promiscuous mode is enabled unless explicitly disabled.
+func (c DpdkConfig) PromiscEnabled() bool {
+	return c.Promiscuous == nil || *c.Promiscuous
+}
+
+// FilterAPIConfig enables the HTTP endpoint replacing the BPF filters of
+// the running sniffers. The endpoint is not authenticated, so it is
+// disabled by default.
+type FilterAPIConfig struct {
+	Enabled bool `config:"enabled"`
+}
+
+// DecoderConfig configures the decoding of the packets captured on all
+// interfaces.
+type DecoderConfig struct {
+	Defrag  DefragConfig  `config:"defrag"`
+	Tunnels TunnelsConfig `config:"tunnels"`
+}
+
+// DefragConfig configures the reassembly of fragmented IPv4 and IPv6
+// packets. Fragments of at most MaxSizeMb are held at once per decoder.
+// Datagrams not completed within Timeout, split into more than MaxFragments
+// fragments or with overlapping fragments are dropped. Unset limits use the
+// defaults of the decoder.
+type DefragConfig struct {
+	Enabled      *bool         `config:"enabled"`
+	Timeout      time.Duration `config:"timeout"`
+	MaxSizeMb    int           `config:"max_size_mb"`
+	MaxFragments int           `config:"max_fragments"`
+}
+
+// IsEnabled returns true if fragments are reassembled, which is the
+// default.
+func (c DefragConfig) IsEnabled() bool {
+	return c.Enabled == nil || *c.Enabled
+}
+
+// Validate checks the limits of the reassembly.
+func (c DefragConfig) Validate() error {
+	if c.Timeout < 0 || c.MaxSizeMb < 0 || c.MaxFragments < 0 {
+		return errors.New("defrag timeout, max_size_mb and max_fragments must not be negative")
+	}
+	return nil
+}
+
+// TunnelsConfig configures the decapsulation of tunneled packets. VXLAN,
+// Geneve and GTP-U packets are recognized by their UDP destination port,
+// GRE, ERSPAN and IP-in-IP packets by their IP protocol. Unset port lists
+// use the ports assigned by IANA. At most MaxConnections tunneled
+// connections are tracked, the least recently seen one being evicted once
+// the limit is reached. Unset, it uses the default of the decoder.
+type TunnelsConfig struct {
+	Enabled        *bool `config:"enabled"`
+	VXLANPorts     []int `config:"vxlan_ports"`
+	GenevePorts    []int `config:"geneve_ports"`
+	GTPPorts       []int `config:"gtp_ports"`
+	MaxConnections int   `config:"max_connections"`
+}
+
+// IsEnabled returns true if tunnels are decapsulated, which is the default.
+func (c TunnelsConfig) IsEnabled() bool {
+	return c.Enabled == nil || *c.Enabled
+}
+
+// Validate checks the tunnel ports and the connection limit.
+func (c TunnelsConfig) Validate() error {
+	if c.MaxConnections < 0 {
+		return errors.New("tunnels max_connections must not be negative")
+	}
+	for _, ports := range [][]int{c.VXLANPorts, c.GenevePorts, c.GTPPorts} {
+		for _, port := range ports {
+			if port < 1 || port > 65535 {
+				return fmt.Errorf("invalid tunnel port %d, must be in range [1, 65535]", port)
+			}
+		}
+	}
+	return nil
+}
+
 type Flows struct {
 	Enabled       *bool                   `config:"enabled"`
 	Timeout       string                  `config:"timeout"`
@@ -0,0 +1,275 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package config
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/njcx/libbeat_v7/common"
+)
+
+func TestInterfaceConfigs(t *testing.T) {
+	t.Run("single interface", func(t *testing.T) {
+		cfg, err := common.NewConfigFrom(`
+interfaces:
+  device: eth0
+  type: af_packet
+`)
+		require.NoError(t, err)
+
+		c, err := Config{Interfaces: InterfacesConfig{TopSpeed: true}}.FromStatic(cfg)
+		require.NoError(t, err)
+
+		ifaces := c.InterfaceConfigs()
+		require.Len(t, ifaces, 1)
+		assert.Equal(t, "eth0", ifaces[0].Device)
+		assert.Equal(t, "af_packet", ifaces[0].Type)
+		assert.True(t, ifaces[0].TopSpeed)
+	})
+
+	t.Run("interface list", func(t *testing.T) {
+		cfg, err := common.NewConfigFrom(`
+interfaces:
+  - device: eth0
+    type: af_packet
+    bpf_filter: tcp
+    internal_networks: [private]
+  - device: eth1
+    snaplen: 1514
+    internal_networks: [private]
+`)
+		require.NoError(t, err)
+
+		c, err := Config{Interfaces: InterfacesConfig{Dumpfile: "out.pcap"}}.FromStatic(cfg)
+		require.NoError(t, err)
+
+		ifaces := c.InterfaceConfigs()
+		require.Len(t, ifaces, 2)
+		assert.Equal(t, "eth0", ifaces[0].Device)
+		assert.Equal(t, "tcp", ifaces[0].BpfFilter)
+		assert.Equal(t, "out-0.pcap", ifaces[0].Dumpfile)
+		assert.Equal(t, "eth1", ifaces[1].Device)
+		assert.Equal(t, 1514, ifaces[1].Snaplen)
+		assert.Equal(t, "out-1.pcap", ifaces[1].Dumpfile)
+		assert.Equal(t, []string{"private"}, c.InternalNetworks())
+		assert.False(t, c.ReadsFile())
+	})
+
+	t.Run("shared settings", func(t *testing.T) {
+		for name, yaml := range map[string]string{
+			"internal networks": `
+interfaces:
+  - device: eth0
+    internal_networks: [private]
+  - device: eth1
+`,
+			"file and live": `
+interfaces:
+  - file: in.pcap
+  - device: eth0
+`,
+		} {
+			cfg, err := common.NewConfigFrom(yaml)
+			require.NoError(t, err)
+			_, err = Config{}.FromStatic(cfg)
+			assert.Error(t, err, name)
+		}
+	})
+
+	t.Run("file from command line", func(t *testing.T) {
+		cfg, err := common.NewConfigFrom(`
+interfaces:
+  - device: eth0
+  - device: eth1
+`)
+		require.NoError(t, err)
+
+		c, err := Config{Interfaces: InterfacesConfig{File: "in.pcap"}}.FromStatic(cfg)
+		require.NoError(t, err)
+
+		ifaces := c.InterfaceConfigs()
+		require.Len(t, ifaces, 1)
+		assert.Equal(t, "in.pcap", ifaces[0].File)
+		assert.True(t, c.ReadsFile())
+	})
+
+	t.Run("dump", func(t *testing.T) {
+		cfg, err := common.NewConfigFrom(`
+interfaces:
+  - device: eth0
+  - device: eth1
+dump:
+  enabled: true
+  rotate_every_kb: 1024
+  number_of_files: 3
+`)
+		require.NoError(t, err)
+
+		c, err := Config{}.FromStatic(cfg)
+		require.NoError(t, err)
+
+		ifaces := c.InterfaceConfigs()
+		require.Len(t, ifaces, 2)
+		for i, iface := range ifaces {
+			assert.True(t, iface.Dump.Enabled)
+			assert.Equal(t, 1024, iface.Dump.RotateEveryKb)
+			assert.Equal(t, 3, iface.Dump.NumberOfFiles)
+			assert.Equal(t, fmt.Sprintf("packetbeat-%d", i), iface.Dump.Filename)
+		}
+
+		cfg, err = common.NewConfigFrom(`
+interfaces.device: eth0
+dump.enabled: true
+`)
+		require.NoError(t, err)
+
+		c, err = Config{}.FromStatic(cfg)
+		require.NoError(t, err)
+
+		ifaces = c.InterfaceConfigs()
+		require.Len(t, ifaces, 1)
+		assert.True(t, ifaces[0].Dump.Enabled)
+		assert.Equal(t, "", ifaces[0].Dump.Filename)
+	})
+
+	t.Run("archive", func(t *testing.T) {
+		cfg, err := common.NewConfigFrom(`
+interfaces:
+  - device: eth0
+  - device: eth1
+archive:
+  enabled: true
+  filename: archive
+  max_size_mb: 512
+`)
+		require.NoError(t, err)
+
+		c, err := Config{}.FromStatic(cfg)
+		require.NoError(t, err)
+
+		ifaces := c.InterfaceConfigs()
+		require.Len(t, ifaces, 2)
+		for i, iface := range ifaces {
+			assert.True(t, iface.Archive.Enabled)
+			assert.Equal(t, 512, iface.Archive.MaxSizeMb)
+			assert.Equal(t, fmt.Sprintf("archive-%d", i), iface.Archive.Filename)
+		}
+	})
+
+	t.Run("watch registry", func(t *testing.T) {
+		cfg, err := common.NewConfigFrom(`
+interfaces:
+  - file: /data/a
+    watch.enabled: true
+  - file: /data/b
+    watch:
+      enabled: true
+      registry_file: /var/lib/files.json
+  - file: /data/c.pcap
+`)
+		require.NoError(t, err)
+
+		c, err := Config{}.FromStatic(cfg)
+		require.NoError(t, err)
+
+		ifaces := c.InterfaceConfigs()
+		require.Len(t, ifaces, 3)
+		assert.Equal(t, "packetbeat-files-0.json", ifaces[0].Watch.RegistryFile)
+		assert.Equal(t, "/var/lib/files-1.json", ifaces[1].Watch.RegistryFile)
+		assert.Equal(t, "", ifaces[2].Watch.RegistryFile)
+	})
+
+	t.Run("tunnels", func(t *testing.T) {
+		cfg, err := common.NewConfigFrom(`
+interfaces:
+  - device: eth0
+  - device: eth1
+decoder.tunnels:
+  vxlan_ports: [8472]
+  max_connections: 1000
+`)
+		require.NoError(t, err)
+
+		c, err := Config{}.FromStatic(cfg)
+		require.NoError(t, err)
+
+		for _, iface := range c.InterfaceConfigs() {
+			assert.Equal(t, []int{8472}, iface.Tunnels.VXLANPorts)
+			assert.Equal(t, 1000, iface.Tunnels.MaxConnections)
+		}
+
+		cfg, err = common.NewConfigFrom(`decoder.tunnels.max_connections: -1`)
+		require.NoError(t, err)
+		_, err = Config{}.FromStatic(cfg)
+		assert.Error(t, err)
+	})
+
+	t.Run("virtual clock", func(t *testing.T) {
+		cfg, err := common.NewConfigFrom(`
+interfaces:
+  - file: a.pcap
+    replay.virtual_clock: true
+  - file: b.pcap
+`)
+		require.NoError(t, err)
+		c, err := Config{}.FromStatic(cfg)
+		require.NoError(t, err)
+		assert.True(t, c.VirtualClock())
+
+		// live interfaces would stop expiring transactions and flows
+		cfg, err = common.NewConfigFrom(`
+interfaces:
+  - file: a.pcap
+    replay.virtual_clock: true
+  - device: eth0
+`)
+		require.NoError(t, err)
+		_, err = Config{}.FromStatic(cfg)
+		assert.Error(t, err)
+	})
+
+	t.Run("dpdk eal_args", func(t *testing.T) {
+		cfg, err := common.NewConfigFrom(`
+interfaces:
+  - type: dpdk
+    dpdk.eal_args: [-l, "0-1"]
+  - type: dpdk
+    dpdk.eal_args: [-l, "0-1"]
+    dpdk.port: 1
+  - device: eth0
+`)
+		require.NoError(t, err)
+		_, err = Config{}.FromStatic(cfg)
+		assert.NoError(t, err)
+
+		cfg, err = common.NewConfigFrom(`
+interfaces:
+  - type: dpdk
+    dpdk.eal_args: [-l, "0-1"]
+  - type: dpdk
+    dpdk.eal_args: [-l, "2-3"]
+`)
+		require.NoError(t, err)
+		_, err = Config{}.FromStatic(cfg)
+		assert.Error(t, err)
+	})
+}
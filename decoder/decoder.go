@@ -21,6 +21,7 @@ import (
 	"fmt"
 
 	"github.com/njcx/libbeat_v7/logp"
+	"github.com/njcx/packetbeat7_dpdk/config"
 	"github.com/njcx/packetbeat7_dpdk/flows"
 	"github.com/njcx/packetbeat7_dpdk/protos"
 	"github.com/njcx/packetbeat7_dpdk/protos/icmp"
@@ -37,11 +38,12 @@ type Decoder struct {
 	decoders         map[gopacket_dpdk.LayerType]gopacket_dpdk.DecodingLayer
 	linkLayerDecoder gopacket_dpdk.DecodingLayer
 	linkLayerType    gopacket_dpdk.LayerType
+	linkDecoder      linkDecoder // decodes the link layer if linkLayerDecoder is nil
 
 	sll       layers.LinuxSLL
 	lo        layers.Loopback
 	eth       layers.Ethernet
-	d1q       [2]layers.Dot1Q
+	d1q       [2]layers.Dot1Q // used in turn, for any number of stacked tags
 	ip4       [2]layers.IPv4
 	ip6       [2]layers.IPv6
 	icmp4     layers.ICMPv4
@@ -52,6 +54,16 @@ type Decoder struct {
 
 	stD1Q, stIP4, stIP6 multiLayer
 
+	defrag *defragmenter // nil if fragments are not reassembled
+
+	tunnelPorts TunnelPorts  // nil if tunnels are not decapsulated
+	tunnels     *Tunnels     // tunnels of connections, for events
+	tunnel      packetTunnel // tunnel of the current packet
+
+	// frames and bytes the packet being decoded is accounted for in its
+	// flow, differing for the fragments of a datagram
+	flowFrames, flowBytes int
+
 	icmp4Proc icmp.ICMPv4Processor
 	icmp6Proc icmp.ICMPv6Processor
 	tcpProc   tcp.Processor
@@ -62,6 +74,11 @@ type Decoder struct {
 	statBytes      *flows.Uint
 	icmpV4TypeCode *flows.Uint
 	icmpV6TypeCode *flows.Uint
+	tunnelPort     *flows.Uint
+	tunnelDir      *flows.Uint
+	interfaceIndex *flows.Uint
+	mplsLabels     *flows.Uint
+	tunnelTEID     *flows.Uint
 
 	// hold current flow ID
 	flowID              *flows.FlowID // buffer flowID among many calls
@@ -73,6 +90,11 @@ const (
 	netBytesTotalCounter   = "bytes"
 	icmpV4TypeCodeValue    = "icmpV4TypeCode"
 	icmpV6TypeCodeValue    = "icmpV6TypeCode"
+	tunnelPortValue        = "tunnelPort"
+	tunnelDirectionValue   = "tunnelDirection"
+	interfaceIndexValue    = "interfaceIndex"
+	mplsLabelsValue        = "mplsLabels"
+	tunnelTEIDValue        = "tunnelTEID"
 )
 
 // New creates and initializes a new packet decoder.
@@ -91,6 +113,8 @@ func New(
 	d.stD1Q.init(&d.d1q[0], &d.d1q[1])
 	d.stIP4.init(&d.ip4[0], &d.ip4[1])
 	d.stIP6.init(&d.ip6[0], &d.ip6[1])
+	d.defrag = newDefragmenter(config.DefragConfig{})
+	d.tunnelPorts = NewTunnelPorts(config.TunnelsConfig{})
 
 	if f != nil {
 		var err error
@@ -110,6 +134,26 @@ func New(
 		if err != nil {
 			return nil, err
 		}
+		d.tunnelPort, err = f.NewUint(tunnelPortValue)
+		if err != nil {
+			return nil, err
+		}
+		d.tunnelDir, err = f.NewUint(tunnelDirectionValue)
+		if err != nil {
+			return nil, err
+		}
+		d.interfaceIndex, err = f.NewUint(interfaceIndexValue)
+		if err != nil {
+			return nil, err
+		}
+		d.mplsLabels, err = f.NewUint(mplsLabelsValue)
+		if err != nil {
+			return nil, err
+		}
+		d.tunnelTEID, err = f.NewUint(tunnelTEIDValue)
+		if err != nil {
+			return nil, err
+		}
 
 		d.flowID = &flows.FlowID{}
 	}
@@ -137,6 +181,18 @@ func New(
 	case layers.LinkTypeNull: // loopback on OSx
 		d.linkLayerDecoder = &d.lo
 		d.linkLayerType = layers.LayerTypeLoopback
+	case layers.LinkTypeRaw, DLTRaw: // tun devices
+		d.linkDecoder = d.decodeRawIP
+	case layers.LinkTypeIPv4:
+		d.linkLayerDecoder = &d.stIP4
+		d.linkLayerType = layers.LayerTypeIPv4
+	case layers.LinkTypeIPv6:
+		d.linkLayerDecoder = &d.stIP6
+		d.linkLayerType = layers.LayerTypeIPv6
+	case LinkTypeLinuxSLL2:
+		d.linkDecoder = d.decodeSLL2
+	case LinkTypeNFLog:
+		d.linkDecoder = d.decodeNFLog
 	default:
 		return nil, fmt.Errorf("Unsupported link type: %s", datalink.String())
 	}
@@ -144,6 +200,23 @@ func New(
 	return &d, nil
 }
 
+// SetDefragConfig configures the reassembly of IP fragments, which is
+// enabled with default limits by New.
+func (d *Decoder) SetDefragConfig(cfg config.DefragConfig) {
+	d.defrag = nil
+	if cfg.IsEnabled() {
+		d.defrag = newDefragmenter(cfg)
+	}
+}
+
+// SetTunnelConfig configures the decapsulation of tunnels, which is enabled
+// with the default ports by New. If tunnels is not nil, the tunnels, MPLS
+// labels and PPPoE sessions of connections are recorded in it.
+func (d *Decoder) SetTunnelConfig(cfg config.TunnelsConfig, tunnels *Tunnels) {
+	d.tunnelPorts = NewTunnelPorts(cfg)
+	d.tunnels = tunnels
+}
+
 func (d *Decoder) SetTruncated() {
 	d.truncated = true
 }
@@ -160,25 +233,39 @@ func (d *Decoder) AddLayers(layers []gopacket_dpdk.DecodingLayer) {
 	}
 }
 
+// OnPackets processes a batch of packets read by the sniffer at once.
+func (d *Decoder) OnPackets(data [][]byte, ci []gopacket_dpdk.CaptureInfo) {
+	for i := range data {
+		d.OnPacket(data[i], &ci[i])
+	}
+}
+
 func (d *Decoder) OnPacket(data []byte, ci *gopacket_dpdk.CaptureInfo) {
 	defer logp.Recover("packet decoding failed")
 
 	d.truncated = false
+	d.tunnel = packetTunnel{}
 
 	current := d.linkLayerDecoder
 	currentType := d.linkLayerType
 
 	packet := protos.Packet{Ts: ci.Timestamp}
+	d.flowFrames, d.flowBytes = 1, ci.Length
 
 	debugf("decode packet data")
 	processed := false
 
 	if d.flowID != nil {
 		d.flowID.Reset(d.flowIDBufferBacking[:0])
+	}
 
-		// suppress flow stats snapshots while processing packet
-		d.flows.Lock()
-		defer d.flows.Unlock()
+	if d.linkDecoder != nil {
+		typ, payload, ok := d.linkDecoder(data, &packet)
+		current, currentType, data = d.decoders[typ], typ, payload
+		if !ok || current == nil {
+			debugf("unsupported link layer payload")
+			data = nil
+		}
 	}
 
 	for len(data) > 0 {
@@ -188,10 +275,9 @@ func (d *Decoder) OnPacket(data []byte, ci *gopacket_dpdk.CaptureInfo) {
 			break
 		}
 
-		nextType := current.NextLayerType()
-		data = current.LayerPayload()
+		next := nextLayer{typ: current.NextLayerType(), data: current.LayerPayload()}
 
-		processed, err = d.process(&packet, currentType)
+		processed, err = d.process(&packet, currentType, &next)
 		if err != nil {
 			logp.Info("Error processing packet: %v", err)
 			break
@@ -201,14 +287,15 @@ func (d *Decoder) OnPacket(data []byte, ci *gopacket_dpdk.CaptureInfo) {
 		}
 
 		// choose next decoding layer
-		next, ok := d.decoders[nextType]
+		nextDecoder, ok := d.decoders[next.typ]
 		if !ok {
 			break
 		}
 
 		// jump to next layer
-		current = next
-		currentType = nextType
+		data = next.data
+		current = nextDecoder
+		currentType = next.typ
 	}
 
 	// add flow s.tats
@@ -216,16 +303,46 @@ func (d *Decoder) OnPacket(data []byte, ci *gopacket_dpdk.CaptureInfo) {
 		debugf("flow id flags: %v", d.flowID.Flags())
 	}
 
-	if d.flowID != nil && d.flowID.Flags() != 0 {
+	if d.flowID != nil && d.flowID.Flags() != 0 && d.flowFrames > 0 {
+		// the flows table is shared by the decoders of all queues, it is
+		// locked for the flow stats only, suppressing snapshots meanwhile
+		d.flows.Lock()
+		defer d.flows.Unlock()
+
 		flow := d.flows.Get(d.flowID)
-		d.statPackets.Add(flow, 1)
-		d.statBytes.Add(flow, uint64(ci.Length))
+		d.statPackets.Add(flow, uint64(d.flowFrames))
+		d.statBytes.Add(flow, uint64(d.flowBytes))
+		if d.tunnel.hasPort {
+			d.tunnelPort.Set(flow, uint64(d.tunnel.port))
+		}
+		if d.tunnel.direction != 0 {
+			d.tunnelDir.Set(flow, uint64(d.tunnel.direction))
+		}
+		if packet.InterfaceIndex != 0 {
+			d.interfaceIndex.Set(flow, uint64(packet.InterfaceIndex))
+		}
+		if d.tunnel.nMPLS > 0 {
+			d.mplsLabels.Set(flow, flows.EncodeMPLSLabels(d.tunnel.mpls[:d.tunnel.nMPLS]))
+		}
+		if d.tunnel.typ == flows.TunnelGTP {
+			d.tunnelTEID.Set(flow, uint64(d.tunnel.id))
+		}
 	}
 }
 
+// nextLayer is the type and the payload of the layer to decode next.
+type nextLayer struct {
+	typ  gopacket_dpdk.LayerType
+	data []byte
+}
+
+// process handles a decoded layer. It returns true once the packet has been
+// processed. Layers may replace the next layer to decode, e.g. with the
+// payload of a reassembled datagram.
 func (d *Decoder) process(
 	packet *protos.Packet,
 	layerType gopacket_dpdk.LayerType,
+	next *nextLayer,
 ) (bool, error) {
 	withFlow := d.flowID != nil
 
@@ -234,6 +351,7 @@ func (d *Decoder) process(
 		if withFlow {
 			d.flowID.AddEth(d.eth.SrcMAC, d.eth.DstMAC)
 		}
+		return d.onEtherType(d.eth.EthernetType, next), nil
 
 	case layers.LayerTypeDot1Q:
 		d1q := &d.d1q[d.stD1Q.i]
@@ -241,6 +359,7 @@ func (d *Decoder) process(
 		if withFlow {
 			d.flowID.AddVLan(d1q.VLANIdentifier)
 		}
+		return d.onEtherType(d1q.Type, next), nil
 
 	case layers.LayerTypeIPv4:
 		debugf("IPv4 packet")
@@ -255,6 +374,15 @@ func (d *Decoder) process(
 		packet.Tuple.DstIP = ip4.DstIP
 		packet.Tuple.IPLength = 4
 
+		if next.typ == gopacket_dpdk.LayerTypeFragment {
+			key := ipv4Key(ip4.SrcIP.To4(), ip4.DstIP.To4(), ip4.Id, uint8(ip4.Protocol))
+			more := ip4.Flags&layers.IPv4MoreFragments != 0
+			if d.onFragment(key, int(ip4.FragOffset)*8, more, next, packet) {
+				return true, nil
+			}
+		}
+		return d.decapIP(false, packet, next), nil
+
 	case layers.LayerTypeIPv6:
 		debugf("IPv6 packet")
 		ip6 := &d.ip6[d.stIP6.i]
@@ -268,6 +396,19 @@ func (d *Decoder) process(
 		packet.Tuple.DstIP = ip6.DstIP
 		packet.Tuple.IPLength = 16
 
+		if next.typ == layers.LayerTypeIPv6Fragment {
+			key, offset, more, payload, ok := ipv6Fragment(ip6.SrcIP, ip6.DstIP, next.data)
+			if !ok {
+				defragMalformed.Inc()
+				return true, nil
+			}
+			next.data = payload
+			if d.onFragment(key, offset, more, next, packet) {
+				return true, nil
+			}
+		}
+		return d.decapIP(true, packet, next), nil
+
 	case layers.LayerTypeICMPv4:
 		debugf("ICMPv4 packet")
 		d.onICMPv4(packet)
@@ -280,6 +421,9 @@ func (d *Decoder) process(
 
 	case layers.LayerTypeUDP:
 		debugf("UDP packet")
+		if d.decapUDP(packet, next) {
+			return false, nil
+		}
 		d.onUDP(packet)
 		return true, nil
 
@@ -292,11 +436,36 @@ func (d *Decoder) process(
 	return false, nil
 }
 
+// onFragment adds an IP fragment to the defragmenter. Once the datagram is
+// complete, its payload is decoded next and its flow accounts for all its
+// fragments. It returns true if the packet has been processed.
+func (d *Decoder) onFragment(key fragmentKey, offset int, more bool, next *nextLayer, packet *protos.Packet) bool {
+	if d.defrag == nil {
+		return true
+	}
+
+	datagram, ok := d.defrag.add(key, offset, more, next.data, packet.Ts, d.flowBytes)
+	if !ok {
+		// the fragment is accounted for once the datagram is complete
+		d.flowFrames = 0
+		return true
+	}
+
+	debugf("Reassembled datagram of %d bytes from %d fragments", len(datagram.payload), datagram.frames)
+	next.typ = layers.IPProtocol(datagram.proto).LayerType()
+	next.data = datagram.payload
+	d.flowFrames, d.flowBytes = datagram.frames, datagram.bytes
+	return false
+}
+
 func (d *Decoder) onICMPv4(packet *protos.Packet) {
 	if d.flowID != nil {
+		d.flows.Lock()
 		flow := d.flows.Get(d.flowID)
 		d.icmpV4TypeCode.Set(flow, uint64(d.icmp4.TypeCode))
+		d.flows.Unlock()
 	}
+	d.recordTunnel("icmp", packet)
 
 	if d.icmp4Proc != nil {
 		packet.Payload = d.icmp4.Payload
@@ -307,9 +476,12 @@ func (d *Decoder) onICMPv4(packet *protos.Packet) {
 
 func (d *Decoder) onICMPv6(packet *protos.Packet) {
 	if d.flowID != nil {
+		d.flows.Lock()
 		flow := d.flows.Get(d.flowID)
 		d.icmpV6TypeCode.Set(flow, uint64(d.icmp6.TypeCode))
+		d.flows.Unlock()
 	}
+	d.recordTunnel("ipv6-icmp", packet)
 
 	if d.icmp6Proc != nil {
 		packet.Payload = d.icmp6.Payload
@@ -331,6 +503,7 @@ func (d *Decoder) onUDP(packet *protos.Packet) {
 	packet.Tuple.DstPort = dst
 	packet.Payload = d.udp.Payload
 	packet.Tuple.ComputeHashables()
+	d.recordTunnel("udp", packet)
 
 	d.udpProc.Process(id, packet)
 }
@@ -347,6 +520,7 @@ func (d *Decoder) onTCP(packet *protos.Packet) {
 	packet.Tuple.SrcPort = src
 	packet.Tuple.DstPort = dst
 	packet.Payload = d.tcp.Payload
+	d.recordTunnel("tcp", packet)
 
 	if id == nil && len(packet.Payload) == 0 && !d.tcp.FIN {
 		// We have no use for this atm.
@@ -22,10 +22,13 @@ package decoder
 
 import (
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/njcx/libbeat_v7/logp"
+	"github.com/njcx/packetbeat7_dpdk/config"
 	"github.com/njcx/packetbeat7_dpdk/flows"
+	"github.com/njcx/packetbeat7_dpdk/procs"
 	"github.com/njcx/packetbeat7_dpdk/protos"
 
 	"github.com/njcx/gopacket_dpdk"
@@ -64,11 +67,13 @@ func (l *TestTCPProcessor) Process(id *flows.FlowID, tcphdr *layers.TCP, pkt *pr
 }
 
 type TestUDPProcessor struct {
-	pkt *protos.Packet
+	pkt    *protos.Packet
+	flowID *flows.FlowID // of the decoder, valid until the next packet
 }
 
 func (l *TestUDPProcessor) Process(id *flows.FlowID, pkt *protos.Packet) {
 	l.pkt = pkt
+	l.flowID = id
 }
 
 // 172.16.16.164:1108 172.16.16.139:53 DNS 87  Standard query 0x0007  AXFR contoso.local
@@ -198,6 +203,39 @@ func TestDecodePacketData_ipv6Udp(t *testing.T) {
 	assert.NotEqual(t, -1, strings.Index(string(p.Data()), string(udp.pkt.Payload)))
 }
 
+// newFlowsTestDecoder creates a decoder of ethernet packets reporting flows
+// to f.
+func newFlowsTestDecoder(t *testing.T, f *flows.Flows) (*Decoder, *TestTCPProcessor, *TestUDPProcessor) {
+	tcp, udp := &TestTCPProcessor{}, &TestUDPProcessor{}
+	d, err := New(f, layers.LinkTypeEthernet, &TestIcmp4Processor{}, &TestIcmp6Processor{}, tcp, udp)
+	if err != nil {
+		t.Fatalf("Error creating decoder %v", err)
+	}
+	return d, tcp, udp
+}
+
+func TestDecodePacketData_sharedFlows(t *testing.T) {
+	f, err := flows.NewFlows(nil, procs.ProcessesWatcher{}, &config.Flows{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the decoders of the queues of an interface decode concurrently
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		d, _, _ := newFlowsTestDecoder(t, f)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				d.OnPacket(ipv4UdpDNS, &gopacket_dpdk.CaptureInfo{Length: len(ipv4UdpDNS)})
+				d.OnPacket(ipv4TcpDNS, &gopacket_dpdk.CaptureInfo{Length: len(ipv4TcpDNS)})
+			}
+		}()
+	}
+	wg.Wait()
+}
+
 // Creates a new TestDecoder that handles ethernet packets.
 func newTestDecoder(t *testing.T) (*Decoder, *TestTCPProcessor, *TestUDPProcessor) {
 	icmp4Layer := &TestIcmp4Processor{}
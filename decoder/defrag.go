@@ -0,0 +1,256 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package decoder
+
+import (
+	"bytes"
+	"container/list"
+	"encoding/binary"
+	"sort"
+	"time"
+
+	"github.com/njcx/libbeat_v7/monitoring"
+
+	"github.com/njcx/packetbeat7_dpdk/config"
+)
+
+const (
+	defaultDefragTimeout      = 30 * time.Second
+	defaultDefragMaxSize      = 4 * 1024 * 1024
+	defaultDefragMaxFragments = 64
+
+	// maximum size of the payload of a reassembled datagram
+	maxDatagramSize = 65535
+)
+
+var (
+	defragReassembled = monitoring.NewInt(nil, "decoder.defrag.reassembled")
+	defragTimedOut    = monitoring.NewInt(nil, "decoder.defrag.timed_out")
+	defragMalformed   = monitoring.NewInt(nil, "decoder.defrag.malformed")
+	defragEvicted     = monitoring.NewInt(nil, "decoder.defrag.evicted")
+)
+
+// defragmenter reassembles fragmented IPv4 and IPv6 datagrams. Fragments
+// are held until their datagram is complete, its timeout expires or the
+// space of newer fragments is needed. Datagrams with fragments overlapping
+// each other, other than exact duplicates, are dropped, as their payload
+// depends on the reassembly policy of the receiver.
+type defragmenter struct {
+	timeout      time.Duration
+	maxSize      int
+	maxFragments int
+
+	datagrams map[fragmentKey]*datagram
+	lru       list.List // datagrams, ordered by the arrival of their first fragment
+	size      int       // bytes of all fragments held
+}
+
+// fragmentKey identifies the fragments of a datagram.
+type fragmentKey struct {
+	src, dst [16]byte
+	id       uint32
+	proto    uint8
+	v6       bool
+}
+
+type datagram struct {
+	key       fragmentKey
+	created   time.Time
+	fragments []fragment // ordered by offset, not overlapping
+	size      int        // bytes of the fragments
+	length    int        // payload length, -1 until the last fragment is seen
+	frames    int        // frames the fragments have been captured in
+	bytes     int        // bytes of the frames
+	elem      *list.Element
+}
+
+type fragment struct {
+	offset int
+	data   []byte
+}
+
+// reassembled is the payload of a datagram reassembled from frames frames
+// of bytes bytes.
+type reassembled struct {
+	payload []byte
+	proto   uint8
+	frames  int
+	bytes   int
+}
+
+func newDefragmenter(cfg config.DefragConfig) *defragmenter {
+	d := &defragmenter{
+		timeout:      cfg.Timeout,
+		maxSize:      cfg.MaxSizeMb * 1024 * 1024,
+		maxFragments: cfg.MaxFragments,
+		datagrams:    map[fragmentKey]*datagram{},
+	}
+	if d.timeout <= 0 {
+		d.timeout = defaultDefragTimeout
+	}
+	if d.maxSize <= 0 {
+		d.maxSize = defaultDefragMaxSize
+	}
+	if d.maxFragments <= 0 {
+		d.maxFragments = defaultDefragMaxFragments
+	}
+	return d
+}
+
+// ipv4Key returns the key of an IPv4 fragment.
+func ipv4Key(src, dst []byte, id uint16, proto uint8) fragmentKey {
+	k := fragmentKey{id: uint32(id), proto: proto}
+	copy(k.src[:], src)
+	copy(k.dst[:], dst)
+	return k
+}
+
+// ipv6Fragment parses the IPv6 fragment header at the start of data.
+func ipv6Fragment(src, dst []byte, data []byte) (key fragmentKey, offset int, more bool, payload []byte, ok bool) {
+	if len(data) < 8 {
+		return key, 0, false, nil, false
+	}
+	key = fragmentKey{
+		id:    binary.BigEndian.Uint32(data[4:8]),
+		proto: data[0],
+		v6:    true,
+	}
+	copy(key.src[:], src)
+	copy(key.dst[:], dst)
+
+	offsetFlags := binary.BigEndian.Uint16(data[2:4])
+	return key, int(offsetFlags>>3) * 8, offsetFlags&1 != 0, data[8:], true
+}
+
+// add adds a fragment of frameLen bytes captured at ts. If the fragment
+// completes its datagram, the reassembled payload is returned.
+func (d *defragmenter) add(key fragmentKey, offset int, more bool, data []byte, ts time.Time, frameLen int) (reassembled, bool) {
+	d.expire(ts)
+
+	dg := d.datagrams[key]
+	if !d.valid(dg, offset, more, data) {
+		defragMalformed.Inc()
+		if dg != nil {
+			d.remove(dg)
+		}
+		return reassembled{}, false
+	}
+
+	if dg == nil {
+		dg = &datagram{key: key, created: ts, length: -1}
+		dg.elem = d.lru.PushBack(dg)
+		d.datagrams[key] = dg
+	}
+	dg.frames++
+	dg.bytes += frameLen
+
+	i := sort.Search(len(dg.fragments), func(i int) bool {
+		return dg.fragments[i].offset >= offset
+	})
+	if i < len(dg.fragments) && dg.fragments[i].offset == offset && bytes.Equal(dg.fragments[i].data, data) {
+		// retransmitted fragment
+		return reassembled{}, false
+	}
+	end := offset + len(data)
+	if (i > 0 && dg.fragments[i-1].offset+len(dg.fragments[i-1].data) > offset) ||
+		(i < len(dg.fragments) && dg.fragments[i].offset < end) ||
+		len(dg.fragments) >= d.maxFragments {
+		defragMalformed.Inc()
+		d.remove(dg)
+		return reassembled{}, false
+	}
+
+	// make room, dropping the oldest datagrams
+	for d.size+len(data) > d.maxSize {
+		oldest := d.lru.Front().Value.(*datagram)
+		defragEvicted.Inc()
+		d.remove(oldest)
+		if oldest == dg {
+			return reassembled{}, false
+		}
+	}
+
+	// the buffer of the packet is reused once the packet is processed
+	frag := fragment{offset: offset, data: append([]byte(nil), data...)}
+	dg.fragments = append(dg.fragments, fragment{})
+	copy(dg.fragments[i+1:], dg.fragments[i:])
+	dg.fragments[i] = frag
+	dg.size += len(data)
+	d.size += len(data)
+	if !more {
+		dg.length = end
+	}
+
+	// fragments do not overlap, they cover the datagram once their size
+	// matches its length
+	if dg.size != dg.length {
+		return reassembled{}, false
+	}
+
+	payload := make([]byte, 0, dg.length)
+	for _, f := range dg.fragments {
+		payload = append(payload, f.data...)
+	}
+	d.remove(dg)
+	defragReassembled.Inc()
+	return reassembled{payload: payload, proto: key.proto, frames: dg.frames, bytes: dg.bytes}, true
+}
+
+// valid checks a fragment against the length of its datagram, if known.
+func (d *defragmenter) valid(dg *datagram, offset int, more bool, data []byte) bool {
+	end := offset + len(data)
+	if len(data) == 0 || end > maxDatagramSize {
+		return false
+	}
+	if more && len(data)%8 != 0 {
+		// all but the last fragment are multiples of 8 bytes
+		return false
+	}
+	if dg == nil {
+		return true
+	}
+
+	if dg.length >= 0 && (end > dg.length || (!more && end != dg.length)) {
+		return false
+	}
+	if !more && len(dg.fragments) > 0 {
+		last := dg.fragments[len(dg.fragments)-1]
+		if last.offset+len(last.data) > end {
+			return false
+		}
+	}
+	return true
+}
+
+// expire drops the datagrams not completed within the timeout.
+func (d *defragmenter) expire(now time.Time) {
+	for e := d.lru.Front(); e != nil; e = d.lru.Front() {
+		dg := e.Value.(*datagram)
+		if now.Sub(dg.created) < d.timeout {
+			return
+		}
+		defragTimedOut.Inc()
+		d.remove(dg)
+	}
+}
+
+func (d *defragmenter) remove(dg *datagram) {
+	d.lru.Remove(dg.elem)
+	delete(d.datagrams, dg.key)
+	d.size -= dg.size
+}
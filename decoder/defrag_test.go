@@ -0,0 +1,206 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !integration
+// +build !integration
+
+package decoder
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/njcx/gopacket_dpdk"
+
+	"github.com/njcx/packetbeat7_dpdk/config"
+)
+
+// fragmentIPv4 splits the IPv4 packet of an ethernet frame into two
+// fragments, the first one carrying at bytes of the payload.
+func fragmentIPv4(frame []byte, at int) [][]byte {
+	const hdr = 14 + 20
+	payload := frame[hdr:]
+
+	build := func(offset int, data []byte, more bool) []byte {
+		f := append(append([]byte(nil), frame[:hdr]...), data...)
+		binary.BigEndian.PutUint16(f[14+2:], uint16(20+len(data)))
+		flags := uint16(offset / 8)
+		if more {
+			flags |= 0x2000
+		}
+		binary.BigEndian.PutUint16(f[14+6:], flags)
+		return f
+	}
+	return [][]byte{build(0, payload[:at], true), build(at, payload[at:], false)}
+}
+
+// fragmentIPv6 splits the IPv6 packet of an ethernet frame into two
+// fragments, the first one carrying at bytes of the payload.
+func fragmentIPv6(frame []byte, at int) [][]byte {
+	const hdr = 14 + 40
+	payload := frame[hdr:]
+	nextHeader := frame[14+6]
+
+	build := func(offset int, data []byte, more bool) []byte {
+		f := append([]byte(nil), frame[:hdr]...)
+		f[14+6] = 44
+		frag := make([]byte, 8)
+		frag[0] = nextHeader
+		offsetFlags := uint16(offset/8) << 3
+		if more {
+			offsetFlags |= 1
+		}
+		binary.BigEndian.PutUint16(frag[2:], offsetFlags)
+		binary.BigEndian.PutUint32(frag[4:], 0xcafe)
+		f = append(append(f, frag...), data...)
+		binary.BigEndian.PutUint16(f[14+4:], uint16(8+len(data)))
+		return f
+	}
+	return [][]byte{build(0, payload[:at], true), build(at, payload[at:], false)}
+}
+
+func TestDecodePacketData_ipv4Fragments(t *testing.T) {
+	for name, reverse := range map[string]bool{"in order": false, "reversed": true} {
+		t.Run(name, func(t *testing.T) {
+			d, _, udp := newTestDecoder(t)
+			frags := fragmentIPv4(ipv4UdpDNS, 24)
+			if reverse {
+				frags[0], frags[1] = frags[1], frags[0]
+			}
+
+			d.OnPacket(frags[0], &gopacket_dpdk.CaptureInfo{Length: len(frags[0])})
+			assert.Nil(t, udp.pkt, "datagram incomplete")
+			d.OnPacket(frags[1], &gopacket_dpdk.CaptureInfo{Length: len(frags[1])})
+
+			if assert.NotNil(t, udp.pkt, "UDP packet not received") {
+				assert.Equal(t, "192.168.170.8", udp.pkt.Tuple.SrcIP.String())
+				assert.Equal(t, uint16(32795), udp.pkt.Tuple.SrcPort)
+				assert.Equal(t, uint16(53), udp.pkt.Tuple.DstPort)
+				assert.Equal(t, ipv4UdpDNS[14+20+8:], udp.pkt.Payload)
+			}
+		})
+	}
+}
+
+func TestDecodePacketData_ipv6Fragments(t *testing.T) {
+	d, _, udp := newTestDecoder(t)
+	frags := fragmentIPv6(ipv6UdpDNS, 48)
+
+	d.OnPacket(frags[0], &gopacket_dpdk.CaptureInfo{Length: len(frags[0])})
+	assert.Nil(t, udp.pkt, "datagram incomplete")
+	d.OnPacket(frags[1], &gopacket_dpdk.CaptureInfo{Length: len(frags[1])})
+
+	if assert.NotNil(t, udp.pkt, "UDP packet not received") {
+		assert.Equal(t, "3ffe:507:0:1:200:86ff:fe05:80da", udp.pkt.Tuple.SrcIP.String())
+		assert.Equal(t, uint16(2415), udp.pkt.Tuple.SrcPort)
+		assert.Equal(t, uint16(53), udp.pkt.Tuple.DstPort)
+		assert.Equal(t, ipv6UdpDNS[14+40+8:], udp.pkt.Payload)
+	}
+}
+
+func TestDecodePacketData_defragDisabled(t *testing.T) {
+	d, _, udp := newTestDecoder(t)
+	disabled := false
+	d.SetDefragConfig(config.DefragConfig{Enabled: &disabled})
+
+	for _, f := range fragmentIPv4(ipv4UdpDNS, 24) {
+		d.OnPacket(f, &gopacket_dpdk.CaptureInfo{Length: len(f)})
+	}
+	assert.Nil(t, udp.pkt)
+}
+
+func TestDefragmenter(t *testing.T) {
+	ts := time.Now()
+	key := ipv4Key([]byte{10, 0, 0, 1}, []byte{10, 0, 0, 2}, 1, 17)
+	data := make([]byte, 24)
+	for i := range data {
+		data[i] = byte(i)
+	}
+
+	t.Run("duplicates", func(t *testing.T) {
+		d := newDefragmenter(config.DefragConfig{})
+		_, ok := d.add(key, 0, true, data[:8], ts, 50)
+		assert.False(t, ok)
+		_, ok = d.add(key, 0, true, data[:8], ts, 50)
+		assert.False(t, ok)
+		_, ok = d.add(key, 16, false, data[16:], ts, 50)
+		assert.False(t, ok)
+		r, ok := d.add(key, 8, true, data[8:16], ts, 50)
+		assert.True(t, ok)
+		assert.Equal(t, data, r.payload)
+		assert.Equal(t, 4, r.frames)
+		assert.Equal(t, 200, r.bytes)
+		assert.Empty(t, d.datagrams)
+		assert.Equal(t, 0, d.size)
+	})
+
+	t.Run("overlap", func(t *testing.T) {
+		d := newDefragmenter(config.DefragConfig{})
+		malformed := defragMalformed.Get()
+		d.add(key, 0, true, data[:16], ts, 50)
+		_, ok := d.add(key, 8, false, []byte("overlapping data"), ts, 50)
+		assert.False(t, ok)
+		assert.Equal(t, malformed+1, defragMalformed.Get())
+		assert.Empty(t, d.datagrams)
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		d := newDefragmenter(config.DefragConfig{})
+		malformed := defragMalformed.Get()
+		d.add(key, 0, true, data[:5], ts, 50)       // not a multiple of 8
+		d.add(key, 65528, false, data[:16], ts, 50) // beyond the maximum size
+		d.add(key, 8, false, data[:8], ts, 50)      // ends at 16
+		d.add(key, 16, true, data[:8], ts, 50)      // beyond the end
+		assert.Equal(t, malformed+3, defragMalformed.Get())
+		assert.Empty(t, d.datagrams)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		d := newDefragmenter(config.DefragConfig{Timeout: time.Second})
+		timedOut := defragTimedOut.Get()
+		d.add(key, 0, true, data[:8], ts, 50)
+		_, ok := d.add(key, 8, false, data[8:], ts.Add(2*time.Second), 50)
+		assert.False(t, ok)
+		assert.Equal(t, timedOut+1, defragTimedOut.Get())
+		assert.Len(t, d.datagrams, 1)
+	})
+
+	t.Run("max size", func(t *testing.T) {
+		d := newDefragmenter(config.DefragConfig{})
+		d.maxSize = 16
+		evicted := defragEvicted.Get()
+		other := ipv4Key([]byte{10, 0, 0, 1}, []byte{10, 0, 0, 2}, 2, 17)
+		d.add(key, 0, true, data[:8], ts, 50)
+		d.add(other, 0, true, data[:16], ts, 50)
+		assert.Equal(t, evicted+1, defragEvicted.Get())
+		assert.Len(t, d.datagrams, 1)
+		assert.NotNil(t, d.datagrams[other])
+		assert.Equal(t, 16, d.size)
+	})
+
+	t.Run("max fragments", func(t *testing.T) {
+		d := newDefragmenter(config.DefragConfig{MaxFragments: 2})
+		d.add(key, 0, true, data[:8], ts, 50)
+		d.add(key, 8, true, data[8:16], ts, 50)
+		_, ok := d.add(key, 16, false, data[16:], ts, 50)
+		assert.False(t, ok)
+		assert.Empty(t, d.datagrams)
+	})
+}
@@ -0,0 +1,253 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package decoder
+
+import (
+	"encoding/binary"
+
+	"github.com/njcx/gopacket_dpdk"
+	"github.com/njcx/gopacket_dpdk/layers"
+
+	"github.com/njcx/packetbeat7_dpdk/flows"
+	"github.com/njcx/packetbeat7_dpdk/protos"
+)
+
+// GRE flags and protocol types
+const (
+	greChecksum = 0x8000
+	greRouting  = 0x4000
+	greKey      = 0x2000
+	greSequence = 0x1000
+	greVersion  = 0x0007
+
+	greProtoERSPAN2 = 0x88be // ERSPAN type I and II
+	greProtoERSPAN3 = 0x22eb
+
+	sizeGREHeader     = 4
+	sizeERSPAN2Header = 8
+	sizeERSPAN3Header = 12
+	sizeERSPAN3SubHdr = 8
+)
+
+// greHeader is a parsed GRE header, see RFC 2784 and RFC 2890.
+type greHeader struct {
+	proto   uint16
+	key     uint32 // 0 if not present
+	hasSeq  bool
+	payload []byte
+}
+
+// parseGRE parses the GRE header at the start of data. Only version 0
+// without source routing is supported.
+func parseGRE(data []byte) (hdr greHeader, ok bool) {
+	if len(data) < sizeGREHeader {
+		return hdr, false
+	}
+	flags := binary.BigEndian.Uint16(data[0:2])
+	if flags&(greVersion|greRouting) != 0 {
+		return hdr, false
+	}
+	hdr.proto = binary.BigEndian.Uint16(data[2:4])
+
+	n := sizeGREHeader
+	if flags&greChecksum != 0 {
+		n += 4
+	}
+	if flags&greKey != 0 {
+		if len(data) < n+4 {
+			return hdr, false
+		}
+		hdr.key = binary.BigEndian.Uint32(data[n : n+4])
+		n += 4
+	}
+	if flags&greSequence != 0 {
+		hdr.hasSeq = true
+		n += 4
+	}
+	if len(data) < n {
+		return hdr, false
+	}
+	hdr.payload = data[n:]
+	return hdr, true
+}
+
+// erspanHeader is a parsed ERSPAN header of type II or III.
+type erspanHeader struct {
+	session   uint32
+	port      uint32
+	hasPort   bool
+	direction flows.TunnelDirection
+	ip        bool // the mirrored frame is an IP packet instead of an ethernet frame
+	payload   []byte
+}
+
+// parseERSPAN2 parses the ERSPAN type II header at the start of data. The
+// index of the header identifies the port the packet has been mirrored at.
+func parseERSPAN2(data []byte) (hdr erspanHeader, ok bool) {
+	if len(data) < sizeERSPAN2Header || data[0]>>4 != 1 {
+		return hdr, false
+	}
+	hdr.session = uint32(binary.BigEndian.Uint16(data[2:4]) & 0x3ff)
+	hdr.port, hdr.hasPort = binary.BigEndian.Uint32(data[4:8])&0xfffff, true
+	hdr.payload = data[sizeERSPAN2Header:]
+	return hdr, true
+}
+
+// parseERSPAN3 parses the ERSPAN type III header at the start of data. The
+// port is reported by the platform specific subheader of some platforms
+// only.
+func parseERSPAN3(data []byte) (hdr erspanHeader, ok bool) {
+	if len(data) < sizeERSPAN3Header || data[0]>>4 != 2 {
+		return hdr, false
+	}
+	hdr.session = uint32(binary.BigEndian.Uint16(data[2:4]) & 0x3ff)
+
+	flags := binary.BigEndian.Uint16(data[10:12])
+	hdr.direction = flows.TunnelIngress
+	if flags&0x0008 != 0 {
+		hdr.direction = flows.TunnelEgress
+	}
+	switch frameType := (flags >> 10) & 0x1f; frameType {
+	case 0:
+	case 2:
+		hdr.ip = true
+	default:
+		return hdr, false
+	}
+
+	n := sizeERSPAN3Header
+	if flags&0x0001 != 0 {
+		if len(data) < n+sizeERSPAN3SubHdr {
+			return hdr, false
+		}
+		sub := data[n : n+sizeERSPAN3SubHdr]
+		switch platform := sub[0] >> 2; platform {
+		case 3, 5, 6:
+			hdr.port, hdr.hasPort = uint32(binary.BigEndian.Uint16(sub[2:4])), true
+		}
+		n += sizeERSPAN3SubHdr
+	}
+	hdr.payload = data[n:]
+	return hdr, true
+}
+
+// parseERSPAN parses the ERSPAN header of a GRE packet, returning the
+// header and the type of the mirrored frame. Type I sessions have no ERSPAN
+// header, they are recognized by the GRE header not having a sequence
+// number.
+func parseERSPAN(gre greHeader) (hdr erspanHeader, next gopacket_dpdk.LayerType, ok bool) {
+	switch {
+	case gre.proto == greProtoERSPAN3:
+		hdr, ok = parseERSPAN3(gre.payload)
+	case gre.hasSeq:
+		hdr, ok = parseERSPAN2(gre.payload)
+	default:
+		hdr, ok = erspanHeader{payload: gre.payload}, true
+	}
+	if !ok {
+		return hdr, 0, false
+	}
+
+	if !hdr.ip {
+		return hdr, layers.LayerTypeEthernet, true
+	}
+	if len(hdr.payload) == 0 {
+		return hdr, 0, false
+	}
+	if hdr.payload[0]>>4 == 6 {
+		return hdr, layers.LayerTypeIPv6, true
+	}
+	return hdr, layers.LayerTypeIPv4, true
+}
+
+// greLayerType returns the type of the layer carried by GRE packets of the
+// given protocol, other than ERSPAN.
+func greLayerType(proto uint16) (gopacket_dpdk.LayerType, bool) {
+	switch proto {
+	case uint16(layers.EthernetTypeIPv4):
+		return layers.LayerTypeIPv4, true
+	case uint16(layers.EthernetTypeIPv6):
+		return layers.LayerTypeIPv6, true
+	case etherTypeTransparentBridging:
+		return layers.LayerTypeEthernet, true
+	}
+	return 0, false
+}
+
+// decapIP records the tunnel of an IP layer carrying another IP packet or
+// a GRE packet. It returns true if the packet has been processed, which is
+// the case for GRE packets not carrying a supported protocol.
+func (d *Decoder) decapIP(v6 bool, packet *protos.Packet, next *nextLayer) bool {
+	if d.tunnelPorts == nil {
+		return false
+	}
+
+	switch next.typ {
+	case layers.LayerTypeIPv4, layers.LayerTypeIPv6:
+		typ := flows.TunnelIPIP
+		if next.typ == layers.LayerTypeIPv6 && !v6 {
+			typ = flows.Tunnel6in4
+		}
+		debugf("Decapsulating %v tunnel", typ)
+		d.onTunnel(typ, 0, packet)
+		return false
+	case layers.LayerTypeGRE:
+		return !d.decapGRE(packet, next)
+	}
+	return false
+}
+
+// decapGRE decapsulates a GRE packet, which may carry an ERSPAN session.
+// It returns false if the packet can not be decapsulated.
+func (d *Decoder) decapGRE(packet *protos.Packet, next *nextLayer) bool {
+	gre, ok := parseGRE(next.data)
+	if !ok {
+		tunnelMalformed.Inc()
+		return false
+	}
+
+	if gre.proto == greProtoERSPAN2 || gre.proto == greProtoERSPAN3 {
+		return d.decapERSPAN(gre, packet, next)
+	}
+	typ, ok := greLayerType(gre.proto)
+	if !ok {
+		debugf("Unsupported GRE protocol 0x%04x", gre.proto)
+		return false
+	}
+
+	debugf("Decapsulating GRE tunnel, key %d", gre.key)
+	next.typ, next.data = typ, gre.payload
+	d.onTunnel(flows.TunnelGRE, gre.key, packet)
+	return true
+}
+
+// decapERSPAN decapsulates the frame mirrored by ERSPAN.
+func (d *Decoder) decapERSPAN(gre greHeader, packet *protos.Packet, next *nextLayer) bool {
+	hdr, typ, ok := parseERSPAN(gre)
+	if !ok {
+		tunnelMalformed.Inc()
+		return false
+	}
+	next.typ, next.data = typ, hdr.payload
+
+	debugf("Decapsulating ERSPAN session %d", hdr.session)
+	d.onTunnel(flows.TunnelERSPAN, hdr.session, packet)
+	d.tunnel.port, d.tunnel.hasPort = hdr.port, hdr.hasPort
+	d.tunnel.direction = hdr.direction
+	return true
+}
@@ -0,0 +1,173 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !integration
+// +build !integration
+
+package decoder
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/njcx/gopacket_dpdk"
+
+	"github.com/njcx/packetbeat7_dpdk/config"
+	"github.com/njcx/packetbeat7_dpdk/flows"
+)
+
+// ipTunnel wraps payload into an ethernet frame of an IPv4 packet of the
+// given protocol from 10.0.0.1 to 10.0.0.2.
+func ipTunnel(proto byte, payload []byte) []byte {
+	frame := []byte{
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x08, 0x00,
+		0x45, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40, proto, 0x00, 0x00,
+		10, 0, 0, 1, 10, 0, 0, 2,
+	}
+	binary.BigEndian.PutUint16(frame[14+2:], uint16(20+len(payload)))
+	return append(frame, payload...)
+}
+
+func grePacket(flags, proto uint16, fields []uint32, payload []byte) []byte {
+	hdr := make([]byte, 4, 4+4*len(fields))
+	binary.BigEndian.PutUint16(hdr[0:], flags)
+	binary.BigEndian.PutUint16(hdr[2:], proto)
+	for _, f := range fields {
+		hdr = append(hdr, 0, 0, 0, 0)
+		binary.BigEndian.PutUint32(hdr[len(hdr)-4:], f)
+	}
+	return ipTunnel(47, append(hdr, payload...))
+}
+
+func TestDecodePacketData_gre(t *testing.T) {
+	d, tcp, _ := newTestDecoder(t)
+	tunnels := NewTunnels(0)
+	d.SetTunnelConfig(config.TunnelsConfig{}, tunnels)
+
+	// checksum and key present
+	packet := grePacket(greChecksum|greKey, 0x0800, []uint32{0, 1234}, ipv4TcpDNS[14:])
+	d.OnPacket(packet, &gopacket_dpdk.CaptureInfo{Length: len(packet)})
+
+	if !assert.NotNil(t, tcp.pkt, "TCP packet not received") {
+		return
+	}
+	assert.Equal(t, "172.16.16.164", tcp.pkt.Tuple.SrcIP.String())
+	assert.Equal(t, uint16(53), tcp.pkt.Tuple.DstPort)
+
+	tunnel, ok := tunnels.Lookup("tcp", tcp.pkt.Tuple.SrcIP, 1108, tcp.pkt.Tuple.DstIP, 53)
+	if assert.True(t, ok) {
+		assert.Equal(t, flows.TunnelGRE, tunnel.Type)
+		assert.Equal(t, uint32(1234), tunnel.ID)
+		assert.Equal(t, "10.0.0.1", tunnel.SrcIP.String())
+		assert.False(t, tunnel.HasPort)
+	}
+}
+
+func TestDecodePacketData_greUnsupported(t *testing.T) {
+	d, tcp, udp := newTestDecoder(t)
+
+	// PPP
+	packet := grePacket(0, 0x880b, nil, ipv4TcpDNS[14:])
+	d.OnPacket(packet, &gopacket_dpdk.CaptureInfo{Length: len(packet)})
+	assert.Nil(t, tcp.pkt)
+	assert.Nil(t, udp.pkt)
+}
+
+func TestDecodePacketData_erspan2(t *testing.T) {
+	d, _, udp := newTestDecoder(t)
+	tunnels := NewTunnels(0)
+	d.SetTunnelConfig(config.TunnelsConfig{}, tunnels)
+
+	erspan := make([]byte, 8)
+	binary.BigEndian.PutUint16(erspan[0:], 0x1000|171) // version 1, vlan 171
+	binary.BigEndian.PutUint16(erspan[2:], 100)        // session
+	binary.BigEndian.PutUint32(erspan[4:], 7)          // index
+	packet := grePacket(greSequence, greProtoERSPAN2, []uint32{1}, append(erspan, ipv4UdpDNS...))
+	d.OnPacket(packet, &gopacket_dpdk.CaptureInfo{Length: len(packet)})
+
+	if !assert.NotNil(t, udp.pkt, "UDP packet not received") {
+		return
+	}
+	assert.Equal(t, "192.168.170.8", udp.pkt.Tuple.SrcIP.String())
+
+	tunnel, ok := tunnels.Lookup("udp", udp.pkt.Tuple.SrcIP, 32795, udp.pkt.Tuple.DstIP, 53)
+	if assert.True(t, ok) {
+		assert.Equal(t, flows.TunnelERSPAN, tunnel.Type)
+		assert.Equal(t, uint32(100), tunnel.ID)
+		assert.True(t, tunnel.HasPort)
+		assert.Equal(t, uint32(7), tunnel.Port)
+		assert.Equal(t, flows.TunnelDirection(0), tunnel.Direction)
+	}
+}
+
+func TestDecodePacketData_erspan3(t *testing.T) {
+	d, _, udp := newTestDecoder(t)
+	tunnels := NewTunnels(0)
+	d.SetTunnelConfig(config.TunnelsConfig{}, tunnels)
+
+	erspan := make([]byte, 20)
+	binary.BigEndian.PutUint16(erspan[0:], 0x2000)  // version 2
+	binary.BigEndian.PutUint16(erspan[2:], 5)       // session
+	binary.BigEndian.PutUint16(erspan[10:], 0x0009) // egress, platform subheader
+	erspan[12] = 3 << 2                             // platform 3
+	binary.BigEndian.PutUint16(erspan[14:], 12)     // port
+	packet := grePacket(greSequence, greProtoERSPAN3, []uint32{1}, append(erspan, ipv4UdpDNS...))
+	d.OnPacket(packet, &gopacket_dpdk.CaptureInfo{Length: len(packet)})
+
+	if !assert.NotNil(t, udp.pkt, "UDP packet not received") {
+		return
+	}
+	tunnel, ok := tunnels.Lookup("udp", udp.pkt.Tuple.SrcIP, 32795, udp.pkt.Tuple.DstIP, 53)
+	if assert.True(t, ok) {
+		assert.Equal(t, flows.TunnelERSPAN, tunnel.Type)
+		assert.Equal(t, uint32(5), tunnel.ID)
+		assert.Equal(t, uint32(12), tunnel.Port)
+		assert.Equal(t, flows.TunnelEgress, tunnel.Direction)
+	}
+}
+
+func TestDecodePacketData_ipInIP(t *testing.T) {
+	for name, test := range map[string]struct {
+		proto  byte
+		packet []byte
+		typ    flows.TunnelType
+	}{
+		"ipip": {4, ipv4UdpDNS[14:], flows.TunnelIPIP},
+		"6in4": {41, ipv6UdpDNS[14:], flows.Tunnel6in4},
+	} {
+		t.Run(name, func(t *testing.T) {
+			d, _, udp := newTestDecoder(t)
+			tunnels := NewTunnels(0)
+			d.SetTunnelConfig(config.TunnelsConfig{}, tunnels)
+
+			packet := ipTunnel(test.proto, test.packet)
+			d.OnPacket(packet, &gopacket_dpdk.CaptureInfo{Length: len(packet)})
+
+			if !assert.NotNil(t, udp.pkt, "UDP packet not received") {
+				return
+			}
+			assert.Equal(t, uint16(53), udp.pkt.Tuple.DstPort)
+			tunnel, ok := tunnels.Lookup("udp", udp.pkt.Tuple.SrcIP, udp.pkt.Tuple.SrcPort, udp.pkt.Tuple.DstIP, 53)
+			if assert.True(t, ok) {
+				assert.Equal(t, test.typ, tunnel.Type)
+				assert.Equal(t, "10.0.0.2", tunnel.DstIP.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,225 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package decoder
+
+import (
+	"encoding/binary"
+
+	"github.com/njcx/gopacket_dpdk"
+	"github.com/njcx/gopacket_dpdk/layers"
+)
+
+const (
+	sizeEthernetHeader = 14
+	sizeDot1QTag       = 4
+	sizeLinuxSLLHeader = 16
+	sizeLoopbackHeader = 4
+	sizeUDPHeader      = 8
+
+	ipProtoIPIP = 4
+	ipProtoIPv6 = 41
+	ipProtoGRE  = 47
+	ipProtoUDP  = 17
+)
+
+// InnerIP returns the innermost IP packet of a frame of the given link type,
+// without decoding it in full. Like the decoder, it skips VLAN tags, MPLS
+// label stacks and PPPoE sessions and decapsulates the tunnels, recognizing
+// UDP tunnels by ports. ports is nil if tunnels are not decapsulated. The
+// outer packet is returned if a tunnel header is malformed, or if the packet
+// is a fragment. ok is false if the frame does not carry IP.
+//
+// InnerIP lets the packets kept or archived by the sniffer be matched
+// against the connections of events, which are reported with the inner
+// addresses.
+func InnerIP(linkType layers.LinkType, data []byte, ports TunnelPorts) (ip []byte, ok bool) {
+	typ, data, ok := linkPayload(linkType, data)
+	for ok {
+		switch typ {
+		case layers.LayerTypeEthernet:
+			typ, data, ok = ethernetPayload(data)
+		case layers.LayerTypeIPv4, layers.LayerTypeIPv6:
+			if ports == nil {
+				return data, true
+			}
+			next, payload, tunneled := tunnelPayload(data, ports)
+			if !tunneled {
+				return data, true
+			}
+			typ, data = next, payload
+		default:
+			return nil, false
+		}
+	}
+	return nil, false
+}
+
+// linkPayload returns the type and the data of the layer following the link
+// layer.
+func linkPayload(linkType layers.LinkType, data []byte) (gopacket_dpdk.LayerType, []byte, bool) {
+	switch linkType {
+	case layers.LinkTypeEthernet:
+		return layers.LayerTypeEthernet, data, true
+	case layers.LinkTypeLinuxSLL:
+		if len(data) < sizeLinuxSLLHeader {
+			return 0, nil, false
+		}
+		return etherTypePayload(binary.BigEndian.Uint16(data[14:16]), data[sizeLinuxSLLHeader:])
+	case LinkTypeLinuxSLL2:
+		proto, _, payload, ok := parseSLL2(data)
+		if !ok {
+			return 0, nil, false
+		}
+		return etherTypePayload(uint16(proto), payload)
+	case LinkTypeNFLog:
+		next, payload, _, _, ok := parseNFLog(data)
+		return next, payload, ok
+	case layers.LinkTypeNull, layers.LinkTypeLoop:
+		// the address family is in host or network byte order, the IP
+		// version of the payload tells
+		if len(data) < sizeLoopbackHeader {
+			return 0, nil, false
+		}
+		return rawIPPayload(data[sizeLoopbackHeader:])
+	case layers.LinkTypeRaw, DLTRaw, layers.LinkTypeIPv4, layers.LinkTypeIPv6:
+		return rawIPPayload(data)
+	}
+	return 0, nil, false
+}
+
+func rawIPPayload(data []byte) (gopacket_dpdk.LayerType, []byte, bool) {
+	if len(data) == 0 {
+		return 0, nil, false
+	}
+	switch data[0] >> 4 {
+	case 4:
+		return layers.LayerTypeIPv4, data, true
+	case 6:
+		return layers.LayerTypeIPv6, data, true
+	}
+	return 0, nil, false
+}
+
+func ethernetPayload(data []byte) (gopacket_dpdk.LayerType, []byte, bool) {
+	if len(data) < sizeEthernetHeader {
+		return 0, nil, false
+	}
+	return etherTypePayload(binary.BigEndian.Uint16(data[12:14]), data[sizeEthernetHeader:])
+}
+
+// etherTypePayload returns the layer of the given ether type, following
+// VLAN tags, MPLS label stacks and PPPoE sessions.
+func etherTypePayload(etherType uint16, data []byte) (gopacket_dpdk.LayerType, []byte, bool) {
+	for {
+		switch etherType {
+		case uint16(layers.EthernetTypeIPv4):
+			return layers.LayerTypeIPv4, data, true
+		case uint16(layers.EthernetTypeIPv6):
+			return layers.LayerTypeIPv6, data, true
+		case uint16(layers.EthernetTypeDot1Q), etherTypeQinQ, etherTypeQinQLegacy:
+			if len(data) < sizeDot1QTag {
+				return 0, nil, false
+			}
+			etherType, data = binary.BigEndian.Uint16(data[2:4]), data[sizeDot1QTag:]
+		case etherTypeMPLSUnicast, etherTypeMPLSMulti:
+			stack, ok := parseMPLS(data)
+			return stack.next, stack.payload, ok && stack.next != 0
+		case etherTypePPPoE:
+			_, next, payload, ok := parsePPPoE(data)
+			return next, payload, ok && next != 0
+		default:
+			return 0, nil, false
+		}
+	}
+}
+
+// tunnelPayload returns the layer encapsulated in the IP packet ip, if it is
+// a tunnel.
+func tunnelPayload(ip []byte, ports TunnelPorts) (next gopacket_dpdk.LayerType, payload []byte, ok bool) {
+	proto, payload, ok := ipPayload(ip)
+	if !ok {
+		return 0, nil, false
+	}
+
+	switch proto {
+	case ipProtoIPIP:
+		return layers.LayerTypeIPv4, payload, true
+	case ipProtoIPv6:
+		return layers.LayerTypeIPv6, payload, true
+	case ipProtoGRE:
+		gre, ok := parseGRE(payload)
+		if !ok {
+			return 0, nil, false
+		}
+		if gre.proto == greProtoERSPAN2 || gre.proto == greProtoERSPAN3 {
+			hdr, next, ok := parseERSPAN(gre)
+			return next, hdr.payload, ok
+		}
+		next, ok := greLayerType(gre.proto)
+		return next, gre.payload, ok
+	case ipProtoUDP:
+		if len(payload) < sizeUDPHeader {
+			return 0, nil, false
+		}
+		typ, ok := ports[binary.BigEndian.Uint16(payload[2:4])]
+		if !ok {
+			return 0, nil, false
+		}
+		_, next, payload, isTunnel, ok := udpTunnelHeader(typ, payload[sizeUDPHeader:])
+		return next, payload, isTunnel && ok
+	}
+	return 0, nil, false
+}
+
+// ipPayload returns the protocol and the payload of an unfragmented IP
+// packet, following the IPv6 extension headers.
+func ipPayload(ip []byte) (proto byte, payload []byte, ok bool) {
+	if len(ip) == 0 {
+		return 0, nil, false
+	}
+	switch ip[0] >> 4 {
+	case 4:
+		if len(ip) < 20 {
+			return 0, nil, false
+		}
+		hlen := int(ip[0]&0x0f) * 4
+		if hlen < 20 || len(ip) < hlen || binary.BigEndian.Uint16(ip[6:8])&0x3fff != 0 {
+			return 0, nil, false
+		}
+		return ip[9], ip[hlen:], true
+	case 6:
+		if len(ip) < 40 {
+			return 0, nil, false
+		}
+		proto, payload = ip[6], ip[40:]
+		for {
+			switch proto {
+			case 0, 43, 60: // hop-by-hop, routing and destination options
+				if len(payload) < 8 || len(payload) < (int(payload[1])+1)*8 {
+					return 0, nil, false
+				}
+				proto, payload = payload[0], payload[(int(payload[1])+1)*8:]
+			case 44: // fragment
+				return 0, nil, false
+			default:
+				return proto, payload, true
+			}
+		}
+	}
+	return 0, nil, false
+}
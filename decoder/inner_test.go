@@ -0,0 +1,75 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !integration
+// +build !integration
+
+package decoder
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/njcx/gopacket_dpdk/layers"
+
+	"github.com/njcx/packetbeat7_dpdk/config"
+)
+
+func TestInnerIP(t *testing.T) {
+	ports := NewTunnelPorts(config.TunnelsConfig{})
+	erspan := make([]byte, 8)
+	binary.BigEndian.PutUint16(erspan[0:], 0x1000)
+
+	for name, test := range map[string]struct {
+		linkType layers.LinkType
+		frame    []byte
+		ports    TunnelPorts
+		ip       []byte
+	}{
+		"ethernet":       {layers.LinkTypeEthernet, ipv4UdpDNS, ports, ipv4UdpDNS[14:]},
+		"raw":            {DLTRaw, ipv6UdpDNS[14:], ports, ipv6UdpDNS[14:]},
+		"sll2":           {LinkTypeLinuxSLL2, append(sll2Header(0x0800, 3), ipv4UdpDNS[14:]...), ports, ipv4UdpDNS[14:]},
+		"nflog":          {LinkTypeNFLog, nflogFrame(nflogFamilyIPv4, 3, ipv4UdpDNS[14:]), ports, ipv4UdpDNS[14:]},
+		"qinq":           {layers.LinkTypeEthernet, linkFrame(etherTypeQinQ, append([]byte{0, 10, 0x81, 0, 0, 20, 0x08, 0}, ipv4UdpDNS[14:]...)), ports, ipv4UdpDNS[14:]},
+		"mpls":           {layers.LinkTypeEthernet, linkFrame(etherTypeMPLSUnicast, append(mplsStack(16, 17), ipv4UdpDNS[14:]...)), ports, ipv4UdpDNS[14:]},
+		"pppoe":          {layers.LinkTypeEthernet, linkFrame(etherTypePPPoE, pppoeSession(1, 0x0021, ipv4UdpDNS[14:])), ports, ipv4UdpDNS[14:]},
+		"vxlan":          {layers.LinkTypeEthernet, vxlanPacket(1, ipv4TcpDNS), ports, ipv4TcpDNS[14:]},
+		"geneve":         {layers.LinkTypeEthernet, genevePacket(7, 0x86dd, ipv6UdpDNS[14:]), ports, ipv6UdpDNS[14:]},
+		"gtp":            {layers.LinkTypeEthernet, gtpPacket(1, nil, ipv4UdpDNS[14:]), ports, ipv4UdpDNS[14:]},
+		"gre":            {layers.LinkTypeEthernet, grePacket(greKey, 0x0800, []uint32{1234}, ipv4TcpDNS[14:]), ports, ipv4TcpDNS[14:]},
+		"erspan":         {layers.LinkTypeEthernet, grePacket(greSequence, greProtoERSPAN2, []uint32{1}, append(erspan, ipv4TcpDNS...)), ports, ipv4TcpDNS[14:]},
+		"ipip":           {layers.LinkTypeEthernet, ipTunnel(4, ipv4UdpDNS[14:]), ports, ipv4UdpDNS[14:]},
+		"vxlan in vxlan": {layers.LinkTypeEthernet, vxlanPacket(1, vxlanPacket(2, ipv4TcpDNS)), ports, ipv4TcpDNS[14:]},
+		"disabled":       {layers.LinkTypeEthernet, vxlanPacket(1, ipv4TcpDNS), nil, vxlanPacket(1, ipv4TcpDNS)[14:]},
+		"other port":     {layers.LinkTypeEthernet, udpTunnel(8472, vxlanPacket(1, ipv4TcpDNS)[42:]), ports, udpTunnel(8472, vxlanPacket(1, ipv4TcpDNS)[42:])[14:]},
+		"malformed":      {layers.LinkTypeEthernet, udpTunnel(4789, []byte{0x08}), ports, udpTunnel(4789, []byte{0x08})[14:]},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ip, ok := InnerIP(test.linkType, test.frame, test.ports)
+			if assert.True(t, ok) {
+				assert.Equal(t, test.ip, ip)
+			}
+		})
+	}
+
+	_, ok := InnerIP(layers.LinkTypeEthernet, linkFrame(0x0806, make([]byte, 28)), ports)
+	assert.False(t, ok, "ARP")
+	_, ok = InnerIP(layers.LinkTypeEthernet, ipv4UdpDNS[:10], ports)
+	assert.False(t, ok, "truncated")
+}
@@ -0,0 +1,152 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package decoder
+
+import (
+	"encoding/binary"
+
+	"github.com/njcx/gopacket_dpdk"
+	"github.com/njcx/gopacket_dpdk/layers"
+
+	"github.com/njcx/packetbeat7_dpdk/protos"
+)
+
+// Link types without a layer decoder, see
+// https://www.tcpdump.org/linktypes.html. Live captures report the DLT
+// value, pcap files the LINKTYPE value, which differ for raw IP.
+const (
+	DLTRaw        layers.LinkType = 12 // DLT_RAW of live captures on Linux
+	LinkTypeNFLog layers.LinkType = 239
+
+	sizeSLL2Header  = 20
+	sizeNFLogHeader = 4
+	sizeNFLogTLV    = 4
+
+	nflogFamilyIPv4 = 2
+	nflogFamilyIPv6 = 10
+
+	nflogTypeInDev   = 4
+	nflogTypePayload = 9
+)
+
+// LINKTYPE_LINUX_SLL2 is converted at run time, such that it is truncated
+// like the link types reported by handles and files if LinkType is 8 bits
+// wide.
+var (
+	linkTypeLinuxSLL2Value = 276
+	LinkTypeLinuxSLL2      = layers.LinkType(linkTypeLinuxSLL2Value)
+)
+
+// linkDecoder decodes a link layer without a layer decoder, returning the
+// type and the data of the layer to decode next. The index of the capture
+// interface is recorded in packet, if reported by the link layer.
+type linkDecoder func(data []byte, packet *protos.Packet) (next gopacket_dpdk.LayerType, payload []byte, ok bool)
+
+// decodeRawIP decodes a raw IP packet, the IP version being given by its
+// first nibble.
+func (d *Decoder) decodeRawIP(data []byte, _ *protos.Packet) (gopacket_dpdk.LayerType, []byte, bool) {
+	if len(data) == 0 {
+		return 0, nil, false
+	}
+	switch data[0] >> 4 {
+	case 4:
+		return layers.LayerTypeIPv4, data, true
+	case 6:
+		return layers.LayerTypeIPv6, data, true
+	}
+	return 0, nil, false
+}
+
+// parseSLL2 parses the Linux cooked capture v2 header, which records the
+// index of the interface the packet has been captured on.
+func parseSLL2(data []byte) (proto layers.EthernetType, ifIndex uint32, payload []byte, ok bool) {
+	if len(data) < sizeSLL2Header {
+		return 0, 0, nil, false
+	}
+	proto = layers.EthernetType(binary.BigEndian.Uint16(data[0:2]))
+	return proto, binary.BigEndian.Uint32(data[4:8]), data[sizeSLL2Header:], true
+}
+
+func (d *Decoder) decodeSLL2(data []byte, packet *protos.Packet) (gopacket_dpdk.LayerType, []byte, bool) {
+	proto, ifIndex, payload, ok := parseSLL2(data)
+	if !ok {
+		return 0, nil, false
+	}
+	packet.InterfaceIndex = ifIndex
+	return proto.LayerType(), payload, true
+}
+
+// parseNFLog parses a frame of the packets logged by the netfilter NFLOG
+// target. The header is followed by TLVs, in host byte order of the
+// capturing machine, one of them holding the IP packet. The index of the
+// input interface is returned, if present.
+func parseNFLog(data []byte) (next gopacket_dpdk.LayerType, payload []byte, ifIndex uint32, hasIfIndex, ok bool) {
+	if len(data) < sizeNFLogHeader {
+		return 0, nil, 0, false, false
+	}
+	switch data[0] {
+	case nflogFamilyIPv4:
+		next = layers.LayerTypeIPv4
+	case nflogFamilyIPv6:
+		next = layers.LayerTypeIPv6
+	default:
+		return 0, nil, 0, false, false
+	}
+
+	var order binary.ByteOrder = binary.LittleEndian
+	for tlvs := data[sizeNFLogHeader:]; len(tlvs) >= sizeNFLogTLV; {
+		length := int(order.Uint16(tlvs[0:2]))
+		if length < sizeNFLogTLV || length > len(tlvs) {
+			// captured on a big endian machine
+			order = binary.BigEndian
+			length = int(order.Uint16(tlvs[0:2]))
+			if length < sizeNFLogTLV || length > len(tlvs) {
+				return 0, nil, 0, false, false
+			}
+		}
+		value := tlvs[sizeNFLogTLV:length]
+
+		switch order.Uint16(tlvs[2:4]) & 0x3fff {
+		case nflogTypeInDev:
+			if len(value) >= 4 {
+				ifIndex, hasIfIndex = binary.BigEndian.Uint32(value), true
+			}
+		case nflogTypePayload:
+			return next, value, ifIndex, hasIfIndex, true
+		}
+
+		// TLVs are padded to 4 bytes
+		length = (length + 3) &^ 3
+		if length > len(tlvs) {
+			break
+		}
+		tlvs = tlvs[length:]
+	}
+	return 0, nil, 0, false, false
+}
+
+func (d *Decoder) decodeNFLog(data []byte, packet *protos.Packet) (gopacket_dpdk.LayerType, []byte, bool) {
+	next, payload, ifIndex, hasIfIndex, ok := parseNFLog(data)
+	if !ok {
+		return 0, nil, false
+	}
+	if hasIfIndex {
+		packet.InterfaceIndex = ifIndex
+	}
+	return next, payload, true
+}
@@ -0,0 +1,152 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !integration
+// +build !integration
+
+package decoder
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/njcx/gopacket_dpdk"
+	"github.com/njcx/gopacket_dpdk/layers"
+
+	"github.com/njcx/packetbeat7_dpdk/config"
+)
+
+// newLinkTestDecoder creates a decoder of the given link type, recording
+// connections in a tunnel table.
+func newLinkTestDecoder(t *testing.T, linkType layers.LinkType) (*Decoder, *TestUDPProcessor, *Tunnels) {
+	udp := &TestUDPProcessor{}
+	d, err := New(nil, linkType, &TestIcmp4Processor{}, &TestIcmp6Processor{}, &TestTCPProcessor{}, udp)
+	if err != nil {
+		t.Fatalf("Error creating decoder %v", err)
+	}
+	tunnels := NewTunnels(0)
+	d.SetTunnelConfig(config.TunnelsConfig{}, tunnels)
+	return d, udp, tunnels
+}
+
+func sll2Header(proto uint16, ifIndex uint32) []byte {
+	hdr := make([]byte, sizeSLL2Header)
+	binary.BigEndian.PutUint16(hdr[0:], proto)
+	binary.BigEndian.PutUint32(hdr[4:], ifIndex)
+	binary.BigEndian.PutUint16(hdr[8:], 1) // ARPHRD_ETHER
+	hdr[11] = 6
+	return hdr
+}
+
+// nflogFrame builds an NFLOG frame in little endian byte order, with the
+// input interface and the packet.
+func nflogFrame(family byte, inDev uint32, packet []byte) []byte {
+	frame := []byte{family, 0, 0, 5}
+	tlv := func(typ uint16, value []byte) {
+		hdr := make([]byte, sizeNFLogTLV)
+		binary.LittleEndian.PutUint16(hdr[0:], uint16(sizeNFLogTLV+len(value)))
+		binary.LittleEndian.PutUint16(hdr[2:], typ)
+		frame = append(append(frame, hdr...), value...)
+		for len(frame)%4 != 0 {
+			frame = append(frame, 0)
+		}
+	}
+	tlv(1, []byte{0x08, 0x00, 1, 0}) // packet header
+	tlv(10, []byte("prefix\x00"))
+	dev := make([]byte, 4)
+	binary.BigEndian.PutUint32(dev, inDev)
+	tlv(nflogTypeInDev, dev)
+	tlv(nflogTypePayload, packet)
+	return frame
+}
+
+func TestDecodePacketData_rawIP(t *testing.T) {
+	for name, test := range map[string]struct {
+		linkType layers.LinkType
+		packet   []byte
+	}{
+		"raw ipv4":      {layers.LinkTypeRaw, ipv4UdpDNS[14:]},
+		"raw ipv6":      {layers.LinkTypeRaw, ipv6UdpDNS[14:]},
+		"live raw ipv4": {DLTRaw, ipv4UdpDNS[14:]},
+		"ipv4":          {layers.LinkTypeIPv4, ipv4UdpDNS[14:]},
+		"ipv6":          {layers.LinkTypeIPv6, ipv6UdpDNS[14:]},
+	} {
+		t.Run(name, func(t *testing.T) {
+			d, udp, _ := newLinkTestDecoder(t, test.linkType)
+			d.OnPacket(test.packet, &gopacket_dpdk.CaptureInfo{Length: len(test.packet)})
+			if assert.NotNil(t, udp.pkt, "UDP packet not received") {
+				assert.Equal(t, uint16(53), udp.pkt.Tuple.DstPort)
+			}
+		})
+	}
+
+	d, udp, _ := newLinkTestDecoder(t, layers.LinkTypeRaw)
+	packet := []byte{0x10, 0, 0, 0}
+	d.OnPacket(packet, &gopacket_dpdk.CaptureInfo{Length: len(packet)})
+	assert.Nil(t, udp.pkt)
+}
+
+func TestDecodePacketData_sll2(t *testing.T) {
+	d, udp, tunnels := newLinkTestDecoder(t, LinkTypeLinuxSLL2)
+
+	packet := append(sll2Header(0x0800, 3), ipv4UdpDNS[14:]...)
+	d.OnPacket(packet, &gopacket_dpdk.CaptureInfo{Length: len(packet)})
+
+	if !assert.NotNil(t, udp.pkt, "UDP packet not received") {
+		return
+	}
+	assert.Equal(t, "192.168.170.8", udp.pkt.Tuple.SrcIP.String())
+	assert.Equal(t, uint32(3), udp.pkt.InterfaceIndex)
+
+	// connections are not recorded for their interface
+	_, ok := tunnels.Lookup("udp", udp.pkt.Tuple.SrcIP, 32795, udp.pkt.Tuple.DstIP, 53)
+	assert.False(t, ok)
+
+	// VLAN tagged
+	udp.pkt = nil
+	packet = append(sll2Header(0x8100, 4), 0x00, 0x0a, 0x86, 0xdd)
+	packet = append(packet, ipv6UdpDNS[14:]...)
+	d.OnPacket(packet, &gopacket_dpdk.CaptureInfo{Length: len(packet)})
+	assert.NotNil(t, udp.pkt, "UDP packet not received")
+}
+
+func TestDecodePacketData_nflog(t *testing.T) {
+	d, udp, _ := newLinkTestDecoder(t, LinkTypeNFLog)
+
+	packet := nflogFrame(nflogFamilyIPv6, 7, ipv6UdpDNS[14:])
+	d.OnPacket(packet, &gopacket_dpdk.CaptureInfo{Length: len(packet)})
+
+	if !assert.NotNil(t, udp.pkt, "UDP packet not received") {
+		return
+	}
+	assert.Equal(t, "3ffe:507:0:1:200:86ff:fe05:80da", udp.pkt.Tuple.SrcIP.String())
+	assert.Equal(t, ipv6UdpDNS[14+40+8:], udp.pkt.Payload)
+	assert.Equal(t, uint32(7), udp.pkt.InterfaceIndex)
+
+	// truncated TLV
+	udp.pkt = nil
+	packet = nflogFrame(nflogFamilyIPv4, 7, ipv4UdpDNS[14:])
+	d.OnPacket(packet[:len(packet)-8], &gopacket_dpdk.CaptureInfo{Length: len(packet) - 8})
+	assert.Nil(t, udp.pkt)
+}
+
+func TestNewUnsupportedLinkType(t *testing.T) {
+	_, err := New(nil, layers.LinkTypeIEEE802_11, &TestIcmp4Processor{}, &TestIcmp6Processor{}, &TestTCPProcessor{}, &TestUDPProcessor{})
+	assert.Error(t, err)
+}
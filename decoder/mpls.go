@@ -0,0 +1,179 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package decoder
+
+import (
+	"encoding/binary"
+
+	"github.com/njcx/libbeat_v7/monitoring"
+
+	"github.com/njcx/gopacket_dpdk"
+	"github.com/njcx/gopacket_dpdk/layers"
+
+	"github.com/njcx/packetbeat7_dpdk/flows"
+)
+
+// ether types of the layers between the link layer and IP
+const (
+	etherTypeQinQ        = 0x88a8 // 802.1ad service tag
+	etherTypeQinQLegacy  = 0x9100 // pre-standard service tag
+	etherTypeMPLSUnicast = 0x8847
+	etherTypeMPLSMulti   = 0x8848
+	etherTypePPPoE       = 0x8864 // PPPoE session stage
+
+	sizeMPLSLabel       = 4
+	sizeMPLSControlWord = 4
+	sizePPPoEHeader     = 6
+	sizePPPProtocol     = 2
+
+	pppIPv4 = 0x0021
+	pppIPv6 = 0x0057
+)
+
+var linkMalformed = monitoring.NewInt(nil, "decoder.link.malformed")
+
+// onEtherType selects the layer decoded after an ethernet header or a VLAN
+// tag of the given ether type, for the layers not handled by the layer
+// decoders. It returns true if the packet has been processed, which is the
+// case for malformed or unsupported MPLS and PPPoE payloads.
+func (d *Decoder) onEtherType(typ layers.EthernetType, next *nextLayer) bool {
+	switch typ {
+	case etherTypeQinQ, etherTypeQinQLegacy:
+		next.typ = layers.LayerTypeDot1Q
+	case etherTypeMPLSUnicast, etherTypeMPLSMulti:
+		return !d.decapMPLS(next)
+	case etherTypePPPoE:
+		return !d.decapPPPoE(next)
+	}
+	return false
+}
+
+// labelStack is a parsed MPLS label stack.
+type labelStack struct {
+	labels  [flows.MaxMPLSLabels]uint32 // outermost labels
+	n       int
+	next    gopacket_dpdk.LayerType // 0 if the payload is not supported
+	payload []byte
+}
+
+// parseMPLS pops the MPLS label stack at the start of data. The payload
+// below the stack is not identified by the labels, it is guessed from its
+// first nibble. A payload starting with 0 is taken for an ethernet frame
+// behind a pseudowire control word. Only the outermost labels are kept. ok
+// is false if the stack is malformed.
+func parseMPLS(data []byte) (stack labelStack, ok bool) {
+	for {
+		if len(data) < sizeMPLSLabel {
+			return stack, false
+		}
+		entry := binary.BigEndian.Uint32(data)
+		data = data[sizeMPLSLabel:]
+		if stack.n < len(stack.labels) {
+			stack.labels[stack.n] = entry >> 12
+			stack.n++
+		}
+		if entry&0x100 != 0 { // bottom of stack
+			break
+		}
+	}
+	if len(data) == 0 {
+		return stack, false
+	}
+
+	switch data[0] >> 4 {
+	case 4:
+		stack.next = layers.LayerTypeIPv4
+	case 6:
+		stack.next = layers.LayerTypeIPv6
+	case 0:
+		if len(data) < sizeMPLSControlWord {
+			return stack, false
+		}
+		data = data[sizeMPLSControlWord:]
+		stack.next = layers.LayerTypeEthernet
+	}
+	stack.payload = data
+	return stack, true
+}
+
+// decapMPLS pops the MPLS label stack at the start of next.data. It returns
+// false if the payload can not be decoded.
+func (d *Decoder) decapMPLS(next *nextLayer) bool {
+	stack, ok := parseMPLS(next.data)
+	if !ok {
+		linkMalformed.Inc()
+		return false
+	}
+	if stack.next == 0 {
+		debugf("Unsupported MPLS payload")
+		return false
+	}
+	next.typ, next.data = stack.next, stack.payload
+
+	// the labels differ by direction, they are recorded per direction
+	// instead of being part of the flow ID
+	labels := stack.labels[:stack.n]
+	debugf("MPLS label stack %v", labels)
+	d.tunnel.nMPLS = copy(d.tunnel.mpls[:], labels)
+	return true
+}
+
+// parsePPPoE parses a PPPoE session header and the PPP protocol, see RFC
+// 2516. next is 0 if the PPP frame does not carry IP. ok is false if the
+// header is malformed.
+func parsePPPoE(data []byte) (session uint16, next gopacket_dpdk.LayerType, payload []byte, ok bool) {
+	if len(data) < sizePPPoEHeader+sizePPPProtocol || data[0] != 0x11 || data[1] != 0 {
+		// version 1, type 1, session data
+		return 0, 0, nil, false
+	}
+	session = binary.BigEndian.Uint16(data[2:4])
+	length := int(binary.BigEndian.Uint16(data[4:6]))
+	if length < sizePPPProtocol || len(data) < sizePPPoEHeader+length {
+		return 0, 0, nil, false
+	}
+
+	switch binary.BigEndian.Uint16(data[6:8]) {
+	case pppIPv4:
+		next = layers.LayerTypeIPv4
+	case pppIPv6:
+		next = layers.LayerTypeIPv6
+	}
+	return session, next, data[sizePPPoEHeader+sizePPPProtocol : sizePPPoEHeader+length], true
+}
+
+// decapPPPoE decapsulates the PPP frame of a PPPoE session. It returns false
+// if the frame does not carry IP.
+func (d *Decoder) decapPPPoE(next *nextLayer) bool {
+	session, typ, payload, ok := parsePPPoE(next.data)
+	if !ok {
+		linkMalformed.Inc()
+		return false
+	}
+	if typ == 0 {
+		debugf("Unsupported PPP protocol")
+		return false
+	}
+	next.typ, next.data = typ, payload
+
+	debugf("PPPoE session %d", session)
+	d.tunnel.pppoe, d.tunnel.hasPPPoE = session, true
+	if d.flowID != nil {
+		d.flowID.AddPPPoE(session)
+	}
+	return true
+}
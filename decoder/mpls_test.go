@@ -0,0 +1,206 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !integration
+// +build !integration
+
+package decoder
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/njcx/gopacket_dpdk"
+
+	"github.com/njcx/packetbeat7_dpdk/config"
+	"github.com/njcx/packetbeat7_dpdk/flows"
+	"github.com/njcx/packetbeat7_dpdk/procs"
+)
+
+// linkFrame builds an ethernet frame of the given ether type, followed by
+// payload.
+func linkFrame(etherType uint16, payload []byte) []byte {
+	frame := []byte{0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 1, 0, 0}
+	binary.BigEndian.PutUint16(frame[12:], etherType)
+	return append(frame, payload...)
+}
+
+func mplsStack(labels ...uint32) []byte {
+	stack := make([]byte, 4*len(labels))
+	for i, label := range labels {
+		entry := label<<12 | 64 // ttl
+		if i == len(labels)-1 {
+			entry |= 0x100
+		}
+		binary.BigEndian.PutUint32(stack[4*i:], entry)
+	}
+	return stack
+}
+
+func pppoeSession(session, proto uint16, payload []byte) []byte {
+	hdr := []byte{0x11, 0, 0, 0, 0, 0, 0, 0}
+	binary.BigEndian.PutUint16(hdr[2:], session)
+	binary.BigEndian.PutUint16(hdr[4:], uint16(2+len(payload)))
+	binary.BigEndian.PutUint16(hdr[6:], proto)
+	return append(hdr, payload...)
+}
+
+func TestDecodePacketData_qinq(t *testing.T) {
+	d, _, udp := newTestDecoder(t)
+
+	// service tag and two customer tags
+	tags := []byte{
+		0x00, 0x64, 0x81, 0x00,
+		0x00, 0xc8, 0x81, 0x00,
+		0x01, 0x2c, 0x08, 0x00,
+	}
+	packet := linkFrame(etherTypeQinQ, append(tags, ipv4UdpDNS[14:]...))
+	d.OnPacket(packet, &gopacket_dpdk.CaptureInfo{Length: len(packet)})
+
+	if assert.NotNil(t, udp.pkt, "UDP packet not received") {
+		assert.Equal(t, "192.168.170.8", udp.pkt.Tuple.SrcIP.String())
+		assert.Equal(t, uint16(53), udp.pkt.Tuple.DstPort)
+	}
+}
+
+func TestDecodePacketData_mpls(t *testing.T) {
+	d, tcp, udp := newTestDecoder(t)
+	tunnels := NewTunnels(0)
+	d.SetTunnelConfig(config.TunnelsConfig{}, tunnels)
+
+	packet := linkFrame(etherTypeMPLSUnicast, append(mplsStack(16, 1000), ipv4UdpDNS[14:]...))
+	d.OnPacket(packet, &gopacket_dpdk.CaptureInfo{Length: len(packet)})
+
+	if !assert.NotNil(t, udp.pkt, "UDP packet not received") {
+		return
+	}
+	assert.Equal(t, "192.168.170.8", udp.pkt.Tuple.SrcIP.String())
+	tunnel, ok := tunnels.Lookup("udp", udp.pkt.Tuple.SrcIP, 32795, udp.pkt.Tuple.DstIP, 53)
+	if assert.True(t, ok) {
+		assert.Equal(t, []uint32{16, 1000}, tunnel.SrcMPLSLabels)
+		assert.Nil(t, tunnel.DstMPLSLabels)
+		assert.Zero(t, tunnel.Type)
+	}
+
+	// ethernet pseudowire with control word
+	pw := append(mplsStack(20), 0, 0, 0, 0)
+	packet = linkFrame(etherTypeMPLSUnicast, append(pw, ipv4TcpDNS...))
+	d.OnPacket(packet, &gopacket_dpdk.CaptureInfo{Length: len(packet)})
+	if assert.NotNil(t, tcp.pkt, "TCP packet not received") {
+		assert.Equal(t, uint16(53), tcp.pkt.Tuple.DstPort)
+	}
+
+	// bottom of stack missing
+	malformed := linkMalformed.Get()
+	udp.pkt = nil
+	packet = linkFrame(etherTypeMPLSUnicast, []byte{0, 1, 0, 64})
+	d.OnPacket(packet, &gopacket_dpdk.CaptureInfo{Length: len(packet)})
+	assert.Nil(t, udp.pkt)
+	assert.Equal(t, malformed+1, linkMalformed.Get())
+}
+
+// udpResponse returns the response to an IPv4 UDP packet, with the
+// addresses and ports swapped.
+// reversedFrame returns frame with the MAC addresses swapped.
+func reversedFrame(frame []byte) []byte {
+	r := append([]byte(nil), frame...)
+	copy(r[0:6], frame[6:12])
+	copy(r[6:12], frame[0:6])
+	return r
+}
+
+func udpResponse(ip []byte) []byte {
+	r := append([]byte(nil), ip...)
+	copy(r[12:16], ip[16:20])
+	copy(r[16:20], ip[12:16])
+	copy(r[20:22], ip[22:24])
+	copy(r[22:24], ip[20:22])
+	return r
+}
+
+func TestDecodePacketData_mplsDirections(t *testing.T) {
+	// requests and responses are decoded by separate decoders, such that
+	// the flow IDs of both can be compared
+	f, err := flows.NewFlows(nil, procs.ProcessesWatcher{}, &config.Flows{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tunnels := NewTunnels(0)
+	d1, _, requests := newFlowsTestDecoder(t, f)
+	d1.SetTunnelConfig(config.TunnelsConfig{}, tunnels)
+	d2, _, responses := newFlowsTestDecoder(t, f)
+	d2.SetTunnelConfig(config.TunnelsConfig{}, tunnels)
+
+	// the label switched paths of both directions differ
+	request := linkFrame(etherTypeMPLSUnicast, append(mplsStack(16, 1000), ipv4UdpDNS[14:]...))
+	response := reversedFrame(linkFrame(etherTypeMPLSUnicast, append(mplsStack(17, 2000, 30, 40), udpResponse(ipv4UdpDNS[14:])...)))
+	d1.OnPacket(request, &gopacket_dpdk.CaptureInfo{Length: len(request)})
+	d2.OnPacket(response, &gopacket_dpdk.CaptureInfo{Length: len(response)})
+
+	// both directions are one flow
+	if assert.NotNil(t, requests.flowID) && assert.NotNil(t, responses.flowID) {
+		assert.True(t, flows.FlowIDsEqual(requests.flowID, responses.flowID))
+	}
+
+	// the labels are kept per direction, the stack is truncated
+	src, dst := net.ParseIP("192.168.170.8"), net.ParseIP("192.168.170.20")
+	for i := 0; i < 2; i++ {
+		tunnel, ok := tunnels.Lookup("udp", src, 32795, dst, 53)
+		if assert.True(t, ok) {
+			assert.Equal(t, []uint32{16, 1000}, tunnel.SrcMPLSLabels)
+			assert.Equal(t, []uint32{17, 2000, 30}, tunnel.DstMPLSLabels)
+		}
+		tunnel, ok = tunnels.Lookup("udp", dst, 53, src, 32795)
+		if assert.True(t, ok) {
+			assert.Equal(t, []uint32{17, 2000, 30}, tunnel.SrcMPLSLabels)
+		}
+		d1.OnPacket(request, &gopacket_dpdk.CaptureInfo{Length: len(request)})
+	}
+}
+
+func TestDecodePacketData_pppoe(t *testing.T) {
+	d, _, udp := newTestDecoder(t)
+	tunnels := NewTunnels(0)
+	d.SetTunnelConfig(config.TunnelsConfig{}, tunnels)
+
+	// behind a VLAN tag, with ethernet padding
+	tag := []byte{0x00, 0x0a, 0x88, 0x64}
+	session := pppoeSession(0x2a, pppIPv6, ipv6UdpDNS[14:])
+	packet := linkFrame(uint16(0x8100), append(append(tag, session...), 0, 0, 0, 0))
+	d.OnPacket(packet, &gopacket_dpdk.CaptureInfo{Length: len(packet)})
+
+	if !assert.NotNil(t, udp.pkt, "UDP packet not received") {
+		return
+	}
+	assert.Equal(t, "3ffe:507:0:1:200:86ff:fe05:80da", udp.pkt.Tuple.SrcIP.String())
+	assert.Equal(t, ipv6UdpDNS[14+40+8:], udp.pkt.Payload)
+	tunnel, ok := tunnels.Lookup("udp", udp.pkt.Tuple.SrcIP, 2415, udp.pkt.Tuple.DstIP, 53)
+	if assert.True(t, ok) {
+		assert.True(t, tunnel.HasPPPoE)
+		assert.Equal(t, uint16(0x2a), tunnel.PPPoESession)
+		assert.Nil(t, tunnel.SrcMPLSLabels)
+	}
+
+	// LCP
+	udp.pkt = nil
+	packet = linkFrame(etherTypePPPoE, pppoeSession(0x2a, 0xc021, []byte{1, 1, 0, 4}))
+	d.OnPacket(packet, &gopacket_dpdk.CaptureInfo{Length: len(packet)})
+	assert.Nil(t, udp.pkt)
+}
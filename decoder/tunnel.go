@@ -0,0 +1,495 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package decoder
+
+import (
+	"bytes"
+	"container/list"
+	"encoding/binary"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/njcx/gopacket_dpdk"
+	"github.com/njcx/gopacket_dpdk/layers"
+
+	"github.com/njcx/libbeat_v7/monitoring"
+
+	"github.com/njcx/packetbeat7_dpdk/config"
+	"github.com/njcx/packetbeat7_dpdk/flows"
+	"github.com/njcx/packetbeat7_dpdk/protos"
+)
+
+const (
+	defaultVXLANPort  = 4789
+	defaultGenevePort = 6081
+	defaultGTPPort    = 2152
+
+	// connections not seen in a tunnel for tunnelConnTimeout are forgotten
+	tunnelConnTimeout = 2 * time.Minute
+
+	defaultTunnelMaxConnections = 100000
+
+	sizeVXLANHeader  = 8
+	sizeGeneveHeader = 8
+	sizeGTPHeader    = 8
+	sizeGTPOptional  = 4 // sequence number, N-PDU number and next extension type
+
+	gtpMessageGPDU = 0xff
+
+	etherTypeTransparentBridging = 0x6558
+)
+
+var (
+	tunnelDecapsulated = monitoring.NewInt(nil, "decoder.tunnels.decapsulated")
+	tunnelMalformed    = monitoring.NewInt(nil, "decoder.tunnels.malformed")
+	tunnelEvicted      = monitoring.NewInt(nil, "decoder.tunnels.evicted")
+)
+
+// Tunnel is the tunnel the packets of a connection have been decapsulated
+// from, and the MPLS label stack and PPPoE session they have been carried
+// in. Type is 0 if the connection has not been seen in a tunnel.
+type Tunnel struct {
+	Type flows.TunnelType
+	ID   uint32 // 0 for GTP-U tunnels, whose TEIDs differ by direction
+
+	// outer endpoints, in the direction of the connection looked up
+	SrcIP, DstIP net.IP
+
+	// TEIDs of the GTP-U packets sent by the source and the destination, if
+	// seen
+	SrcTEID, DstTEID       uint32
+	HasSrcTEID, HasDstTEID bool
+
+	// switch port and direction of the packet seen last, as reported by
+	// ERSPAN
+	Port      uint32
+	HasPort   bool
+	Direction flows.TunnelDirection
+
+	// MPLS labels of the packets sent by the source and the destination, if
+	// seen, up to flows.MaxMPLSLabels labels each, outermost first
+	SrcMPLSLabels, DstMPLSLabels []uint32
+
+	PPPoESession uint16
+	HasPPPoE     bool
+}
+
+// packetTunnel is the tunnel of the packet being decoded.
+type packetTunnel struct {
+	typ          flows.TunnelType
+	id           uint32
+	srcIP, dstIP net.IP
+	port         uint32
+	hasPort      bool
+	direction    flows.TunnelDirection
+
+	mpls     [flows.MaxMPLSLabels]uint32
+	nMPLS    int
+	pppoe    uint16
+	hasPPPoE bool
+}
+
+// empty returns true if the packet has been neither tunneled nor carried
+// in MPLS or PPPoE.
+func (t *packetTunnel) empty() bool {
+	return t.typ == 0 && t.nMPLS == 0 && !t.hasPPPoE
+}
+
+// Tunnels keeps the tunnels of the connections seen in tunnels, MPLS label
+// stacks or PPPoE sessions, such that the events of the protocol analyzers
+// can be tagged with the tunnel of their connection. Tunnels is shared by
+// the decoders of an interface. Connections are kept apart by the type and
+// id of their tunnel, such that tenants of overlay networks using the same
+// addresses are not mixed. Lookups by the endpoints of a connection return
+// the tunnel it has been seen in last.
+//
+// At most maxConns connections are kept, the connection seen least recently
+// is evicted to make room for a new one.
+type Tunnels struct {
+	mu       sync.Mutex
+	maxConns int
+	conns    map[tunnelConnKey]*list.Element
+	tuples   map[tupleKey]*list.Element // connection seen last by its endpoints
+	lru      *list.List                 // of *tunnelConn, most recently seen first
+}
+
+// tupleKey identifies a connection independent of its direction. The
+// endpoints are ordered.
+type tupleKey struct {
+	transport    string
+	ip1, ip2     [16]byte
+	port1, port2 uint16
+}
+
+// tunnelConnKey identifies a connection within its tunnel. The id of GTP-U
+// tunnels is not part of the key, as TEIDs differ by direction.
+type tunnelConnKey struct {
+	tuple tupleKey
+	typ   flows.TunnelType
+	id    uint32
+}
+
+type tunnelConn struct {
+	Tunnel
+	key  tunnelConnKey
+	last time.Time
+
+	// TEIDs of GTP-U tunnels and MPLS labels by direction. Index 0 is the
+	// direction of the tuple key.
+	teids   [2]uint32
+	seen    [2]bool
+	labels  [2][flows.MaxMPLSLabels]uint32
+	nLabels [2]int
+}
+
+// NewTunnels creates an empty tunnel table keeping up to maxConns
+// connections, or a default number if maxConns is 0.
+func NewTunnels(maxConns int) *Tunnels {
+	if maxConns <= 0 {
+		maxConns = defaultTunnelMaxConnections
+	}
+	return &Tunnels{
+		maxConns: maxConns,
+		conns:    map[tunnelConnKey]*list.Element{},
+		tuples:   map[tupleKey]*list.Element{},
+		lru:      list.New(),
+	}
+}
+
+func newTupleKey(transport string, srcIP net.IP, srcPort uint16, dstIP net.IP, dstPort uint16) (key tupleKey, reversed bool) {
+	key = tupleKey{transport: transport, port1: srcPort, port2: dstPort}
+	copy(key.ip1[:], srcIP.To16())
+	copy(key.ip2[:], dstIP.To16())
+
+	c := bytes.Compare(key.ip1[:], key.ip2[:])
+	if c > 0 || (c == 0 && key.port1 > key.port2) {
+		key.ip1, key.ip2 = key.ip2, key.ip1
+		key.port1, key.port2 = key.port2, key.port1
+		reversed = true
+	}
+	return key, reversed
+}
+
+// add records the tunnel of a packet of a connection seen at ts.
+func (t *Tunnels) add(ts time.Time, transport string, packet *protos.Packet, tunnel *packetTunnel) {
+	tuple, reversed := newTupleKey(transport,
+		packet.Tuple.SrcIP, packet.Tuple.SrcPort, packet.Tuple.DstIP, packet.Tuple.DstPort)
+	key := tunnelConnKey{tuple: tuple, typ: tunnel.typ, id: tunnel.id}
+	if tunnel.typ == flows.TunnelGTP {
+		key.id = 0
+	}
+	src, dst := tunnel.srcIP, tunnel.dstIP
+	if reversed {
+		src, dst = dst, src
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.expire(ts)
+
+	elem := t.conns[key]
+	added := elem == nil
+	if added {
+		if t.lru.Len() >= t.maxConns {
+			t.remove(t.lru.Back())
+			tunnelEvicted.Inc()
+		}
+		elem = t.lru.PushFront(&tunnelConn{key: key})
+		t.conns[key] = elem
+	} else {
+		t.lru.MoveToFront(elem)
+	}
+	t.tuples[tuple] = elem
+
+	conn := elem.Value.(*tunnelConn)
+	if added || !conn.SrcIP.Equal(src) || !conn.DstIP.Equal(dst) {
+		// the addresses reference the packet, which is reused
+		*conn = tunnelConn{key: key, Tunnel: Tunnel{
+			Type:  tunnel.typ,
+			ID:    key.id,
+			SrcIP: append(net.IP(nil), src...),
+			DstIP: append(net.IP(nil), dst...),
+		}}
+	}
+	dir := 0
+	if reversed {
+		dir = 1
+	}
+	if tunnel.typ == flows.TunnelGTP {
+		conn.teids[dir], conn.seen[dir] = tunnel.id, true
+	}
+	conn.Port, conn.HasPort, conn.Direction = tunnel.port, tunnel.hasPort, tunnel.direction
+	conn.PPPoESession, conn.HasPPPoE = tunnel.pppoe, tunnel.hasPPPoE
+	conn.labels[dir], conn.nLabels[dir] = tunnel.mpls, tunnel.nMPLS
+	conn.last = ts
+}
+
+// expire removes the connections not seen for tunnelConnTimeout before ts.
+func (t *Tunnels) expire(ts time.Time) {
+	for elem := t.lru.Back(); elem != nil; elem = t.lru.Back() {
+		if ts.Sub(elem.Value.(*tunnelConn).last) < tunnelConnTimeout {
+			return
+		}
+		t.remove(elem)
+	}
+}
+
+func (t *Tunnels) remove(elem *list.Element) {
+	conn := elem.Value.(*tunnelConn)
+	delete(t.conns, conn.key)
+	if t.tuples[conn.key.tuple] == elem {
+		delete(t.tuples, conn.key.tuple)
+	}
+	t.lru.Remove(elem)
+}
+
+// Lookup returns the tunnel a connection has been seen in last. The
+// transport is one of tcp, udp, icmp or ipv6-icmp.
+func (t *Tunnels) Lookup(transport string, srcIP net.IP, srcPort uint16, dstIP net.IP, dstPort uint16) (Tunnel, bool) {
+	tuple, reversed := newTupleKey(transport, srcIP, srcPort, dstIP, dstPort)
+
+	t.mu.Lock()
+	elem, ok := t.tuples[tuple]
+	var tunnel Tunnel
+	if ok {
+		conn := elem.Value.(*tunnelConn)
+		tunnel = conn.Tunnel
+		src := 0
+		if reversed {
+			src = 1
+		}
+		tunnel.SrcTEID, tunnel.HasSrcTEID = conn.teids[src], conn.seen[src]
+		tunnel.DstTEID, tunnel.HasDstTEID = conn.teids[1-src], conn.seen[1-src]
+		tunnel.SrcMPLSLabels = conn.mplsLabels(src)
+		tunnel.DstMPLSLabels = conn.mplsLabels(1 - src)
+	}
+	t.mu.Unlock()
+	if !ok {
+		return Tunnel{}, false
+	}
+
+	if reversed {
+		tunnel.SrcIP, tunnel.DstIP = tunnel.DstIP, tunnel.SrcIP
+	}
+	return tunnel, true
+}
+
+// mplsLabels returns a copy of the MPLS labels of direction dir, or nil if
+// none have been seen.
+func (c *tunnelConn) mplsLabels(dir int) []uint32 {
+	if c.nLabels[dir] == 0 {
+		return nil
+	}
+	return append([]uint32(nil), c.labels[dir][:c.nLabels[dir]]...)
+}
+
+// TunnelPorts maps the UDP destination ports of tunnels to their types.
+type TunnelPorts map[uint16]flows.TunnelType
+
+// NewTunnelPorts returns the ports of the UDP tunnels decapsulated with cfg,
+// or nil if tunnels are not decapsulated.
+func NewTunnelPorts(cfg config.TunnelsConfig) TunnelPorts {
+	if !cfg.IsEnabled() {
+		return nil
+	}
+
+	vxlan, geneve, gtp := cfg.VXLANPorts, cfg.GenevePorts, cfg.GTPPorts
+	if vxlan == nil {
+		vxlan = []int{defaultVXLANPort}
+	}
+	if geneve == nil {
+		geneve = []int{defaultGenevePort}
+	}
+	if gtp == nil {
+		gtp = []int{defaultGTPPort}
+	}
+
+	ports := TunnelPorts{}
+	for _, port := range vxlan {
+		ports[uint16(port)] = flows.TunnelVXLAN
+	}
+	for _, port := range geneve {
+		ports[uint16(port)] = flows.TunnelGeneve
+	}
+	for _, port := range gtp {
+		ports[uint16(port)] = flows.TunnelGTP
+	}
+	return ports
+}
+
+// vxlanHeader parses the VXLAN header at the start of data, returning the
+// VNI and the encapsulated ethernet frame.
+func vxlanHeader(data []byte) (vni uint32, payload []byte, ok bool) {
+	if len(data) < sizeVXLANHeader || data[0]&0x08 == 0 {
+		// the I flag marks a valid VNI
+		return 0, nil, false
+	}
+	return binary.BigEndian.Uint32(data[4:8]) >> 8, data[sizeVXLANHeader:], true
+}
+
+// geneveHeader parses the Geneve header at the start of data, returning the
+// VNI, the type of the encapsulated layer and the layer itself.
+func geneveHeader(data []byte) (vni uint32, next gopacket_dpdk.LayerType, payload []byte, ok bool) {
+	if len(data) < sizeGeneveHeader || data[0]>>6 != 0 {
+		return 0, 0, nil, false
+	}
+	length := sizeGeneveHeader + int(data[0]&0x3f)*4
+	if len(data) < length {
+		return 0, 0, nil, false
+	}
+
+	switch binary.BigEndian.Uint16(data[2:4]) {
+	case etherTypeTransparentBridging:
+		next = layers.LayerTypeEthernet
+	case uint16(layers.EthernetTypeIPv4):
+		next = layers.LayerTypeIPv4
+	case uint16(layers.EthernetTypeIPv6):
+		next = layers.LayerTypeIPv6
+	default:
+		return 0, 0, nil, false
+	}
+	return binary.BigEndian.Uint32(data[4:8]) >> 8, next, data[length:], true
+}
+
+// gtpHeader parses the GTP-U header of a G-PDU at the start of data,
+// returning the TEID, the type of the encapsulated IP packet and the packet
+// itself. Extension headers are skipped.
+func gtpHeader(data []byte) (teid uint32, next gopacket_dpdk.LayerType, payload []byte, ok bool) {
+	// version 1, protocol type GTP
+	if len(data) < sizeGTPHeader || data[0]>>4 != 0x3 {
+		return 0, 0, nil, false
+	}
+	length := int(binary.BigEndian.Uint16(data[2:4]))
+	if len(data) < sizeGTPHeader+length {
+		return 0, 0, nil, false
+	}
+	teid = binary.BigEndian.Uint32(data[4:8])
+	payload = data[sizeGTPHeader : sizeGTPHeader+length]
+
+	if flags := data[0]; flags&0x07 != 0 {
+		// the sequence number, N-PDU number and next extension type are
+		// present if any of the E, S or PN flags is set
+		if len(payload) < sizeGTPOptional {
+			return 0, 0, nil, false
+		}
+		extType := payload[3]
+		payload = payload[sizeGTPOptional:]
+		if flags&0x04 == 0 {
+			extType = 0
+		}
+		for extType != 0 {
+			// the length is in units of 4 bytes, the last byte is the type of
+			// the next extension header
+			if len(payload) < 1 {
+				return 0, 0, nil, false
+			}
+			n := int(payload[0]) * 4
+			if n == 0 || len(payload) < n {
+				return 0, 0, nil, false
+			}
+			extType, payload = payload[n-1], payload[n:]
+		}
+	}
+
+	if len(payload) == 0 {
+		return 0, 0, nil, false
+	}
+	switch payload[0] >> 4 {
+	case 4:
+		next = layers.LayerTypeIPv4
+	case 6:
+		next = layers.LayerTypeIPv6
+	default:
+		return 0, 0, nil, false
+	}
+	return teid, next, payload, true
+}
+
+// udpTunnelHeader parses the header of a UDP tunnel of type typ at the start
+// of the UDP payload, returning the tunnel id, the type of the encapsulated
+// layer and the layer itself. isTunnel is false for GTP-U signalling
+// messages, e.g. echo requests, which carry no user data.
+func udpTunnelHeader(typ flows.TunnelType, data []byte) (id uint32, next gopacket_dpdk.LayerType, payload []byte, isTunnel, ok bool) {
+	switch typ {
+	case flows.TunnelVXLAN:
+		id, payload, ok = vxlanHeader(data)
+		return id, layers.LayerTypeEthernet, payload, true, ok
+	case flows.TunnelGeneve:
+		id, next, payload, ok = geneveHeader(data)
+		return id, next, payload, true, ok
+	case flows.TunnelGTP:
+		if len(data) < 2 || data[1] != gtpMessageGPDU {
+			return 0, 0, nil, false, false
+		}
+		id, next, payload, ok = gtpHeader(data)
+		return id, next, payload, true, ok
+	}
+	return 0, 0, nil, false, false
+}
+
+// decapUDP decapsulates the packet of a UDP tunnel. It returns false if the
+// UDP layer is not a tunnel, in which case the UDP payload is analyzed.
+func (d *Decoder) decapUDP(packet *protos.Packet, next *nextLayer) bool {
+	typ, ok := d.tunnelPorts[uint16(d.udp.DstPort)]
+	if !ok {
+		return false
+	}
+
+	id, nextType, payload, isTunnel, ok := udpTunnelHeader(typ, d.udp.Payload)
+	if !isTunnel {
+		return false
+	}
+	if !ok {
+		tunnelMalformed.Inc()
+		return false
+	}
+	next.typ, next.data = nextType, payload
+
+	debugf("Decapsulating %v tunnel %d", typ, id)
+	d.onTunnel(typ, id, packet)
+	return true
+}
+
+// onTunnel records the tunnel of the packet. The endpoints of the tunnel
+// are the addresses of the outer IP layer. The TEIDs of GTP-U tunnels differ
+// by direction, so they are not part of the flow ID but recorded per
+// direction in a flow counter.
+func (d *Decoder) onTunnel(typ flows.TunnelType, id uint32, packet *protos.Packet) {
+	tunnelDecapsulated.Inc()
+	t := &d.tunnel
+	t.typ, t.id = typ, id
+	t.srcIP, t.dstIP = packet.Tuple.SrcIP, packet.Tuple.DstIP
+	t.port, t.hasPort, t.direction = 0, false, 0
+	if d.flowID != nil {
+		if typ == flows.TunnelGTP {
+			id = 0
+		}
+		d.flowID.AddTunnel(typ, id)
+	}
+}
+
+// recordTunnel records the tunnel, MPLS labels and PPPoE session of a packet
+// of a connection, once its transport layer has been decoded.
+func (d *Decoder) recordTunnel(transport string, packet *protos.Packet) {
+	if d.tunnels == nil || d.tunnel.empty() {
+		return
+	}
+	d.tunnels.add(packet.Ts, transport, packet, &d.tunnel)
+}
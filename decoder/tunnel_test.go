@@ -0,0 +1,297 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !integration
+// +build !integration
+
+package decoder
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/njcx/gopacket_dpdk"
+
+	"github.com/njcx/packetbeat7_dpdk/config"
+	"github.com/njcx/packetbeat7_dpdk/flows"
+	"github.com/njcx/packetbeat7_dpdk/procs"
+)
+
+// udpTunnel wraps payload into an ethernet frame of a UDP datagram from
+// 10.0.0.1 to 10.0.0.2 sent to port.
+func udpTunnel(port uint16, payload []byte) []byte {
+	frame := []byte{
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x08, 0x00,
+		0x45, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40, 0x11, 0x00, 0x00,
+		10, 0, 0, 1, 10, 0, 0, 2,
+		0xc0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	}
+	binary.BigEndian.PutUint16(frame[14+2:], uint16(20+8+len(payload)))
+	binary.BigEndian.PutUint16(frame[14+20+2:], port)
+	binary.BigEndian.PutUint16(frame[14+20+4:], uint16(8+len(payload)))
+	return append(frame, payload...)
+}
+
+func vxlanPacket(vni uint32, inner []byte) []byte {
+	hdr := make([]byte, 8)
+	hdr[0] = 0x08
+	binary.BigEndian.PutUint32(hdr[4:], vni<<8)
+	return udpTunnel(4789, append(hdr, inner...))
+}
+
+func genevePacket(vni uint32, proto uint16, inner []byte) []byte {
+	// one option of 4 bytes
+	hdr := make([]byte, 12)
+	hdr[0] = 1
+	binary.BigEndian.PutUint16(hdr[2:], proto)
+	binary.BigEndian.PutUint32(hdr[4:], vni<<8)
+	return udpTunnel(6081, append(hdr, inner...))
+}
+
+func TestDecodePacketData_vxlan(t *testing.T) {
+	d, tcp, _ := newTestDecoder(t)
+	tunnels := NewTunnels(0)
+	d.SetTunnelConfig(config.TunnelsConfig{}, tunnels)
+
+	packet := vxlanPacket(4242, ipv4TcpDNS)
+	d.OnPacket(packet, &gopacket_dpdk.CaptureInfo{Length: len(packet)})
+
+	if !assert.NotNil(t, tcp.pkt, "TCP packet not received") {
+		return
+	}
+	assert.Equal(t, "172.16.16.164", tcp.pkt.Tuple.SrcIP.String())
+	assert.Equal(t, uint16(1108), tcp.pkt.Tuple.SrcPort)
+	assert.Equal(t, "172.16.16.139", tcp.pkt.Tuple.DstIP.String())
+	assert.Equal(t, uint16(53), tcp.pkt.Tuple.DstPort)
+
+	// looked up in the direction of the response
+	tunnel, ok := tunnels.Lookup("tcp", net.ParseIP("172.16.16.139"), 53, net.ParseIP("172.16.16.164"), 1108)
+	if assert.True(t, ok) {
+		assert.Equal(t, flows.TunnelVXLAN, tunnel.Type)
+		assert.Equal(t, uint32(4242), tunnel.ID)
+		assert.Equal(t, "10.0.0.2", tunnel.SrcIP.String())
+		assert.Equal(t, "10.0.0.1", tunnel.DstIP.String())
+	}
+	_, ok = tunnels.Lookup("udp", net.ParseIP("172.16.16.139"), 53, net.ParseIP("172.16.16.164"), 1108)
+	assert.False(t, ok)
+}
+
+func TestDecodePacketData_geneve(t *testing.T) {
+	d, _, udp := newTestDecoder(t)
+	tunnels := NewTunnels(0)
+	d.SetTunnelConfig(config.TunnelsConfig{}, tunnels)
+
+	// IPv6 packet without ethernet header
+	packet := genevePacket(7, 0x86dd, ipv6UdpDNS[14:])
+	d.OnPacket(packet, &gopacket_dpdk.CaptureInfo{Length: len(packet)})
+
+	if !assert.NotNil(t, udp.pkt, "UDP packet not received") {
+		return
+	}
+	assert.Equal(t, "3ffe:507:0:1:200:86ff:fe05:80da", udp.pkt.Tuple.SrcIP.String())
+	assert.Equal(t, uint16(53), udp.pkt.Tuple.DstPort)
+	assert.Equal(t, ipv6UdpDNS[14+40+8:], udp.pkt.Payload)
+
+	tunnel, ok := tunnels.Lookup("udp", udp.pkt.Tuple.SrcIP, 2415, udp.pkt.Tuple.DstIP, 53)
+	if assert.True(t, ok) {
+		assert.Equal(t, flows.TunnelGeneve, tunnel.Type)
+		assert.Equal(t, uint32(7), tunnel.ID)
+		assert.Equal(t, "10.0.0.1", tunnel.SrcIP.String())
+	}
+}
+
+func TestDecodePacketData_tunnelsDisabled(t *testing.T) {
+	d, tcp, udp := newTestDecoder(t)
+	disabled := false
+	d.SetTunnelConfig(config.TunnelsConfig{Enabled: &disabled}, nil)
+
+	packet := vxlanPacket(4242, ipv4TcpDNS)
+	d.OnPacket(packet, &gopacket_dpdk.CaptureInfo{Length: len(packet)})
+
+	assert.Nil(t, tcp.pkt)
+	if assert.NotNil(t, udp.pkt, "UDP packet not received") {
+		assert.Equal(t, uint16(4789), udp.pkt.Tuple.DstPort)
+	}
+}
+
+func TestDecodePacketData_vxlanMalformed(t *testing.T) {
+	d, tcp, udp := newTestDecoder(t)
+
+	packet := vxlanPacket(4242, ipv4TcpDNS)
+	packet[14+20+8] = 0 // clear the I flag
+	malformed := tunnelMalformed.Get()
+	d.OnPacket(packet, &gopacket_dpdk.CaptureInfo{Length: len(packet)})
+
+	assert.Nil(t, tcp.pkt)
+	assert.NotNil(t, udp.pkt, "UDP packet not received")
+	assert.Equal(t, malformed+1, tunnelMalformed.Get())
+}
+
+func TestDecodePacketData_vxlanPorts(t *testing.T) {
+	d, tcp, udp := newTestDecoder(t)
+	d.SetTunnelConfig(config.TunnelsConfig{VXLANPorts: []int{8472}}, nil)
+
+	packet := vxlanPacket(1, ipv4TcpDNS)
+	d.OnPacket(packet, &gopacket_dpdk.CaptureInfo{Length: len(packet)})
+	assert.Nil(t, tcp.pkt)
+	assert.NotNil(t, udp.pkt)
+
+	binary.BigEndian.PutUint16(packet[14+20+2:], 8472)
+	d.OnPacket(packet, &gopacket_dpdk.CaptureInfo{Length: len(packet)})
+	assert.NotNil(t, tcp.pkt)
+}
+
+// gtpPacket wraps the IP packet inner into a GTP-U G-PDU sent to port 2152.
+// ext are the extension headers, each of them ending with the type of the
+// next one.
+func gtpPacket(teid uint32, ext []byte, inner []byte) []byte {
+	hdr := make([]byte, 8)
+	hdr[0], hdr[1] = 0x30, gtpMessageGPDU
+	binary.BigEndian.PutUint32(hdr[4:], teid)
+	if ext != nil {
+		hdr[0] |= 0x04
+		hdr = append(hdr, 0, 0, 0, 0x85) // PDU session container follows
+		hdr = append(hdr, ext...)
+	}
+	binary.BigEndian.PutUint16(hdr[2:], uint16(len(hdr)-8+len(inner)))
+	return udpTunnel(2152, append(hdr, inner...))
+}
+
+func TestDecodePacketData_gtp(t *testing.T) {
+	// requests and responses are decoded by separate decoders, such that
+	// the flow IDs of both can be compared
+	f, err := flows.NewFlows(nil, procs.ProcessesWatcher{}, &config.Flows{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tunnels := NewTunnels(0)
+	d, _, udp := newFlowsTestDecoder(t, f)
+	d.SetTunnelConfig(config.TunnelsConfig{}, tunnels)
+	d2, _, responses := newFlowsTestDecoder(t, f)
+	d2.SetTunnelConfig(config.TunnelsConfig{}, tunnels)
+
+	packet := gtpPacket(0x1001, []byte{1, 0x10, 0x09, 0}, ipv4UdpDNS[14:])
+	d.OnPacket(packet, &gopacket_dpdk.CaptureInfo{Length: len(packet)})
+	if !assert.NotNil(t, udp.pkt, "UDP packet not received") {
+		return
+	}
+	assert.Equal(t, "192.168.170.8", udp.pkt.Tuple.SrcIP.String())
+	assert.Equal(t, uint16(53), udp.pkt.Tuple.DstPort)
+	assert.Equal(t, ipv4UdpDNS[14+20+8:], udp.pkt.Payload)
+
+	tunnel, ok := tunnels.Lookup("udp", udp.pkt.Tuple.SrcIP, 32795, udp.pkt.Tuple.DstIP, 53)
+	if assert.True(t, ok) {
+		assert.Equal(t, flows.TunnelGTP, tunnel.Type)
+		assert.Equal(t, uint32(0), tunnel.ID)
+		assert.Equal(t, uint32(0x1001), tunnel.SrcTEID)
+		assert.True(t, tunnel.HasSrcTEID)
+		assert.False(t, tunnel.HasDstTEID)
+	}
+
+	// the response is sent with the TEID of the other direction
+	response := reversedFrame(gtpPacket(0x2002, nil, udpResponse(ipv4UdpDNS[14:])))
+	copy(response[14+12:], []byte{10, 0, 0, 2, 10, 0, 0, 1})
+	d2.OnPacket(response, &gopacket_dpdk.CaptureInfo{Length: len(response)})
+
+	// both directions are one flow
+	if assert.NotNil(t, udp.flowID) && assert.NotNil(t, responses.flowID) {
+		assert.True(t, flows.FlowIDsEqual(udp.flowID, responses.flowID))
+	}
+
+	tunnel, ok = tunnels.Lookup("udp", net.ParseIP("192.168.170.8"), 32795, net.ParseIP("192.168.170.20"), 53)
+	if assert.True(t, ok) {
+		assert.Equal(t, uint32(0x1001), tunnel.SrcTEID)
+		assert.Equal(t, uint32(0x2002), tunnel.DstTEID)
+		assert.True(t, tunnel.HasSrcTEID && tunnel.HasDstTEID)
+		assert.Equal(t, "10.0.0.1", tunnel.SrcIP.String())
+	}
+	tunnel, ok = tunnels.Lookup("udp", net.ParseIP("192.168.170.20"), 53, net.ParseIP("192.168.170.8"), 32795)
+	if assert.True(t, ok) {
+		assert.Equal(t, uint32(0x2002), tunnel.SrcTEID)
+		assert.Equal(t, uint32(0x1001), tunnel.DstTEID)
+		assert.Equal(t, "10.0.0.2", tunnel.SrcIP.String())
+	}
+}
+
+func TestDecodePacketData_gtpSignalling(t *testing.T) {
+	d, _, udp := newTestDecoder(t)
+
+	// echo request
+	packet := udpTunnel(2152, []byte{0x32, 0x01, 0x00, 0x04, 0, 0, 0, 0, 0, 1, 0, 0})
+	malformed := tunnelMalformed.Get()
+	d.OnPacket(packet, &gopacket_dpdk.CaptureInfo{Length: len(packet)})
+
+	if assert.NotNil(t, udp.pkt, "UDP packet not received") {
+		assert.Equal(t, uint16(2152), udp.pkt.Tuple.DstPort)
+	}
+	assert.Equal(t, malformed, tunnelMalformed.Get())
+
+	// extension header of length 0
+	udp.pkt = nil
+	packet = gtpPacket(1, []byte{0, 0, 0, 0}, ipv4UdpDNS[14:])
+	d.OnPacket(packet, &gopacket_dpdk.CaptureInfo{Length: len(packet)})
+	assert.NotNil(t, udp.pkt)
+	assert.Equal(t, malformed+1, tunnelMalformed.Get())
+}
+
+func TestTunnels_connections(t *testing.T) {
+	d, tcp, _ := newTestDecoder(t)
+	tunnels := NewTunnels(2)
+	d.SetTunnelConfig(config.TunnelsConfig{}, tunnels)
+
+	ts := time.Unix(1600000000, 0)
+	send := func(vni uint32, ts time.Time) {
+		packet := vxlanPacket(vni, ipv4TcpDNS)
+		d.OnPacket(packet, &gopacket_dpdk.CaptureInfo{Timestamp: ts, Length: len(packet)})
+	}
+	lookup := func() (Tunnel, bool) {
+		return tunnels.Lookup("tcp", tcp.pkt.Tuple.SrcIP, 1108, tcp.pkt.Tuple.DstIP, 53)
+	}
+
+	// the same connection in two overlay networks is tracked per network,
+	// the network it has been seen in last is reported
+	send(1, ts)
+	send(2, ts.Add(time.Second))
+	assert.Equal(t, 2, tunnels.lru.Len())
+	if tunnel, ok := lookup(); assert.True(t, ok) {
+		assert.Equal(t, uint32(2), tunnel.ID)
+	}
+	send(1, ts.Add(2*time.Second))
+	if tunnel, ok := lookup(); assert.True(t, ok) {
+		assert.Equal(t, uint32(1), tunnel.ID)
+	}
+
+	// the connection seen least recently is evicted once the table is full
+	evicted := tunnelEvicted.Get()
+	send(3, ts.Add(3*time.Second))
+	assert.Equal(t, evicted+1, tunnelEvicted.Get())
+	assert.Equal(t, 2, tunnels.lru.Len())
+	tuple, _ := newTupleKey("tcp", tcp.pkt.Tuple.SrcIP, 1108, tcp.pkt.Tuple.DstIP, 53)
+	assert.NotContains(t, tunnels.conns, tunnelConnKey{tuple: tuple, typ: flows.TunnelVXLAN, id: 2})
+	assert.Contains(t, tunnels.conns, tunnelConnKey{tuple: tuple, typ: flows.TunnelVXLAN, id: 1})
+
+	// idle connections expire
+	send(3, ts.Add(3*time.Second+tunnelConnTimeout))
+	assert.Equal(t, 1, tunnels.lru.Len())
+	if tunnel, ok := lookup(); assert.True(t, ok) {
+		assert.Equal(t, uint32(3), tunnel.ID)
+	}
+}
@@ -1,30 +1,82 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package dpdkinit initializes the DPDK environment abstraction layer (EAL)
+// and configures the ethernet ports used by the dpdk sniffer type.
 package dpdkinit
 
+/*
+#include <stdlib.h>
+#include <rte_eal.h>
+#include <rte_errno.h>
+
+static int pb_rte_errno(void) {
+	return rte_errno;
+}
+*/
+import "C"
+
 import (
-	"github.com/njcx/gopacket_dpdk/dpdk"
-	"strconv"
+	"fmt"
+	"sync"
+	"unsafe"
+
+	"github.com/njcx/libbeat_v7/logp"
 )
 
-var DPdk *dpdk.DPDKHandle
-
-func DpdkInit() error {
-
-	dpdkPort, _ := Parse("dpdk_port")
-	dpdkStatus, _ := Parse("dpdk_status")
-	if dpdkStatus == "enable" {
-		err := dpdk.InitDPDK([]string{})
-		if err != nil {
-			return err
-		}
-		num16, _ := strconv.ParseUint(dpdkPort, 10, 16)
-		port := uint16(num16)
-
-		h, err := dpdk.NewDPDKHandle(port)
-		if err != nil {
-			h.Close()
-			return err
-		}
-		DPdk = h
+const progName = "packetbeat"
+
+var (
+	ealOnce sync.Once
+	ealErr  error
+)
+
+// DpdkInit initializes the EAL with the given arguments. The EAL can only
+// be initialized once per process. Subsequent calls return the result of
+// the first initialization, regardless of the arguments passed; the config
+// validation ensures that all interfaces pass the same arguments.
+func DpdkInit(args []string) error {
+	ealOnce.Do(func() {
+		ealErr = initEAL(args)
+	})
+	return ealErr
+}
+
+func initEAL(args []string) error {
+	logp.Debug("dpdk", "Initializing EAL with args: %v", args)
+
+	// rte_eal_init may keep references into argv, so the arguments are
+	// allocated in C memory and never freed.
+	argv := append([]string{progName}, args...)
+	cargv := (*[1 << 16]*C.char)(C.malloc(C.size_t(len(argv)) * C.size_t(unsafe.Sizeof(uintptr(0)))))
+	for i, arg := range argv {
+		cargv[i] = C.CString(arg)
+	}
+
+	if ret := C.rte_eal_init(C.int(len(argv)), &cargv[0]); ret < 0 {
+		return fmt.Errorf("EAL initialization failed: %s", strerror(rteErrno()))
 	}
 	return nil
 }
+
+func rteErrno() int {
+	return int(C.pb_rte_errno())
+}
+
+func strerror(errno int) string {
+	return C.GoString(C.rte_strerror(C.int(errno)))
+}
@@ -0,0 +1,374 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dpdkinit
+
+/*
+#include <errno.h>
+#include <stdlib.h>
+#include <string.h>
+#include <rte_ethdev.h>
+#include <rte_mbuf.h>
+#include <rte_mempool.h>
+
+#define PB_MAX_BURST 64
+
+static struct rte_mempool *pb_pool_create(const char *name, unsigned n, int socket) {
+	struct rte_mempool *mp = rte_mempool_lookup(name);
+	if (mp != NULL) {
+		return mp;
+	}
+	return rte_pktmbuf_pool_create(name, n, 256, 0, RTE_MBUF_DEFAULT_BUF_SIZE, socket);
+}
+
+// A RSS key made of a repeated 16 bit pattern hashes both directions of a
+// connection to the same queue.
+static uint8_t pb_symmetric_rss_key[40] = {
+	0x6d, 0x5a, 0x6d, 0x5a, 0x6d, 0x5a, 0x6d, 0x5a, 0x6d, 0x5a,
+	0x6d, 0x5a, 0x6d, 0x5a, 0x6d, 0x5a, 0x6d, 0x5a, 0x6d, 0x5a,
+	0x6d, 0x5a, 0x6d, 0x5a, 0x6d, 0x5a, 0x6d, 0x5a, 0x6d, 0x5a,
+	0x6d, 0x5a, 0x6d, 0x5a, 0x6d, 0x5a, 0x6d, 0x5a, 0x6d, 0x5a,
+};
+
+static int pb_port_conf(uint16_t port, uint16_t nb_queues, struct rte_eth_conf *conf) {
+	struct rte_eth_dev_info info;
+	int ret;
+
+	memset(conf, 0, sizeof(*conf));
+	if (nb_queues < 2) {
+		return 0;
+	}
+
+	ret = rte_eth_dev_info_get(port, &info);
+	if (ret < 0) {
+		return ret;
+	}
+	if (nb_queues > info.max_rx_queues) {
+		return -EINVAL;
+	}
+
+	conf->rxmode.mq_mode = ETH_MQ_RX_RSS;
+	conf->rx_adv_conf.rss_conf.rss_key = pb_symmetric_rss_key;
+	conf->rx_adv_conf.rss_conf.rss_key_len = sizeof(pb_symmetric_rss_key);
+	conf->rx_adv_conf.rss_conf.rss_hf = (ETH_RSS_IP | ETH_RSS_TCP | ETH_RSS_UDP) & info.flow_type_rss_offloads;
+	return 0;
+}
+
+static uint16_t pb_rx_burst(uint16_t port, uint16_t queue, struct rte_mbuf **pkts, uint16_t n) {
+	return rte_eth_rx_burst(port, queue, pkts, n);
+}
+
+// pb_copy_mbuf copies up to len bytes of the packet into buf, stores the
+// packet length in pktlen and releases the mbuf.
+static uint32_t pb_copy_mbuf(struct rte_mbuf *m, void *buf, uint32_t len, uint32_t *pktlen) {
+	const void *p;
+	uint32_t n;
+
+	*pktlen = rte_pktmbuf_pkt_len(m);
+	n = *pktlen < len ? *pktlen : len;
+	p = rte_pktmbuf_read(m, 0, n, buf);
+	if (p != NULL && p != buf) {
+		memcpy(buf, p, n);
+	}
+	rte_pktmbuf_free(m);
+	return p != NULL ? n : 0;
+}
+
+// pb_rx_burst_copy receives up to n packets and copies them back to back
+// into buf, which must hold n * snaplen bytes. The captured and original
+// length of each packet are stored in caplens and pktlens.
+static uint16_t pb_rx_burst_copy(uint16_t port, uint16_t queue, uint16_t n, uint8_t *buf,
+		uint32_t snaplen, uint32_t *caplens, uint32_t *pktlens) {
+	struct rte_mbuf *pkts[PB_MAX_BURST];
+	uint16_t i, nb;
+
+	if (n > PB_MAX_BURST) {
+		n = PB_MAX_BURST;
+	}
+	nb = rte_eth_rx_burst(port, queue, pkts, n);
+	for (i = 0; i < nb; i++) {
+		caplens[i] = pb_copy_mbuf(pkts[i], buf, snaplen, &pktlens[i]);
+		buf += caplens[i];
+	}
+	return nb;
+}
+
+static void pb_free_mbufs(struct rte_mbuf **pkts, uint16_t n) {
+	uint16_t i;
+	for (i = 0; i < n; i++) {
+		rte_pktmbuf_free(pkts[i]);
+	}
+}
+*/
+import "C"
+
+import (
+	"fmt"
+	"sync"
+	"unsafe"
+
+	"github.com/njcx/libbeat_v7/logp"
+)
+
+// burstSize is the number of mbufs fetched from a RX queue at once by
+// ReadPacket.
+const burstSize = 32
+
+// MaxBurstSize is the maximum number of packets received by ReadBurst.
+const MaxBurstSize = 64
+
+// PortConfig configures an ethernet port.
+type PortConfig struct {
+	Port          uint16
+	RxQueues      int
+	MbufPoolSize  int
+	RxDescriptors int
+	Promiscuous   bool
+	MTU           int
+}
+
+// Port is a configured and started ethernet port.
+type Port struct {
+	id     uint16
+	pool   *C.struct_rte_mempool
+	queues []*Queue
+
+	xstatNames []string
+	xstats     []C.struct_rte_eth_xstat
+}
+
+// Queue receives packets from a single RX queue of a port. A Queue must
+// only be used by one goroutine at a time.
+type Queue struct {
+	port, id uint16
+
+	mbufs    [burstSize]*C.struct_rte_mbuf
+	pos, cnt int
+}
+
+var (
+	portsMu   sync.Mutex
+	portsOpen = map[uint16]bool{}
+)
+
+// OpenPort configures and starts an ethernet port. DpdkInit must have been
+// called before.
+func OpenPort(cfg PortConfig) (*Port, error) {
+	portsMu.Lock()
+	defer portsMu.Unlock()
+
+	if portsOpen[cfg.Port] {
+		return nil, fmt.Errorf("dpdk port %d is already in use", cfg.Port)
+	}
+	if C.rte_eth_dev_is_valid_port(C.uint16_t(cfg.Port)) == 0 {
+		return nil, fmt.Errorf("dpdk port %d is not available, %d ports found",
+			cfg.Port, int(C.rte_eth_dev_count_avail()))
+	}
+
+	p := &Port{id: cfg.Port}
+	if err := p.setup(cfg); err != nil {
+		return nil, err
+	}
+
+	portsOpen[cfg.Port] = true
+	return p, nil
+}
+
+func (p *Port) setup(cfg PortConfig) error {
+	port := C.uint16_t(p.id)
+	socket := C.rte_eth_dev_socket_id(port)
+	if socket < 0 {
+		socket = 0
+	}
+
+	// mbuf pools can not be destroyed while a port still references them,
+	// so the pool is looked up by name and reused if the port is reopened.
+	name := C.CString(fmt.Sprintf("pb_mbuf_pool_%d", p.id))
+	defer C.free(unsafe.Pointer(name))
+	p.pool = C.pb_pool_create(name, C.uint(cfg.MbufPoolSize), socket)
+	if p.pool == nil {
+		return fmt.Errorf("failed to create mbuf pool of size %d for port %d: %s",
+			cfg.MbufPoolSize, p.id, strerror(rteErrno()))
+	}
+
+	// With more than one queue packets are distributed by RSS using a
+	// symmetric key, such that both directions of a connection are
+	// received on the same queue.
+	nbQueues := C.uint16_t(cfg.RxQueues)
+	var conf C.struct_rte_eth_conf
+	if ret := C.pb_port_conf(port, nbQueues, &conf); ret < 0 {
+		return fmt.Errorf("failed to configure RSS with %d queues on port %d: %s", cfg.RxQueues, p.id, strerror(int(-ret)))
+	}
+	if ret := C.rte_eth_dev_configure(port, nbQueues, 0, &conf); ret < 0 {
+		return fmt.Errorf("failed to configure port %d: %s", p.id, strerror(int(-ret)))
+	}
+
+	nbDesc := C.uint16_t(cfg.RxDescriptors)
+	if ret := C.rte_eth_dev_adjust_nb_rx_tx_desc(port, &nbDesc, nil); ret < 0 {
+		return fmt.Errorf("failed to adjust number of rx descriptors on port %d: %s", p.id, strerror(int(-ret)))
+	}
+	if int(nbDesc) != cfg.RxDescriptors {
+		logp.Info("Number of rx descriptors of dpdk port %d adjusted to %d", p.id, int(nbDesc))
+	}
+
+	if cfg.MTU > 0 {
+		if ret := C.rte_eth_dev_set_mtu(port, C.uint16_t(cfg.MTU)); ret < 0 {
+			return fmt.Errorf("failed to set MTU %d on port %d: %s", cfg.MTU, p.id, strerror(int(-ret)))
+		}
+	}
+
+	for q := C.uint16_t(0); q < nbQueues; q++ {
+		if ret := C.rte_eth_rx_queue_setup(port, q, nbDesc, C.uint(socket), nil, p.pool); ret < 0 {
+			return fmt.Errorf("failed to setup rx queue %d on port %d: %s", int(q), p.id, strerror(int(-ret)))
+		}
+		p.queues = append(p.queues, &Queue{port: p.id, id: uint16(q)})
+	}
+
+	if ret := C.rte_eth_dev_start(port); ret < 0 {
+		return fmt.Errorf("failed to start port %d: %s", p.id, strerror(int(-ret)))
+	}
+
+	var ret C.int
+	if cfg.Promiscuous {
+		ret = C.rte_eth_promiscuous_enable(port)
+	} else {
+		ret = C.rte_eth_promiscuous_disable(port)
+	}
+	if ret < 0 {
+		logp.Warn("Failed to set promiscuous mode on dpdk port %d: %s", p.id, strerror(int(-ret)))
+	}
+
+	return nil
+}
+
+// ID returns the port id.
+func (p *Port) ID() uint16 {
+	return p.id
+}
+
+// Queues returns the RX queues of the port.
+func (p *Port) Queues() []*Queue {
+	return p.queues
+}
+
+// Close stops the port. Packets still buffered in the queues are dropped.
+func (p *Port) Close() {
+	portsMu.Lock()
+	defer portsMu.Unlock()
+
+	for _, q := range p.queues {
+		q.release()
+	}
+	if ret := C.rte_eth_dev_stop(C.uint16_t(p.id)); ret < 0 {
+		logp.Warn("Failed to stop dpdk port %d: %s", p.id, strerror(int(-ret)))
+	}
+	delete(portsOpen, p.id)
+}
+
+// ReadPacket copies the next packet received on the queue into buf. It
+// returns the number of bytes copied and the original length of the packet.
+// ok is false if no packet is available.
+func (q *Queue) ReadPacket(buf []byte) (n int, length int, ok bool) {
+	if q.pos == q.cnt {
+		q.pos = 0
+		q.cnt = int(C.pb_rx_burst(C.uint16_t(q.port), C.uint16_t(q.id), &q.mbufs[0], burstSize))
+		if q.cnt == 0 {
+			return 0, 0, false
+		}
+	}
+
+	m := q.mbufs[q.pos]
+	q.mbufs[q.pos] = nil
+	q.pos++
+
+	var pktlen C.uint32_t
+	copied := C.pb_copy_mbuf(m, unsafe.Pointer(&buf[0]), C.uint32_t(len(buf)), &pktlen)
+	return int(copied), int(pktlen), true
+}
+
+// Burst holds the packets received by a single ReadBurst call.
+type Burst struct {
+	size    int
+	snaplen int
+	buf     []byte
+
+	n       int
+	offsets [MaxBurstSize]int
+	caplens [MaxBurstSize]C.uint32_t
+	pktlens [MaxBurstSize]C.uint32_t
+}
+
+// NewBurst creates a Burst receiving up to size packets, each truncated to
+// snaplen bytes.
+func NewBurst(size, snaplen int) *Burst {
+	if size <= 0 || size > MaxBurstSize {
+		size = MaxBurstSize
+	}
+	return &Burst{
+		size:    size,
+		snaplen: snaplen,
+		buf:     make([]byte, size*snaplen),
+	}
+}
+
+// Len returns the number of packets received.
+func (b *Burst) Len() int {
+	return b.n
+}
+
+// Packet returns the data and original length of the i-th packet. The data
+// is only valid until the next call to ReadBurst.
+func (b *Burst) Packet(i int) (data []byte, length int) {
+	off := b.offsets[i]
+	return b.buf[off : off+int(b.caplens[i])], int(b.pktlens[i])
+}
+
+// ReadBurst receives the packets available on the queue, up to the size of
+// the burst, with a single call into DPDK. It returns the number of packets
+// received.
+func (q *Queue) ReadBurst(b *Burst) int {
+	b.n = 0
+
+	// drain packets left over from ReadPacket first
+	off := 0
+	for q.pos < q.cnt && b.n < b.size {
+		n, length, _ := q.ReadPacket(b.buf[off : off+b.snaplen])
+		b.offsets[b.n] = off
+		b.caplens[b.n] = C.uint32_t(n)
+		b.pktlens[b.n] = C.uint32_t(length)
+		off += n
+		b.n++
+	}
+	if b.n > 0 {
+		return b.n
+	}
+
+	b.n = int(C.pb_rx_burst_copy(C.uint16_t(q.port), C.uint16_t(q.id), C.uint16_t(b.size),
+		(*C.uint8_t)(unsafe.Pointer(&b.buf[0])), C.uint32_t(b.snaplen), &b.caplens[0], &b.pktlens[0]))
+	for i := 0; i < b.n; i++ {
+		b.offsets[i] = off
+		off += int(b.caplens[i])
+	}
+	return b.n
+}
+
+func (q *Queue) release() {
+	if q.pos < q.cnt {
+		C.pb_free_mbufs(&q.mbufs[q.pos], C.uint16_t(q.cnt-q.pos))
+	}
+	q.pos, q.cnt = 0, 0
+}
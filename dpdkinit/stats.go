@@ -0,0 +1,113 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dpdkinit
+
+/*
+#include <rte_ethdev.h>
+*/
+import "C"
+
+import "fmt"
+
+// PortStats holds the basic statistics of a port. All counters are totals
+// since the port has been started.
+type PortStats struct {
+	Packets uint64 // packets received
+	Bytes   uint64 // bytes received
+	Missed  uint64 // packets dropped by the NIC, because the RX queues were full
+	Errors  uint64 // erroneous packets received
+	NoMbuf  uint64 // mbuf allocation failures
+
+	// per queue counters, only reported for the first
+	// RTE_ETHDEV_QUEUE_STAT_CNTRS queues
+	QueuePackets []uint64
+	QueueErrors  []uint64
+}
+
+// Stats returns the basic statistics of the port.
+func (p *Port) Stats() (PortStats, error) {
+	var st C.struct_rte_eth_stats
+	if ret := C.rte_eth_stats_get(C.uint16_t(p.id), &st); ret < 0 {
+		return PortStats{}, fmt.Errorf("failed to read stats of port %d: %s", p.id, strerror(int(-ret)))
+	}
+
+	stats := PortStats{
+		Packets: uint64(st.ipackets),
+		Bytes:   uint64(st.ibytes),
+		Missed:  uint64(st.imissed),
+		Errors:  uint64(st.ierrors),
+		NoMbuf:  uint64(st.rx_nombuf),
+	}
+	n := len(p.queues)
+	if n > len(st.q_ipackets) {
+		n = len(st.q_ipackets)
+	}
+	for i := 0; i < n; i++ {
+		stats.QueuePackets = append(stats.QueuePackets, uint64(st.q_ipackets[i]))
+		stats.QueueErrors = append(stats.QueueErrors, uint64(st.q_errors[i]))
+	}
+	return stats, nil
+}
+
+// XStats returns the extended statistics of the port, keyed by the names
+// reported by the driver. XStats must not be called concurrently.
+func (p *Port) XStats() (map[string]uint64, error) {
+	port := C.uint16_t(p.id)
+
+	// the set of extended statistics is fixed once the port has been
+	// started, so names are only read once
+	if p.xstatNames == nil {
+		n := C.rte_eth_xstats_get_names(port, nil, 0)
+		if n < 0 {
+			return nil, fmt.Errorf("failed to read xstats names of port %d: %s", p.id, strerror(int(-n)))
+		}
+		if n == 0 {
+			p.xstatNames = []string{}
+			return nil, nil
+		}
+
+		names := make([]C.struct_rte_eth_xstat_name, int(n))
+		if ret := C.rte_eth_xstats_get_names(port, &names[0], C.uint(n)); ret < 0 {
+			return nil, fmt.Errorf("failed to read xstats names of port %d: %s", p.id, strerror(int(-ret)))
+		}
+		p.xstatNames = make([]string, len(names))
+		for i := range names {
+			p.xstatNames[i] = C.GoString(&names[i].name[0])
+		}
+		p.xstats = make([]C.struct_rte_eth_xstat, len(names))
+	}
+	if len(p.xstatNames) == 0 {
+		return nil, nil
+	}
+
+	ret := C.rte_eth_xstats_get(port, &p.xstats[0], C.uint(len(p.xstats)))
+	if ret < 0 {
+		return nil, fmt.Errorf("failed to read xstats of port %d: %s", p.id, strerror(int(-ret)))
+	}
+	if int(ret) > len(p.xstats) {
+		return nil, fmt.Errorf("number of xstats of port %d changed from %d to %d", p.id, len(p.xstats), int(ret))
+	}
+
+	stats := make(map[string]uint64, int(ret))
+	for _, xs := range p.xstats[:int(ret)] {
+		if int(xs.id) < len(p.xstatNames) {
+			stats[p.xstatNames[xs.id]] = uint64(xs.value)
+		}
+	}
+	return stats, nil
+}
@@ -0,0 +1,77 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dpdkinit
+
+/*
+#include <errno.h>
+#include <stdlib.h>
+#include <rte_bus_vdev.h>
+#include <rte_ethdev.h>
+*/
+import "C"
+
+import (
+	"fmt"
+	"strings"
+	"unsafe"
+
+	"github.com/njcx/libbeat_v7/logp"
+)
+
+// AttachVdev creates a virtual device from a spec of the form
+// <driver><id>[,<key>=<value>...], e.g. net_pcap0,rx_pcap=file.pcap, and
+// returns the device name. Creating a device that exists already is not an
+// error, such that a port can be reopened. DpdkInit must have been called
+// before.
+func AttachVdev(spec string) (string, error) {
+	name, args := spec, ""
+	if i := strings.IndexByte(spec, ','); i >= 0 {
+		name, args = spec[:i], spec[i+1:]
+	}
+	if name == "" {
+		return "", fmt.Errorf("invalid vdev '%s': missing device name", spec)
+	}
+
+	cname, cargs := C.CString(name), C.CString(args)
+	defer C.free(unsafe.Pointer(cname))
+	defer C.free(unsafe.Pointer(cargs))
+
+	ret := C.rte_vdev_init(cname, cargs)
+	switch {
+	case ret == -C.EEXIST:
+		logp.Debug("dpdk", "vdev %s exists already", name)
+	case ret < 0:
+		return "", fmt.Errorf("failed to create vdev '%s': %s", spec, strerror(int(-ret)))
+	default:
+		logp.Info("Created dpdk vdev %s", name)
+	}
+	return name, nil
+}
+
+// PortByName returns the id of the port with the given device name, for
+// example the name of a vdev or the PCI address of a NIC.
+func PortByName(name string) (uint16, error) {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+
+	var id C.uint16_t
+	if ret := C.rte_eth_dev_get_port_by_name(cname, &id); ret < 0 {
+		return 0, fmt.Errorf("no dpdk port named '%s': %s", name, strerror(int(-ret)))
+	}
+	return uint16(id), nil
+}
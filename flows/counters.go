@@ -144,10 +144,19 @@ func (c *counterReg) newFloat(name string) (*Float, error) {
 	return &Float{i, makeFlagsInfo(i)}, nil
 }
 
+// reg returns the index of the counter name. A name registered again, e.g.
+// by the decoders of several queues sharing a flows table, gets the index
+// registered first, such that flows have one slot per counter.
+//
 // XXX:
 //   - error on index > int max
-//   - error if already in use
 func (reg *counterTypeReg) reg(name string) (int, error) {
+	for i, registered := range reg.names {
+		if registered == name {
+			return i, nil
+		}
+	}
+
 	debugf("register flow counter: %v", name)
 
 	i := len(reg.names)
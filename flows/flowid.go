@@ -51,6 +51,8 @@ type flowIDMeta struct {
 	offUDP        uint8
 	offTCP        uint8
 	offID         uint8
+	offTunnel     uint8
+	offPPPoE      uint8
 
 	cntEth  uint8
 	cntVlan uint8
@@ -72,8 +74,61 @@ const (
 	UDPFlow
 	TCPFlow
 	ConnectionID
+	TunnelFlow
+	PPPoEFlow
 )
 
+// TunnelType is the type of the tunnel the packets of a flow have been
+// decapsulated from.
+type TunnelType uint8
+
+const (
+	TunnelVXLAN TunnelType = iota + 1
+	TunnelGeneve
+	TunnelGRE
+	TunnelERSPAN
+	TunnelIPIP
+	Tunnel6in4
+	TunnelGTP
+)
+
+var tunnelTypeNames = map[TunnelType]string{
+	TunnelVXLAN:  "vxlan",
+	TunnelGeneve: "geneve",
+	TunnelGRE:    "gre",
+	TunnelERSPAN: "erspan",
+	TunnelIPIP:   "ipip",
+	Tunnel6in4:   "6in4",
+	TunnelGTP:    "gtp",
+}
+
+func (t TunnelType) String() string {
+	if name, ok := tunnelTypeNames[t]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// TunnelDirection is the direction of the traffic of the switch port a
+// packet has been mirrored at, as reported by ERSPAN.
+type TunnelDirection uint8
+
+const (
+	TunnelIngress TunnelDirection = iota + 1
+	TunnelEgress
+)
+
+func (d TunnelDirection) String() string {
+	switch d {
+	case TunnelIngress:
+		return "ingress"
+	case TunnelEgress:
+		return "egress"
+	default:
+		return "unknown"
+	}
+}
+
 const (
 	SizeEthAddr    = 6
 	SizeVlan       = 2
@@ -90,15 +145,54 @@ const (
 	SizeTCPFlowID    = 2 * SizePortNumber // source + dest port
 	SizeUDPFlowID    = 2 * SizePortNumber // source + dest port
 	SizeConnectionID = 8                  // 64bit internal connection id
+	SizeTunnelFlowID = 1 + 4              // tunnel type + 32bit tunnel id
+	SizePPPoEFlowID  = 2                  // pppoe session id
 
 	SizeFlowIDMax int = SizeEthFlowID +
 		2*(SizeVlanFlowID+SizeIPv4FlowID+SizeIPv6FlowID) +
 		SizeICMPFlowID +
 		SizeTCPFlowID +
 		SizeUDPFlowID +
-		SizeConnectionID
+		SizeConnectionID +
+		SizeTunnelFlowID +
+		SizePPPoEFlowID
 )
 
+// MaxMPLSLabels is the number of labels of an MPLS label stack, starting
+// with the outermost label, that are reported. The labels differ by
+// direction, so they are not part of the flow ID but recorded per direction
+// in a flow counter, see EncodeMPLSLabels.
+const MaxMPLSLabels = 3
+
+const sizeMPLSLabelBits = 20
+
+// EncodeMPLSLabels packs up to MaxMPLSLabels labels of a label stack and
+// their number into a counter value.
+func EncodeMPLSLabels(labels []uint32) uint64 {
+	if len(labels) > MaxMPLSLabels {
+		labels = labels[:MaxMPLSLabels]
+	}
+	v := uint64(len(labels)) << (MaxMPLSLabels * sizeMPLSLabelBits)
+	for i, label := range labels {
+		v |= uint64(label&(1<<sizeMPLSLabelBits-1)) << (i * sizeMPLSLabelBits)
+	}
+	return v
+}
+
+// DecodeMPLSLabels returns the labels packed by EncodeMPLSLabels, starting
+// with the outermost label.
+func DecodeMPLSLabels(v uint64) []uint32 {
+	n := int(v >> (MaxMPLSLabels * sizeMPLSLabelBits))
+	if n > MaxMPLSLabels {
+		n = MaxMPLSLabels
+	}
+	labels := make([]uint32, n)
+	for i := range labels {
+		labels[i] = uint32(v>>(i*sizeMPLSLabelBits)) & (1<<sizeMPLSLabelBits - 1)
+	}
+	return labels
+}
+
 const offUnset uint8 = 0xff
 
 var flowIDEmptyMeta = flowIDMeta{
@@ -116,6 +210,8 @@ var flowIDEmptyMeta = flowIDMeta{
 	offUDP:        offUnset,
 	offTCP:        offUnset,
 	offID:         offUnset,
+	offTunnel:     offUnset,
+	offPPPoE:      offUnset,
 
 	cntEth:  0,
 	cntVlan: 0,
@@ -240,6 +336,42 @@ func (f *FlowID) AddConnectionID(id uint64) {
 	f.addID(&f.offID, ConnectionID, tmp[:], nil, flowDirUnset)
 }
 
+// AddTunnel records the tunnel the packet has been decapsulated from. The IP
+// layers added so far become the outer layers, such that the addresses of
+// the encapsulated packet are added as the inner layers. If the packet is
+// encapsulated more than once, the innermost tunnel is kept.
+func (f *FlowID) AddTunnel(typ TunnelType, id uint32) {
+	debugf("flowid: add tunnel")
+
+	var tmp [SizeTunnelFlowID]byte
+	tmp[0] = byte(typ)
+	binary.LittleEndian.PutUint32(tmp[1:], id)
+	f.addID(&f.offTunnel, TunnelFlow, tmp[:], nil, flowDirUnset)
+
+	f.toOuterLayer(&f.offIPv4, &f.offOutterIPv4, IPv4Flow, OutterIPv4Flow)
+	f.toOuterLayer(&f.offIPv6, &f.offOutterIPv6, IPv6Flow, OutterIPv6Flow)
+}
+
+// AddPPPoE records the session id of a PPPoE session.
+func (f *FlowID) AddPPPoE(session uint16) {
+	debugf("flowid: add pppoe")
+
+	var tmp [2]byte
+	binary.LittleEndian.PutUint16(tmp[:], session)
+	f.addID(&f.offPPPoE, PPPoEFlow, tmp[:], nil, flowDirUnset)
+}
+
+// toOuterLayer turns an inner layer into the outer layer, unless an outer
+// layer is present already. In that case the next inner layer added replaces
+// the outer one.
+func (f *FlowID) toOuterLayer(off, outerOff *uint8, flag, outerFlag FlowIDFlag) {
+	if f.flags&(flag|outerFlag) != flag {
+		return
+	}
+	*outerOff, *off = *off, offUnset
+	f.flags = f.flags&^flag | outerFlag
+}
+
 func (f *FlowID) addMultLayerID(
 	off, outerOff *uint8,
 	flag, outerFlag FlowIDFlag,
@@ -364,6 +496,10 @@ func (f *rawFlowID) Get(i FlowIDFlag) []byte {
 		return f.UDP()
 	case TCPFlow:
 		return f.TCP()
+	case TunnelFlow:
+		return f.Tunnel()
+	case PPPoEFlow:
+		return f.PPPoE()
 	default:
 		return nil
 	}
@@ -388,6 +524,8 @@ func (f *rawFlowID) Serialize() []byte {
 		f.offUDP,
 		f.offTCP,
 		f.offID,
+		f.offTunnel,
+		f.offPPPoE,
 		f.cntEth,
 		f.cntVlan,
 		f.cntIP,
@@ -474,6 +612,33 @@ func (f *rawFlowID) ConnectionID() []byte {
 	return f.extractID(f.offID, SizeConnectionID)
 }
 
+func (f *rawFlowID) Tunnel() []byte {
+	return f.extractID(f.offTunnel, SizeTunnelFlowID)
+}
+
+// TunnelID returns the type and the id of the tunnel, e.g. the VNI of a
+// VXLAN tunnel.
+func (f *rawFlowID) TunnelID() (TunnelType, uint32, bool) {
+	tunnel := f.Tunnel()
+	if tunnel == nil {
+		return 0, 0, false
+	}
+	return TunnelType(tunnel[0]), binary.LittleEndian.Uint32(tunnel[1:]), true
+}
+
+func (f *rawFlowID) PPPoE() []byte {
+	return f.extractID(f.offPPPoE, SizePPPoEFlowID)
+}
+
+// PPPoESession returns the session id of the PPPoE session.
+func (f *rawFlowID) PPPoESession() (uint16, bool) {
+	pppoe := f.PPPoE()
+	if pppoe == nil {
+		return 0, false
+	}
+	return binary.LittleEndian.Uint16(pppoe), true
+}
+
 func (f *rawFlowID) extractID(off, sz uint8) []byte {
 	if off == offUnset {
 		return nil
@@ -298,3 +298,66 @@ func TestSimilarWithOffsets(t *testing.T) {
 	assert.Equal(t, id1.flags, id2.flags)
 	assert.NotEqual(t, id1.flowIDMeta, id2.flowIDMeta)
 }
+
+func TestFlowIDTunnel(t *testing.T) {
+	outer1, outer2 := []byte{10, 0, 0, 1}, []byte{10, 0, 0, 2}
+	inner1 := []byte{0x20, 0x01, 0x0d, 0xb8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}
+	inner2 := []byte{0x20, 0x01, 0x0d, 0xb8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2}
+
+	id := newFlowID()
+	id.AddIPv4(outer1, outer2)
+	id.AddTunnel(TunnelVXLAN, 4242)
+	id.AddIPv6(inner1, inner2)
+	id.AddTCP(1234, 80)
+
+	assert.Equal(t, TunnelFlow|OutterIPv4Flow|IPv6Flow|TCPFlow, id.Flags())
+	typ, vni, ok := id.TunnelID()
+	assert.True(t, ok)
+	assert.Equal(t, TunnelVXLAN, typ)
+	assert.Equal(t, uint32(4242), vni)
+
+	src, dst, ok := id.OutterIPv4Addr()
+	assert.True(t, ok)
+	assert.Equal(t, outer1, src)
+	assert.Equal(t, outer2, dst)
+	assert.Nil(t, id.IPv4())
+
+	src, dst, ok = id.IPv6Addr()
+	assert.True(t, ok)
+	assert.Equal(t, inner1, src)
+	assert.Equal(t, inner2, dst)
+
+	// same family inside and outside of the tunnel
+	id = newFlowID()
+	id.AddIPv4(outer1, outer2)
+	id.AddTunnel(TunnelGeneve, 7)
+	id.AddIPv4([]byte{192, 168, 0, 1}, []byte{192, 168, 0, 2})
+	src, _, _ = id.OutterIPv4Addr()
+	assert.Equal(t, outer1, src)
+	src, _, _ = id.IPv4Addr()
+	assert.Equal(t, []byte{192, 168, 0, 1}, src)
+}
+
+func TestFlowIDPPPoE(t *testing.T) {
+	id := newFlowID()
+	id.AddPPPoE(0x1234)
+	id.AddIPv4([]byte{10, 0, 0, 1}, []byte{10, 0, 0, 2})
+
+	assert.Equal(t, PPPoEFlow|IPv4Flow, id.Flags())
+	session, ok := id.PPPoESession()
+	assert.True(t, ok)
+	assert.Equal(t, uint16(0x1234), session)
+
+	id = newFlowID()
+	_, ok = id.PPPoESession()
+	assert.False(t, ok)
+}
+
+func TestMPLSLabels(t *testing.T) {
+	// the stack is truncated
+	v := EncodeMPLSLabels([]uint32{16, 1048575, 3, 4, 5})
+	assert.Equal(t, []uint32{16, 1048575, 3}, DecodeMPLSLabels(v))
+
+	assert.Equal(t, []uint32{0}, DecodeMPLSLabels(EncodeMPLSLabels([]uint32{0})))
+	assert.Empty(t, DecodeMPLSLabels(EncodeMPLSLabels(nil)))
+}
@@ -161,3 +161,159 @@ func TestFlowsCounting(t *testing.T) {
 	assert.Equal(t, nil, stat["float1"])
 	assert.Equal(t, 1.4142, stat["float2"])
 }
+
+// startTestWorker starts a worker publishing the flows of module every
+// 10ms. The worker must be stopped by the caller.
+func startTestWorker(t *testing.T, module *Flows) (*flowsChan, *worker) {
+	pub := &flowsChan{make(chan []beat.Event, 1)}
+
+	processor := &flowsProcessor{
+		table:    module.table,
+		counters: module.counterReg,
+		timeout:  20 * time.Millisecond,
+	}
+	processor.spool.init(pub.PublishFlows, 1)
+
+	worker, err := makeWorker(processor, 10*time.Millisecond, 1, -1, 0)
+	if err != nil {
+		t.Fatalf("Failed to create flow worker: %v", err)
+	}
+	worker.Start()
+	return pub, worker
+}
+
+// receiveFlow returns the fields of the single flow event published next.
+func receiveFlow(t *testing.T, pub *flowsChan) common.MapStr {
+	var events []beat.Event
+	select {
+	case events = <-pub.ch:
+	case <-time.After(5 * time.Second):
+	}
+
+	if len(events) != 1 {
+		t.Fatalf("expected one flow event, got %d", len(events))
+	}
+	t.Logf("event: %v", events[0].Fields)
+	return events[0].Fields
+}
+
+func TestFlowsMPLSLabels(t *testing.T) {
+	logp.TestingSetup()
+
+	mac1 := []byte{1, 2, 3, 4, 5, 6}
+	mac2 := []byte{6, 5, 4, 3, 2, 1}
+	ip1 := []byte{127, 0, 0, 1}
+	ip2 := []byte{128, 0, 1, 2}
+	port1 := []byte{0, 1}
+	port2 := []byte{0, 2}
+
+	module, err := NewFlows(nil, procs.ProcessesWatcher{}, &config.Flows{})
+	assert.NoError(t, err)
+	labels, err := module.NewUint("mplsLabels")
+	assert.NoError(t, err)
+
+	pub, worker := startTestWorker(t, module)
+	defer worker.Stop()
+
+	// the label switched paths of the request and the response differ, the
+	// packets still belong to one flow
+	idForward := newFlowID()
+	addAll(addEther(mac1, mac2), addIP(ip1, ip2), addUDP(port1, port2))(idForward)
+	idRev := newFlowID()
+	addAll(addEther(mac2, mac1), addIP(ip2, ip1), addUDP(port2, port1))(idRev)
+	assert.True(t, FlowIDsEqual(idForward, idRev))
+
+	{
+		module.Lock()
+
+		labels.Set(module.Get(idForward), EncodeMPLSLabels([]uint32{16, 1000}))
+		labels.Set(module.Get(idRev), EncodeMPLSLabels([]uint32{17, 2000, 30}))
+
+		module.Unlock()
+	}
+
+	event := receiveFlow(t, pub)
+	v, err := event.GetValue("mpls.source.labels")
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{16, 1000}, v)
+	v, err = event.GetValue("mpls.destination.labels")
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{17, 2000, 30}, v)
+
+	// the counter is not reported as a statistic
+	_, err = event.GetValue("source.mplsLabels")
+	assert.Error(t, err)
+}
+
+func TestFlowsGTPTunnel(t *testing.T) {
+	logp.TestingSetup()
+
+	outer1 := []byte{10, 0, 0, 1}
+	outer2 := []byte{10, 0, 0, 2}
+	ip1 := []byte{192, 168, 0, 1}
+	ip2 := []byte{192, 168, 0, 2}
+
+	module, err := NewFlows(nil, procs.ProcessesWatcher{}, &config.Flows{})
+	assert.NoError(t, err)
+	teid, err := module.NewUint("tunnelTEID")
+	assert.NoError(t, err)
+
+	pub, worker := startTestWorker(t, module)
+	defer worker.Stop()
+
+	// the request and the response are sent with the TEIDs of their
+	// receivers, the packets still belong to one flow
+	idForward := newFlowID()
+	idForward.AddIPv4(outer1, outer2)
+	idForward.AddTunnel(TunnelGTP, 0)
+	idForward.AddIPv4(ip1, ip2)
+	idForward.AddUDP(1000, 53)
+	idRev := newFlowID()
+	idRev.AddIPv4(outer2, outer1)
+	idRev.AddTunnel(TunnelGTP, 0)
+	idRev.AddIPv4(ip2, ip1)
+	idRev.AddUDP(53, 1000)
+	assert.True(t, FlowIDsEqual(idForward, idRev))
+
+	{
+		module.Lock()
+
+		teid.Set(module.Get(idForward), 0x1001)
+		teid.Set(module.Get(idRev), 0x2002)
+
+		module.Unlock()
+	}
+
+	event := receiveFlow(t, pub)
+	tunnel, err := event.GetValue("tunnel")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, common.MapStr{
+		"type":        "gtp",
+		"source":      common.MapStr{"ip": "10.0.0.1", "teid": uint32(0x1001)},
+		"destination": common.MapStr{"ip": "10.0.0.2", "teid": uint32(0x2002)},
+	}, tunnel)
+	assert.Equal(t, "192.168.0.1", event["source"].(common.MapStr)["ip"])
+
+	// the counter is not reported as a statistic
+	_, err = event.GetValue("source.tunnelTEID")
+	assert.Error(t, err)
+}
+
+func TestFlowsCountersRegisteredOnce(t *testing.T) {
+	module, err := NewFlows(nil, procs.ProcessesWatcher{}, &config.Flows{})
+	assert.NoError(t, err)
+
+	// the decoders of several queues register the same counters
+	first, err := module.NewUint("packets")
+	assert.NoError(t, err)
+	other, err := module.NewUint("bytes")
+	assert.NoError(t, err)
+	again, err := module.NewUint("packets")
+	assert.NoError(t, err)
+
+	assert.Equal(t, first.i, again.i)
+	assert.NotEqual(t, first.i, other.i)
+	assert.Equal(t, []string{"packets", "bytes"}, module.counterReg.uints.getNames())
+}
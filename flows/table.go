@@ -19,7 +19,8 @@ package flows
 
 import (
 	"sync"
-	"time"
+
+	"github.com/njcx/packetbeat7_dpdk/clock"
 )
 
 // Table with single produce and single consumer workers.
@@ -75,7 +76,7 @@ func (t *flowMetaTable) get(id *FlowID, counter *counterReg) Flow {
 }
 
 func (t *flowTable) get(id *FlowID, counter *counterReg) Flow {
-	ts := time.Now()
+	ts := clock.Now()
 
 	t.mutex.Lock()
 	defer t.mutex.Unlock()
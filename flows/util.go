@@ -23,6 +23,8 @@ import (
 
 	"github.com/njcx/libbeat_v7/beat"
 	"github.com/njcx/libbeat_v7/logp"
+
+	"github.com/njcx/packetbeat7_dpdk/clock"
 )
 
 type worker struct {
@@ -85,6 +87,27 @@ func (w *worker) tick(t *time.Ticker) bool {
 func (w *worker) periodically(tick time.Duration, fn func() error) {
 	defer debugf("stop periodic loop")
 
+	if clock.Virtual() {
+		// ticks follow packet time, fn is called by the sniffer
+		var once sync.Once
+		stop := make(chan struct{})
+		var timer *clock.Timer
+		timer = clock.Every(tick, func() {
+			if err := fn(); err != nil {
+				once.Do(func() {
+					timer.Stop()
+					close(stop)
+				})
+			}
+		})
+		select {
+		case <-w.done:
+		case <-stop:
+		}
+		timer.Stop()
+		return
+	}
+
 	ticker := time.NewTicker(tick)
 	for {
 		cont := w.tick(ticker)
@@ -21,11 +21,13 @@ import (
 	"encoding/binary"
 	"errors"
 	"net"
+	"strconv"
 	"time"
 
 	"github.com/njcx/libbeat_v7/beat"
 	"github.com/njcx/libbeat_v7/common"
 	"github.com/njcx/libbeat_v7/common/flowhash"
+	"github.com/njcx/packetbeat7_dpdk/clock"
 	"github.com/njcx/packetbeat7_dpdk/procs"
 	"github.com/njcx/packetbeat7_dpdk/protos/applayer"
 )
@@ -104,7 +106,7 @@ func makeWorker(
 	return newWorker(func(w *worker) {
 		defer processor.execute(w, false, true, true)
 
-		if align > 0 {
+		if align > 0 && !clock.Virtual() {
 			// round time to nearest 10 seconds for alignment
 			aligned := time.Unix(((time.Now().Unix()+(align-1))/align)*align, 0)
 			waitStart := aligned.Sub(time.Now())
@@ -154,7 +156,7 @@ func (fw *flowsProcessor) execute(w *worker, checkTimeout, handleReports, lastRe
 
 	fw.table.Lock()
 	defer fw.table.Unlock()
-	ts := time.Now()
+	ts := clock.Now()
 
 	// TODO: create snapshot inside flows/tables, so deletion of timedout flows
 	//       and reporting flows stats can be done more concurrent to packet
@@ -258,8 +260,19 @@ func createEvent(
 		putOrAppendUint64(flow, "vlan", vlanID)
 	}
 
+	// pppoe meta data, the mpls labels are counted per direction
+	if session, ok := f.id.PPPoESession(); ok {
+		fields["pppoe"] = common.MapStr{"session_id": session}
+	}
+
+	// tunnel meta data, the outer IP layer holds the tunnel endpoints
+	tunneled := f.id.Tunnel() != nil
+	if tunneled {
+		fields["tunnel"] = tunnelFields(&f.id)
+	}
+
 	// ipv4 layer meta data
-	if src, dst, ok := f.id.OutterIPv4Addr(); ok {
+	if src, dst, ok := f.id.OutterIPv4Addr(); ok && !tunneled {
 		srcIP, dstIP := net.IP(src), net.IP(dst)
 		source["ip"] = srcIP.String()
 		dest["ip"] = dstIP.String()
@@ -286,7 +299,7 @@ func createEvent(
 	}
 
 	// ipv6 layer meta data
-	if src, dst, ok := f.id.OutterIPv6Addr(); ok {
+	if src, dst, ok := f.id.OutterIPv6Addr(); ok && !tunneled {
 		srcIP, dstIP := net.IP(src), net.IP(dst)
 		putOrAppendString(source, "ip", srcIP.String())
 		putOrAppendString(dest, "ip", dstIP.String())
@@ -356,6 +369,14 @@ func createEvent(
 					communityID.ICMP.Type = uint8(typeCode >> 8)
 					communityID.ICMP.Code = uint8(typeCode)
 				}
+			case "tunnelPort", "tunnelDirection":
+				putTunnelValue(fields, k, v)
+			case "interfaceIndex":
+				putInterfaceIndex(fields, v)
+			case "mplsLabels":
+				putMPLSLabels(fields, "source", v)
+			case "tunnelTEID":
+				putTunnelTEID(fields, "source", v)
 			default:
 				source[k] = v
 			}
@@ -374,6 +395,19 @@ func createEvent(
 		for k, v := range stats {
 			switch k {
 			case "icmpV4TypeCode", "icmpV6TypeCode":
+			case "tunnelPort", "tunnelDirection":
+				// the values of the packets sent by the source are preferred
+				if f.stats[0] == nil {
+					putTunnelValue(fields, k, v)
+				}
+			case "interfaceIndex":
+				if f.stats[0] == nil {
+					putInterfaceIndex(fields, v)
+				}
+			case "mplsLabels":
+				putMPLSLabels(fields, "destination", v)
+			case "tunnelTEID":
+				putTunnelTEID(fields, "destination", v)
 			default:
 				dest[k] = v
 			}
@@ -441,6 +475,70 @@ func createEvent(
 	}
 }
 
+// tunnelFields returns the type, the id and the endpoints of the tunnel of
+// a flow. GTP-U tunnels have no id, their TEIDs are counted per direction.
+func tunnelFields(id *rawFlowID) common.MapStr {
+	typ, tunnelID, _ := id.TunnelID()
+	tunnel := common.MapStr{"type": typ.String()}
+	if typ != TunnelGTP {
+		tunnel["id"] = tunnelID
+	}
+	src, dst, ok := id.OutterIPv4Addr()
+	if !ok {
+		src, dst, ok = id.OutterIPv6Addr()
+	}
+	if ok {
+		tunnel["source"] = common.MapStr{"ip": net.IP(src).String()}
+		tunnel["destination"] = common.MapStr{"ip": net.IP(dst).String()}
+	}
+	return tunnel
+}
+
+// putTunnelValue adds the switch port or the direction the packets of a flow
+// have been mirrored at to the tunnel fields.
+func putTunnelValue(fields common.MapStr, name string, v interface{}) {
+	value, ok := v.(uint64)
+	tunnel, _ := fields["tunnel"].(common.MapStr)
+	if !ok || tunnel == nil {
+		return
+	}
+	switch name {
+	case "tunnelPort":
+		tunnel["port"] = value
+	case "tunnelDirection":
+		if value > 0 {
+			tunnel["direction"] = TunnelDirection(value).String()
+		}
+	}
+}
+
+// putTunnelTEID adds the TEID of the GTP-U packets sent by the source or the
+// destination of a flow to the tunnel fields.
+func putTunnelTEID(fields common.MapStr, side string, v interface{}) {
+	teid, ok := v.(uint64)
+	tunnel, _ := fields["tunnel"].(common.MapStr)
+	if !ok || tunnel == nil {
+		return
+	}
+	tunnel.Put(side+".teid", uint32(teid))
+}
+
+// putInterfaceIndex adds the index of the interface the packets of a flow
+// have been captured on to the observer fields.
+func putInterfaceIndex(fields common.MapStr, v interface{}) {
+	if index, ok := v.(uint64); ok {
+		fields.Put("observer.ingress.interface.id", strconv.FormatUint(index, 10))
+	}
+}
+
+// putMPLSLabels adds the MPLS labels of the packets sent by the source or
+// the destination of a flow to the mpls fields.
+func putMPLSLabels(fields common.MapStr, side string, v interface{}) {
+	if labels, ok := v.(uint64); ok {
+		fields.Put("mpls."+side+".labels", DecodeMPLSLabels(labels))
+	}
+}
+
 func encodeStats(
 	stats *flowStats,
 	ints, uints, floats []string,
@@ -127,3 +127,29 @@ func TestCreateEvent(t *testing.T) {
 		}
 	}
 }
+
+func TestCreateEventTunnel(t *testing.T) {
+	id := newFlowID()
+	id.AddIPv4([]byte{10, 0, 0, 1}, []byte{10, 0, 0, 2})
+	id.AddTunnel(TunnelVXLAN, 4242)
+	id.AddIPv4([]byte{203, 0, 113, 3}, []byte{198, 51, 100, 2})
+	id.AddTCP(38901, 80)
+
+	ts := time.Unix(1542292881, 0)
+	bif := &biFlow{id: id.rawFlowID, createTS: ts, ts: ts, dir: flowDirForward}
+	event := createEvent(procs.ProcessesWatcher{}, ts, bif, false, nil, nil, nil)
+
+	validate := lookslike.MustCompile(map[string]interface{}{
+		"source":      map[string]interface{}{"ip": "203.0.113.3", "port": uint16(38901)},
+		"destination": map[string]interface{}{"ip": "198.51.100.2", "port": uint16(80)},
+		"tunnel": map[string]interface{}{
+			"type":        "vxlan",
+			"id":          uint32(4242),
+			"source":      map[string]interface{}{"ip": "10.0.0.1"},
+			"destination": map[string]interface{}{"ip": "10.0.0.2"},
+		},
+	})
+	for _, err := range validate(event.Fields).Errors() {
+		t.Error(err)
+	}
+}
@@ -50,7 +50,7 @@ require (
         github.com/elastic/go-seccomp-bpf v1.2.0 // indirect
         github.com/elastic/go-structform v0.0.9 // indirect
         github.com/elastic/go-txfile v0.0.7 // indirect
-        github.com/elastic/go-ucfg v0.8.6 // indirect
+        github.com/elastic/go-ucfg v0.8.6
         github.com/elastic/go-windows v1.0.1 // indirect
         github.com/fatih/color v1.16.0 // indirect
         github.com/go-logr/logr v1.4.1 // indirect
@@ -32,5 +32,5 @@ func init() {
 // AssetFieldsYml returns asset data.
 // This is the base64 encoded zlib format compressed contents of fields.yml.
 func AssetFieldsYml() string {
-	return "eJzs/X17GzeyL4r+n0+Bq7nPlZVFtkjqxbLunnO2IsmJnu0XjSVPZiWeRwS7QRKjJtAB0JKYddZ3Pw+qADT6RTJli44zy3tneUSyGygUgEJVoepXfyE/H717c/bmx/8POZFESENYxg0xc67JlOeMZFyx1OTLHuGG3FJNZkwwRQ3LyGRJzJyR0+MLUij5L5aa3nd/IROqWUakgO9vmNJcCjJMhqNkkHz3F3KeM6oZueGaGzI3ptCH29szbublJEnlYpvlVBuebrNUEyOJLmczpg1J51TMGHxl251ylmc6+e67Prlmy0PCUv0dIYabnB3aB74jJGM6VbwwXAr4irx07xD39uF3hPSJoAt2SDb/t+ELpg1dFJvfEUJIzm5YfkhSqRh8Vuy3kiuWHRKjSvzKLAt2SDJq8GOtv80Tati2bZPczpkAPrEbJgyRis+4sPxLvoP3CLm0zOYaHsrCe+zOKJpaPk+VXFQt9GzHPKV5viSKFYppJgwXM+jItVh11zljWpYqZaH/s2n0Av5G5lQTIT21OQns6eHauKF5yYDoQEwhizK33bhmXWdTrrSB9xtkKZYyflNRVfCC5VxUdL1zPMf5IlOpCM1zbEEnOE/sji4KO+mbo8Fwvz/Y6492LgcHh4O9w53d5GBv55fNaJpzOmG57pxgnE05scsYvsA/r/D7a7a8lSrrmOjjUhu5sA9sI08KypUOYzimgkwYKe2eMJLQLCMLZijhYirVgtpG7PduTORiLss8g32YSmEoF0QwbacOyYHla//fUZ7jHGhCFSPaSMsoqj2lgYBTz6BxJtNrpsaEioyMrw/02LGjxcn/2qBFkfMUqNs4JBtTKfsTqjZ6ZIOJG/tNoWRWpvD7f8cMXjCt6Yw9wOEFNen8Sop8eWXYneng6EupSC5njiewNFyzbiE4zuBP9kn3c4/IwvAF/z0sQbtkbji7tduDC0LhafsFU4FBtjttVJma0rIwlzNNbrmZy9IQKqodUKOhR6SZM+UkCUlxllMpUmqYiDaBkZaIBaFkXi6o6CtGMzrJGdHlYkHVksho88U7clHmhhd5GLsm7I5ru/vnbFl1uJhwwTLChZFEivB0c05/Ynkuyc9S5Vk0W4bOHtoM8aLnMyEVu6ITecMOyXAw2m3P3CuujR2Pe0+HVW/ojDCazv0o68vt13g14RIbbfwzXlV0xgSuFCfhj8IXMyXL4pCMOtbR5Zzhm2GW3I5ycpYSOrGTjBJxam7tRrKy1NjDbuqmgoql5Tm1GzLP7RbskYwZ/EMqIieaqRs7PbhcpV1mc2lnSipi6DXTZMGoLhVb2Adcs+Gx5kbVhIs0LzNGfmDUigQYqyYLuiQ015KoUti3Xb9KJ3C4wUCT791QXZN6buXlhFWiGVa2pZ/yXPu1h0xSpRB2n0hkkKUtGp9yTd7OmYoF+ZwWBbMr0A4WdmoYKgh5ywDhVuNUSiOksXPuB3tIzrC71CoFcoqDhn1rN2Kvoi+xS4E4rWTCqEmi/Xt0/hr0E3eI1gfkZpwWxbYdCk9ZQqq1EQviTDLPOpDAoHMQPsXVwjWxRy0xcyXL2Zz8VrLStq+X2rCFJjm/ZuT/0Ok17ZF3LOO4PgolU6Y1FzM/Ke5xXaZzK7BfyZk2VM8JjoNcALsdy3AjwiJHFgbNpdodk5LnWeLllOuluaO79vS9u7q5k07vDBOZPaltVzWWTd284xz5tex0GhTXVrkRrgEjwy6kYtnRHuw0igxHVSQ0aXdAoeQNz1jP6ia6YCmf8pTg26ADcR00NcfBSNIsmFE8tWsn6KXPk/1kQJ7RRba/u9UjOZ/Az/j1r/t0tMMOpgfTncF0bzAYTujO7i7bZXu72UH2Ip0cjNLJcPA8DSTa8RgyGowG/cGoP9gjo53D4eBwOCD/MRgMBuT95fE/A4entMzNFfDokExprlltWlkxZwumaH7Fs/qkMjcdTzCxvg/CMyv5ppwplApcu/3xjE/hYIHTR281p5hbZUUtQAH0OjpNldR2IrShyorJSWnIGFcIz8awzewGa8/QAd21jJ7WGNEc/tOs6feC/2Y12MePO2hUVvKgvIL3bkF1mzAC0ol3LEA3vKw2PPvvOgboFFMQm7Ggb82gJhSfwlMONYsZv2GgmVLhXsOn3c9zlhfTMrey0UoAN8LQsLmV5KWT04QLbahInabaOGa07RjOGrtInJZEKi2JFVSBZAhtc00EYxnamLdzns7bXQWBncqF7cxaUNG4z6ZWfvgDBYaKJ43/Sk4NEyRnU0PYojDL9lROpazNop2odczi5bJ4YPr8IWY7IDS/pUtNtLH/Bt5abV/P/dLEaXUGF75rlbSkYo0IR3HgavUsLnHX0YRVj4Bmwqe1ia9mrLkAapO/oOncWn1tFsfteD47wb0GVv/dHQl1Zjdo2k8GyaCv0lGsneqaaloaKeRClppcwEn/ETX1SBBavYLKAXl2dLGFG9MpnY6wVArBwCdwJgxTghlyrqSRqfTn/rOz8y2iZAmnYaHYlN8xTUqRMTyn7emrZG4bs9JNKrKQihHBzK1U10QWTFEjldVjvRnP5jSf2hcosWpMzgjNFlxwbezOvPE6s20rkwtUsKkhzjOBg1gspOiRNGdU5cvqBATbJVArc54uwV6YM1AZ7ACTlfUgUS4mQU996KjMZVDGalPhjgRsh9A8lynozI6i1jQ5NTJ8HRa8m0XX0LOjizdbpITG82V14mi0iQLrcU+c1cYdLb3h3nD/RW3AUs2o4L+DeEzax8jnqAlgfV7FXI5EnTfbyYNGPXlIyWlw/m00EuilNfofpbQr79Wr42jnpTlvGIbH1TcPWIZH7k27xfwqpNotO2643QG44P3kuI3nNF5PHFp8is2oysASsIq+FLoXPY9WwISjH5VLQXMyzeUtUSy1RnLND3F5fO5axfOoIrNFm/3CPh5RBttOMxHsP/vMxX++IQVNr5l5prcS6AVdF4UTHK2u0F1oFbpap95wVaBhM23pcKaV55JRVGgKxCTkQi5YMHZKjUajYWpBNrwPVKqNyk2i2NTLKEeKaAxQ44ZzPzujHmd2woJRC0Z9xAC3GS1ZYuanueoiph/dE24R+Q7smVXq0jLEtVpZ01xY8v5VCpwAMK7RXPYe6o7GKv4KaVpNWnUK56sP+9i7BoNDEdvb9v0EFzBsHlTQaJYRzRZUGJ6CxGd3xuly7A619B6qTl4O6KDRGUluuB0u/51VnhI7UKbAbtPclNRNx9mULGWpQh9Tmud+8flzwMrQmVTLnn3UqyLa8DwnTOhSOb3T+Z2tupIxbezysCy1DJvyPA9ijBaFkoXi1LB8+QgrmWaZYlqvy5KC1Y4uEbe2XIdO6wliZjHhs1KWOl/iaoZ3gsC8tWzRcsHA305yrsEJeXbes0Yxnq5SEWqPkzuipV0nCSH/WXE2aIGVToT7QNFbT5Nf9+PEfTFGltV1S0G4iVTHrESfMB6I44QXY0vKOEGyxj2SsYKJzCn3qJlLUREB/hk3Y5XulPyPO7apTv7HntyR12ppmP6IGh/NOPp46q/VCPnB/oAOunBf5naiWwgoMNsTdLBbIwyX8xoMDCe5sf2k1ueMySTlZnm1JmfAsdXPO2fntbUHmHMb1siRwnDBhLlKZbYOmi5vZT9nxjB7fGSsfpcZet/U3XS/OfruIwu1ezBrYvCbyMsSOmsTLZWZk6MFUzylHUSWwqjlFddyXTw/xi7I2cVbYHqLwuOje8la19J0JHXO8jEVNGtzCiT8x70AMyavCsnD8Vq/rJJixk2ZocqRUwMfWhRs/hfZyOEWtP98J9kf7h7sDHpkI6dm45Ds7iV7g70XwwPy35stIp9WrDecl5qpvlcpop/QaPHs6RHnvEFFUk7JTFFR5lRxs4x1gyVJrY4CmnOkAxz7oz+4xnCFc4VKYcrsoefsh2kupXJnZw9cQXNeaefVIYvk5aSYLzW3f/gbt9TLKB2R8EaaKMIA7hM5OkwWcMbPmPSjbTuQJlIbKfpZ2pqbQmpD83Xtss1zaB7FGtVapry6e8M7a0dyNdC/u7v8Srt1VyvhGiVcAE4YuRbyVlhbhhI7FOhIKvLL2TmJxkRgaYNKeUPVktzyzGoycDy6XY0XMvBnm38vdge7g8eIWcVmXIp1CrB30MND8qv/t+P76FqTBHM0dQqwv5Vswtrrz2r3v1e68ZMeq9bK5gtGfgf/3rS24Hrh1vHs6M1R9Fwn8e6g2j5SMziW6fYPJRNSXx1xFSlhH1kYvPjIKMMDtXGcnQdrxZ+rqD89Ozu/2bWr/ez8Zn+rrkctaLqO/fz66LibmIYzXkgTbkUX1Cmi714ek+eD3RHcH2M0G8sOyak1ImRqmCHPwADmukcO+hNe6eBW193CK02nGrlgqVtJfi2LgqmUavZPMmd3NGMpX9CcZHzGDdxpWDXKUgpRQqFNRz52bAWIIKXQfOaCSNiMqYRclCncWd+4B12MEd7FIA00tDhfFnPWIX0Hg/5g0N87hX93+qOd2kwJapLmyug8H7tXx+alokKjx+Ts3I7K+Q8w+vDN0WVwxpFnLJklzp9spXLlIiToefKu5trlZjh0Iv8TMYrCBYSYkVzSjExoTkUKZ+CUK3ZL8xz9fUqW9mhsWLt20IVU5nHGrjd9tFG82wKOuWHb/7PwA/1cj7ACa6M+x7c/yeYb1elozckqpuj983Hu5iAWFHF/9jzShimWXXVZm0+nJ1qhNOezOdMm6tTzCPvuwUCKgmWeZF1OvJEa5v9ldcuL+l7UnPNLWX1lYypl4p5LUrnYsOJrI/6ief2MQZPuWjljhqkFaLWFYinXVl8BtYmiLwxibCBYtJzkPCW6nE75XWgRnnk2N6Y43N7GR/CJRKrZVkIu1RLEokRF645bLRKVrMmSaL4o8iUx9LqaV/Sd5VQbELsYMYk6lZCGgAvoluU5jP7y1UkV17ORyqS83mgLxogbtVUR2L7O1RA6gUUfTIZpabf2byXN+ZRXU4r34RiPFqnwee6XCujrhN2lrDBV2Bi8Vt05tpZ7AvfMlBRUGR451kmLAhAeHPuy/+d+R22msmvAACntnNieUyoqzzqpr6texIEQR9oa0ITl8rZ7mXfvifq+iXm7cXt7mzCqTbJYuhZwYeDOoNpsRLfvSIRrZU51FQYKYwX1I3RTaXMbupyMEl1OhrXN16st4oo8NCica9fHY1VtbPRwzwlpBTzP4XKWKS47QlrsAFbVBI0srmAYX0DqsenUHlI3zPbqFoob/TN2+epkq4fGVLCkKr4HpqHo6PnrNxACdsn6tRJtkqQtIJv9hmajgBk7S7AO/tySEaTifUKxmonVxCN8X1s3pWYqWe+Sif13eFMrFd5/2s4xFGPB4F5ATu87Fqkgr06OziGQE0d8EpqK18pme3RsQXm+psG9tyOADrwRk7QJsNKzw0D+E91E2GFu6uoYACcUvaE8p5O8w7jNJ0wZcsqFNswtrBpH4DrxD1t20Pv61x0Ocm0Bpu0gSx8vjOPzcWBw8bZd5NRY5bpjeSKda3SpxjOBnbWJmFM9X9dKcJwCaWP7QcecUsxada2Ia+rEkiBUSLGMU17QPomWynvNXKTmGEbBM7y3hQ92dOOgAqRSTHGuaF7rk4qsQ6uCyMGORbWWgN174nWRZa3dfdEf9vf6o2F/NBjtjnZfDEfPD573R/svRrujF7uD3f5oZ2/4Ym//+cF+fzgYDNqDeDpn4ReWgxdza32iux6yKLh4kFU0YffKQCXz5iXtky35I6UopD/BUoae/H0F+CXriVENojd/3bjmEyroFcQibvTIhmKgdYvZlW0QE4ge4FsVQyZLJDyEkPkv7o8gw1RSgrszRBpAU2CwiKmiIaesGgb60TAm2TsTIDKZ3JsdMyWvq6wFruPwaSrI6fEILS67QafMpHOm4W4map1wo11CUkWk3dz1PLpaQhTXISy3ToJrV5XCZToptpAmBPESWRrNMxb11KQMaaLEpeL4AfmlI6pX3b1SPeUPG60agpwj17l3+Nhmua5IdQx7TLBQCpci6zsYNy8rBmFfkGsVB1PwLOTPOaG3JBmfTpmK3XVwe8Yha8yqClbU9A0TVBjCxA1XUizqfupqbR39fBE651nPB2rA+idv3/1IzjLMcINwwbIpf9ua+/7+/vPnzw8ODl68eNHJznVeibYZ6kUgzTnVD/Ay8DDw6PN4icpni5sZ10VOl7EqFtvRmPbez9jNqua00215zs3yqn2b9HSCOuoHb4u4D+8CSYGyRTH0OMOSqXYhcdFYLQle6j6j2vSH9dsxnyuwvq135nNEzk78GQMi1Au8JqG8Pxzt7FpV4cWATtKMTQfdFK9xdQea42yeNtXRNRh82U5KeTKKXnuZG+WnPMhGM0oWLONl3efpcCO+iKB1fcUirGsr1zbueXinR45+t4d59U1HguGy7zpZdQ/78X8Zyeg5gLe9q44d5Vl99N1CbLEkjx//Dc9WCv/87LAszwLoMPGjjlEU6K3uEWoH2iOztKjcp1LhxSrNZcqoaOvQt7o2LAw4WNOgXLzBkwrhGvU+S/rLLEpPps+9i3OFM66tRl9yPffP6YbGCKn01VnufQiI4QAHuZ/yHmEzOKit1nyjySu6mGS0R348Pic/Hp+Sm0oBOCoKcipmXISF//fX9hX7vcvP7to+tCgIc6/Zvx3JPTdSVYoemVI1o4b1SA7dtzcRfr+i8SIzdqX5TFBraNSsGJkxclH75X5z5nLONGuiJNTsf7ALJlxQtcSwptCpXj1BK+Mzps0VzWdScTNfrHNtzameQ76H7ywogJZydJRjZv79bvfa6gvjRUAfiuhIXBMXPBEuaiByv3KTLKtMR3hSQaKr89xX6xZ5U5HbXhd6Tkd7+6sKVkxb/ogDYyJlzqjoYuIP+BO4oWgBZizHDEDHBzt0F5XSts6NKtlH7O9I3PCZ3c9rTHO3CyJyHK26EniH1eCQJDw6DllQUU6pw46ZLC2HPJTIDROZVEnUJqsQERTL2Q3FQIWjwq6b799eECnyjji8VC4S2ydL7oo0KZS8W67MW0NNubakl6Ms4y6lrS0t4MxlyuB1LXOkdPN4WuYe82MG4d9qWRg5U7SY85QwpaTSVfhk3OoNzXkWh7NKRYwqtfH9kVeM3jBSiihra+oDo+DV6hWvKVTth2ZvrQkp0jlLr7ugJU7fvXv77ur9m8t37y8uT0+u3r19e7nyHJWINLWm8MQLbL5mOgQRHjSvKiGAp0raNUyOpSpkLfn+4ze8jC7WvI9tF0+5maE9qdxudWnOfgs7YKKk2ruVj+Bxe/j0bz/945eD1wdHf1+Zlx5xbQVuZtVSrXHsxG4RKjJSR6Krn+ANjDgADIAzrS3XR4PRsD+w/10OR4fDweHO4JeV5TzsMbbK4njgXNq8MNIewjB10T7v2LskndfjNf5uNzw1PjDyvj2P70G8NUJs+by3HrJyzqvjvRZJYWe+jrZkT38pc+1gbeByDoBSWHqNegEKqdZyedwJCpLsM/nafeDjxSRYPvWj/4YpjNOhM8qFji4x5qxSFK0qHzvMOmUxrTH/I4J2FcZU2jFosk7GBcU4/vKBJPHwYD0R2KXotkD8Iiwxh0vkiAxUEBfm6wDHIDxTTuNGIkTISCGfs7yI7gLB941hnaFp7bzqYmmNDLvZH+HsXud1XTV4ntV9VHxBZ2s1MWPbHzoLyVlIkF1oiNckRRdphs7WRFm1shxddNYIyYhwKh/uPsKrfACxsulNhF4d+GOt3zVORzXoKtcguEtwza7LX4KtW1WdzlD4c10thNYphziZkRyxO+5KG8XoIpYkJ3YjXlRffwSKMGrF72xDrxlGlHGBGeL+bBLs1mGgVe0TSw7ATKRzFjnwzkTXK/WHq0yAEG4SPRosf0CT9AyXzbwve4CVNK+96i7spjLPJcBsLqgQTB2S8X9FAwZP9H/3a1/ZvzUzjW8h/rKgKfvvcRKEIQcIRXdRGUFuwoEbghfmFPBylT9tlVPxCdU+bK/iIwONIRqJTshrqRowEm6pYAjeVJYiwwFzHaCLIbwTL2+SVG5PcjnbpqLPhQmgln0j+2bO+uGOhxrax177OEt9nKVf7duOxkJq888wx0eCnOLbmlGVzmtzkEqhrbXfBPWZ0PQaARAznjKN6kvwLNWXCqSbLXQtvrHxvkvOIyclw8WBu+jGKtNS9NrtarIotcOGwgVim2J3fmkqpo3iHtqjFtLUufaZdkEoASJz/GHcI+Nt+8/39p//2/6zYf/5X/af/8v+8//Yf8iYPINlVS2TLU/xuDcGf+n4L+PEw1NrhlumznSAKGEixdw8Wlm/9yyGWckzts2EB7XGZrZDM9tpqRQTZttxuJ8qRg3rA5eSuVnkf2n8QgveL6iZ9wuq6EL/GrPwn484893mW0Hi2sVlqDBXDxwoG5Vrw+6VCOHRzFGiUUMWAIWqmdDM22vOBvsQ9MMPkV7khVTyQbSgUcdixsVdQiFNyc5voeSCmTkr4RMTGeT9juOWmUlxkdVWKJAGN9y3HFQug3CF8H2GiOhzq7Q6jhHNTNzqLQvYNiheP2yAKcXTDxsh2M2/C08kZIwxLe7bsTMf4lahx+A3xIapJuMO+TlOPogf2FKCZdJYsHGTHUdDqrhhilM7SKvJ22MUgy7GgTbse051tNzjZuMFePhBEPI9eW03fYxgPe6P8Zc3EvJoUBUWZDgYRFJ7o3kOx3O8qiUUjo+nWtlHGJ7kc61D+wnol/BnsAwcfA4FSQf7j4tZzCx34iQfxGurqtuWNaG5YjRb+uAP5jKsvdBFaEm69MYnrqbaluk674W89Rcfro0J04YUltk8ZZg77NiZEEtO3CRSBgHd3lSLU5HBEzd2b48Th6cYIOGtkQRwvAAHFLdrTxRAjwnv3r9462dFfa3GbbplOw5TEy9a0FoqOf3Aao2b/MyFW8FZr+waWe2ifpUVu3kkiLxhyrIQZO+yYDVB5NZLnOePp1C+xKXLsvhOdCOXM70Bi28DAXT1RkJ+ZoTdFSxFWCl7wNMsIxtG2f2wEVqGt/RSmDmz87pRAW5RRaalKVXHLbLtcDUDPwKKqinmja8fUMyjRyvFEr0fTUWQ+YIFdWi4QD3iam1jTmNSN+HBl1hBfGEEXA11rOeSIa0m4s05iLtymFK4Iu2ERuDdtBN5zU9sx9giLLOPoZjhOTwNNTOIu8G4B6cr6gDU0QxSplCL1FDWIUYiRZAq1+rEI3yBM0jUR6zv69Czoc5Mv11iILpaeQtYq6Ft70d2lzn1RHOgN8Y+hxhVOCBF93OeC2GUiNYQOP1VwpXV0NZWwiwL29vV8ngizLLQLGCXfcMs+4ZZ9j8Rsyzejj6J3ZXrac7SlwIuiw+Sb+hl39DLvqGXfUMv+4Ze9g297Bt62Tf0sm/oZSuil8V63dcBYRZR9A3H7CvAMeMFOIijdfIR8C5WQ+0qFL+xgvfk9S9bXbhdcPyAEP+qoMsAKyuKiHEjhTiZijdG2smynDhhkJvw9CNcBxjZI4y5L4dIVtv35CuCJctaduY3bLJv2GTfsMm+YZN9wyb7hk32DZvsGzbZkxHxDZvsGzbZN2yyb9hk37DJvmGTddD5lWCTZTmeuz6u6dUr+PhwosEqmfzgcs/5RFHFmSbZUtAFOlE8QyXN0JMmfUIl3Gy4nyF8EWvFxhXwXQlHSTb0nALQeK2fDVQKq6R3MGi8ITDx4fbOAmAG29MupjLYUj7V4NBT8z05wQH0cy6uXX9L8mycZHk+3nLlZ72DSAryMxeZvNXV+xdI7lvMlH02TrTseu+94Hd9UGZbY2/RUiNjmfNJV4MLmr69WD0oqA73kHzDU/hyeAoN1v+J4BUalH9DW1gf2kKT1d/AF7568IXmlP37YDE0RvYNmuHpoBmarP13Q2poju8bcMOagBsajP6G43APn6z2mSyyvTVJr9cne9jFo+jRczpcE0EXPx0NP42iSqVdA02jvf1Po2rPXW+vhaq94ehTqNIZY6tI7E+i6uLk9PT8cVStSeWo+XGdTdo8gPFIyfMlWdBCd2X+g3EGQIv6ur2Zr5kSLN8ZJd5hsQryJzXrcli+LPMcKbadtMbeIP748IPzB3y4AFt+Z/ThkwbEEsi5MywNkItrgNE4f0/iboihasZM8F3bYbeGeLe/+4hR2IOTiuWaBnAW6g5iN61l1vN5qxmhBp7iOesDps2T6scFSyLC1j3aRpjzJwz2nMax4B8fnG3+Cqozrn90rptPHNl+spO82B8MkuHz3eHeI4bIF8U67z2O8LYjYAgVUhkHtH9+ijuNHAniqCD9PgSEwGMkoovYX9xVubdzplzMmCoUFw5WFXK0bpggdGqYIoohx1xeogfht/piH8ZZ6WmKCh3Mf43QATIFZIms51LabjGaAjJUERvEKFqhV1jqMeW3ruMpgQ9TU0O4mHLF2BIEBeKdmLli1PQVcwAXo8Fwd3sw3DYKEUT6C5pbo62PzOk7ZyIgXHQEXKb7B4OddJe9GI2G9o8spXsv9ncozXb2s2z6iAUiFZ9xQfMr2AxrvKILO+FzpNnF+dHZm8vk9B+njxiis4PXPS7XzeeMbyOI6w93R6feCQ9/vw3udDyCNx5mQLgfEWjQ+fuRNxfw8YH7EVe4xSV22A5P3lyQ30oGGxDwcYS+ZaraCPZ3V7zFWYuMw14MwczgthWznIW2lqRQXMJNyIwZGJdr1jX6bJwJDaBIh/D8eIvg+b30ncStQ9iATzDH+053s2NCMi52G3LWNca40Fr8mKMBbdpbhk4UnLuQrQHttKnEV8dbj8lgro14ZRCzFpgBhbu4KAGeCvcGhvDQdO76IhrrEhPFTKlEdO3sbw2a0N2XUD2GC7s3HF+q5GE/AchnzVyv9dzoyZKcHl9Ubud3LJUqc22BLAYJGntoF9Vw8EffuSC39q3T4wvXfDOXyM6lXWMI4wCBxBAiz+CXOmCBfc6vZXJkyIILvigXPfdlaNcPCqCaonWFGDBjSxyk0LeGwXUVwdKzhkNoEkIDUzg4OXjg7IioJoXUmk8wKiQDwAir/0XwHB4WTUbLtUUo1SQttZEetqyZne3GnOZ0benzCF5PMZUiTIhHlKswwjwcPxznqu2lO3vTSXpUwGktflqgNhKBGEvqA9Prm4NRrJ7kM+Lw1YKJTPsIGUAYAankWRI36MfeOuaHg8T/18mFdWYgXtYjJO2Ki2opNEgnBVMQixvx5gzcXeBulFNy/Obo9SkBjB2HaybzG6t9RcJpc1MjRs04EjEmAlOQUMEKDlGlmC6kZXG4dokagX2ZkLMgq4Q0Pgqy2abTf8j4t5LpkLk/tscLixApommBkOB7osD91BizSjzgfakSIccLslhu4P7Kim4YMHCgcxa8W5em81iysykIphrqA9cpVRnLEvILU9Kj2SzALTp3cRwoQysGTiquYRcd+fbdC3WNVXYu51WFnU+UMbA26+4vRjOmrqY5na3vctIH0IyIy5K3YhJ7JtBzrYBGwVJTgx06JEdHPXJ53CPvTnrk3VGPHJ30yPFJj5y87XAm/7rx7mSjRzbeHfnYmvvAbZ90auyYMG0ovvai2oUwOK2jUHKm6AKXXri9qQw4SB1gCrFV4oYAX7HgFSwIigXdYUGPhsN6DRdZdCSxPvngXRiMFHhRhQoUwh+7q55rLiB3B/XTmspKyIJpTWcsiQNFuIbQH8c7J8CMv/7DZlAFBs5AhFLc5r08+tv703f/WeNRkIlfTFdQTjvEcwLNjo+qBTXRvc4TEY7CBmnxiRecwo2ajEKKPrgyrCoY47A+w1yVnRHg/1gKyHC0vxWnfkhde6MS4nGuKNWE6ZQWdk9Rzchw4HM8NXn24eTkZKtSwH+g6TXROdVzZ9D9VkpAWQktu6YSckknukdSqhSnM+asBgeTmvMIBWjKWBa3AKinyuUlfjA98kHhWx8ErD/m7gsfd7qGef7D8/C+5d59Tbl3YV184SQ8XnMeuBE+lDnXEhZ/olyx29vbbqZ/SwxDEfgtMexxiWHVAvoy5oGzkh7WLI6OjuoQSd5UvfocDIOjlocuz8nZuVXkGNQpHMeejXHDxeB/HHtPn1s7fDrlaZmDA6nUrEcmLKWlDt7nG6o4M0tvGsUrdUGNtiZhBEadkNM7A+C3gb4I7dATauZMMQSoFTqJmDOudFaAseYmeLMgbA2gas2cLQCdJGoa9QJ8CX5nVHMIkg8t3nBd0pz/zpy6YjXcqeyor7z560bkNLH2TvVx2DR8vB78JcwA31c3qs2btxCgWaNujZtiM94VwXvvg6GynuOw1Uhh4dWPraUsVQRCHnn/IUhsxm+Ytg/F9wY9+CKOJUO09tBuJnRoZYq0NS8AVqWiIsB7852vv0ZEo38pPNhnwZQb/zNZoNc1X9omtJThRHG2Gm6LrYQciQwKtqdSVGZrC5fWbqr7byG8H99acU4YtNZ3cPiGIoZp7X7n9Phj9zuvmaH92Enta/E4L/TqtSo7L86jgBzFfiu5YhmUtXqCKJ3T44twiw4HWOAv1mUwMiFjlurEPTTG/EtPRiX9QCUCmVNqg2Ug4co6z90Silbaz3MmcM5gAlMldaSpeQTyft85R93FhSUIwnpzPpubvKsqfTQaeD/K68mZwRqvM+VurGn2L0uqx0dJ52xBG/wntYyrjqUzTAbJIF45+bS2cl69JD+B8+kjC6gzf+oVF+UdOb1jaYkm7isuruGPl4iP9Oz01cstKAkF8O4rL7IvEC/k63TXYoYcMy1XuuOFDvb7q4cMTZaGXUm1virOPywNI5r9VkLpDTm9n/BX3JickVORcbp6oHxRXq3xPDo+f1+rkn4v8WfCsJWjzUDCcymuooDyT4k7d1oRyzDmPCg1AcLIkrqpq8VNzqxYoMYlboUNyk1cUUv5UIAMLiqsIubR/6b0Gn2jLigEhyKVXjlSkt1BJM4Ko57m1BhW3QTXMTQ5RpFjcywjLGeLkJaIIePLgq1OF7q3Ezrha467+ns93MquqKMoS+oHDNuG2I0pTRl5dvTD2dZjh7FOpyjK4vqFYXNfrErnGm9LocIXivyISNfvI8lkwqhljOf6ZBBqjplVB5/LUVdHbr1reHNjcDfEEjnhltITXB3wK5Osr+iEr4nUj+8tz3HUFN5ePJbjazx+3Op46ARalcovLtQeudNchOZTnQvY3BOcCy4saRXCBIsyrT4pFMrHQLXmOpzU1j7+nHgnXU76qM2GJsErLBg1czJm+TTxI06+H6++lcNL6Zyvki7SISRrdRnqWtic9/VvpcscnNAJz7lZQkq64pMyZpmj49HTCyJeFqsEzj+K9Is5FUIK4ponKc3T0kUGBzXtk4leZxiAXXwXbj3CrnI3/4+lcY0Xny0SYyzh1Sn0eOBXcjpdra7ekxCLvX0GuZr/vgpnH1PUokVkwEq3nT2e1jWejS1SbVOPp/CGK1PS/Gr1mj2P0u9aVLr+6ohpn0Lwp8/+J1D7yNmf2SP3Sx2Z0NkffWTiiB95ZLqXHqFifOpGcVzzzAqL6dG0rnlDN+h83JYGHKCrgFi0JjK9hulQkiogJkgPgtAnrqPBrEy/Yfl0jRlRvnmil4uJdIlDdhutaFEEB45SslaU/zR8sTK2FRVRjoQDKYHLhSXEnIXN+x6rsy5wu+NzLji/KBjU/MoZmTKD5RL9NQ0A2KVUo5tLxWG16JnnRrN8GuH0Cmz9ERkSa6oyAczEgLxGkDcSWLeZsjVQcD+0YgcFLgjwI2R0I891jNvHFNb3taHp9RVUp1xha9zyPEtpGPMXxsi7RHSJFIo6+lLIXCPr7KosckjRYHemPsgvFGgQprEXB4UgFgf48+IUMUTfjgyT4O3+F72hSU7FLHlT5vm5hGDwU/94LCxu/M2SFxbhi49U3MeNWit96VKdANHiztwDnFQVYYf1ZBRPa5u+qspuHyVQKMhVStStApaNkp1QJ7Eqio1CqIrUeCWDCIL7O1/wOiAPUhMyFSDSRcyqNkioky2n0SBce74p6mF47CoDUEJiRw/o6L2opKgLbMaAklDWwLXp048g9iYG7u9hqUffSCqFcMrghJlbBkhvUd1MWq+wiZ1xwQ3WHLJTlUttx3bkZ+Lj7MYa+H73SgV1FqEYTE4WjOpSgT9Hh4rKbc5Gj8G1hqHXLKzhmM3x8qh4vGALCVmATNtmfHNZxWlXz/SGB4lk2AKiqUvFEnLh6s+7UuH2RBvjsDkmX7lbYu/tqdejD1s4TghzlAJokKGmcZe60nVl2s6UfxqMCihuiSPxcRo+AtxhbEaqeFyYCzOz46w0Eb1FzoxdLrAEqkiAORWeryk1bCYh/MK3HybXCowxMKRPs8wVmbf7pg/7hsFXVulxtfmzMQbw+zD20KKwxOX5MjYIXEI5OhZZR6xPqZnqF1Rry8w+pn7WJ2PGhLni2dWaUeZmuFPsJvLjcOE+eE8olYdN8lrGGEhLeFYFTeHVvyt/74JDXeVSLKocqV4cSjD7S5ebOvRTvQgQ1seRUFJtbnWN1C+COiSxqYr1uuK9IRwBo80qq8zl5ys2zaG69ZwRWZpU+iONmkCSvK/egsNbAjZsbupYCHId0+rL1SwuX114YRRadASnTEXFkG27ZychoXfGEOKsElzwuJVYXOsSKzBXd7T12fErVXjOu8g7h9nkEaGaKFt2Amst+vQqq/cgf93uaizRwwA2hcErWVZltmAN7NAsoB3ccmtQV2XAEAKhUaK8ssUB8bkWRYU16d1cAaAmU3ZhOLa46+0ohHjCiFxwY1ijqnBHXfTD6oFxNay+i2gMLI4WPjIIKpLE6U5ETlxGV3S8ElglUURJ1dmCa2joI51lkmmIaw3T0ui34nXc/4P9ai5mrluHRSdku/9YAtvpdVMQu1PGtpcr38vVfU3XaAGTDJe2ez7e5hXfghaHm+PspC1b/XytalX7U2I9Jx8CILp1PpWlguipY+zTVylG3AIMJuUhACMWFxie54K33RzYhjzxZM6Zoiqdx+hPzWOwMqlR1GxM+IxMSihttQGRN1WLnOl6AHkk7XPDlFMsG10cukN0TJZOLw+BaQQA5V1At3usmtfU8Btuli5XLCC7gnoIZ1IoFOZ6tJMy9gAoHmKSxqU8dTnxZDUVjNC+D1x0/UK4OEgDS2HBVODG76GEvA611XUkJ6mxKwumJkTYRZxsB0PWjrSP+A2e7rw/q1fEBy9CAIdAKW3PN4goBQzkiHNRUXkPTlBqFvTzjOkaxKez1DUpRVRhvkcUm1GV5fHsg6INTxNrMpb2D6mIHR74dCGCEHV6ecMUKPSAzeOPZG9Ec107uhwGCZqUnbJid3/3oM58VPY+IgvuC7fadLsBG6mf6/ad7Tr8pyuwT+2CVBE4o2IU8Y8FijmwuiZLjBsueMFyLti9axpxuFNXp+5/B/hSFBvUxF9V5WsdrTX+AbUshJCF28gQ0dHU3s8EWVjrR3NTYvhnz3nOza0koVu30SasI8gUtWz/MY3TtmsQS/6aFA2pjOWQP442aBxf7TJy3d1AQxGpWYgwLfAqni0wJwEePyPcOCnRoGQhBTeyQrKomrDaoaxmzH70N9NGkmvGClIWqCPCS/HmqnM1pdp5Cep8tIo77riU5r14ZhuaUzvbYDQY7vcHe/3RzuXg4HCwd7izmxzsPf+lnmdgz+bWjefTIxe6bhoQDKLGEcwigsRPxLiylh7AKjjXVS5nlt3uuMFSmjStnTO5nPWcqy2Xs61e3HkMVIzm5NIdL4jdUIm6GLHeboqYbJh0wPdagMwG/HqrqflYbmjempi1vsGrFuAgFjIr82rpY00hrIzgkdEzaXqVnhs303HYFDSdsyTiRZjeUq1SrLzjirDxJhdFaa5CtAMV0kE+eFdbaeIHqH7N85x3PoO5ZLBGhp0L58R1XYsaJ5D1FrqtrySUU8h1u+fxMxMZbCDMtzNVflsNwaNLFnlBA72LzHtd7JzyVrUjJlYBKbjvSKlIbZ0mzYME15s9OP33Xq0KhNuzBtLj5AQ8c1ndx7zGy6WfqJ6TZwVTc1pou/m0geulCikPwuwUvXUnmYFwYoopWJGbfSGFNsoOH7yzkGpgNcfmoh+Odnb39p8fvBh0/XX0w/FJbejrvCk5O7Gj8V6t2O/VoPmA7k73BoOsTpmYsTZA9+o6yWU4E7AUh5eqVCl+w4JFlzJhFM0dcoqRqqVhgG7hK3CAMjCuDpxYF2+sS68u5MuAXJg4SVmdxLmWrdZr2lTcwYI58HePgY22vj2vLUHR+e7Ock1vO92NZ8L5vezuQv+qNcO0LhdWYxCS2LGBtdMLmoI7e30y1lxJIXM5q1XYsUeNvPYZsFwf1nhF/ldzcNU3frrHK53Ze8lwMFwd+v2aN4XRV2bneryCTzJ00Y+OOXS2ob5vpXkNBEBsXm2If45JqV08aEw2ty+7K5Mo9Qyh/AFFXdeLR3Vb0M7P5K0WlHdx+RyaM2W8IgN7oXYR0XBfOUfTtO34rAovYFrXHEvLagRuAQpq4IfRAUfmVGSQ4HE5Z0tIDru1pjIU3/HbVDE7ZrgXqr5ENQMYomRejZobaAV2+pzlBcbIaGMXw+2cgfsvQDelcoE+IEINJMLNypyqgClVmY7KKlcdKo/lYG3p13SqtSmy2EuEpgYoPzCWpqbo8kCd+QAGCsqqskAoWresoMCxNZGhabQo8nIGmkDbk1IlolLYCcJrz6gPH4EqCOfvVs/vG2x53IBaqJmC1a0v3LjY5+/TM2tc97L/UXyvs/edld3szgQfgV21wnAVNtl7t8rvVQ7i5RLiPRB40z7nG37m4MZ0kXPE9eTGWmixU6egyugtu5LjzeKV+x4BLk+lIopB2vi9Zrq1CeAJV+ojk+lV5YC24sDqPiHBCkHMCELwsqzaVta+cMnwQIhRnN14a318hbM/hnuZUjOo9YM1HuUNU4pnbrHSKPnX57t7cnukyJm1QDVjZPwSxRUkzywLpsdeTI9PrWrJU6SRvGNObe44yS5YQYYvyODgcLR/OBzgnenx6cvDwf/vL8PR7v//gqWlnTj8RBCBeEEFnTGF3w0T9+hw4P6olFwr6nQJYgiri2sji4Jl/gX8X63Svw4Hif3/Q5Jp89dRMkxGyUgX5q/D0c7ou4gZjYCOMFVdZ6y7UPqqj1lrSH7qKevGN/YIHBkT0uULBhmOZ2fkbqZ+QiCAoLKeKc+t/hZcSwVTHmApnKTCgMfEntmIU4w3PC1l7o00DqTM1ZxzuL2AoU3DDZ3Xw7Pavka5iZiODRXAnlq+FEp0zlWneIMxPXsEOt8lage88g5FA4xIP7KHogj0e5WcIh4GHIeFLL3lSp6Fsbl7GASWRE0lNFqB8qBy6sYIXo+qEGMV7RqqvQQ/BGoWtvVI2OmAtoBHlJUjNM/jCV5pWm/ilHI3sTFcw8tSwXqq2CIcSK4748CJCCC8Vs/XWqYurATn4R7ly9SkcFUbwzZesWDaiA2yK8P3CjG94RLi0OpW4158xFCxDMobnDgccELDVXN0e3ddzY5mQnccqo6tNRHjgJ3XlZG9eRGwKbr2GbrTYVehouLxcy6W2vng2t73V3IWeZsXqDbWVIwKEMObqCG52BnNcehZqBT2AO6i2yxwJF8s9cLqqXNjimwLPOpYcaycuFAFfw/dqP0ZWnyGZUN6VV2Kvhti3x9X/aPSGpFitnVfFZXaNCpG9foyMN9B6+R2vowhJ3w4WVtItR3PHcE4tjXgm9WDeApKuROtlqNugYcon1q8Tmj3Z1DBfBgBvD2uyxTXZJAf7mrKvYJ8G1ekVUf/fFnV9LKDD8Fdjbrl5JZNCFR/dIhVokFP1KTdvRkT3B07VtezQjAYM+FsaJAXxGhtnpFIXJTjSS4hGENzw8Ydi+YSALZcOThSinDJX1f7P2r3K1Z3Ya5hsbkOyPt3r0jOxbWH7nq4jqVfl81V51vB8sAQ0sbTOEQuxM2ioDiKLOZeUHpqEPGRk+AQzEN7UCuGp+tCCrjNhCM33IgCP9uz4qtloICIsey2oY/tvwwG4GtceXq4vr7SkY54n9Y4zSXtjI5+x/U1gRbAPlRcKo7oWU1BqJ2sIlrmkBipI3jN95q52zMYGtxfubs+1AXszk3uof1KSLVKleJ7B7H5Bnxx/HeWQbMfGVAP4y11SuEKOAxiYNfMcDDo8F8uKHcFml1h+qUsYd7rN0ruREBJAni/OiJI1y8QbRO3zh9pDSTqXIowDOSaw94BLQkLSjfuCDz8yQrce1S61uaFx1XBBNT7jnSISm88CghESL+/ecNsp1YcQA+uQel1HZ2c3dHUEECOcRjzTieKAgLicABPW3WHGW6CWty6YZFZ/4hbqwc4BRC5GEgcOqjvn9qB+dCF7c8BhTwYC6HFGK08wsLDp/y9ko+viI1yL5104i4Zy8If3FGoaZgJCEx2vXLnU0il0FybWO92KzN2NZpQgLurZIDT8cJ4JsyyGeomjXM5SzT8nvjfk1RmbJx44eu/ro7X2JtfZfxgzrProqWo1G6FUapNuWK3NI/cjWcnF1shGrX2RlC/3bIm3Ggib0XoEcHW7PleoaiFdlNZYIDv/cONwpTCgNunyPP6mjZUrZJY/PA9IV5CfvSm0IU4x3eF0YrAO8MqLuWey0K7T3+XYo1Afw8bqbUh2Q1RCQ47w2FA6GhziRaO5roukitGM6+TucPaL/Tqwic6JnED+sVRxbPGFn2asgLBZUKnHrsSEPCp3f5SgOl3duI63zgtlSzY9tFCG6YyutiI4LTpZKLYDdq4/vGLy40tNDnJTz8dLhaVMOE090/1B3uHg8HGVkOMtjOKvjIvlZlz9YkxjxAeWHdANUL5NnQ56WPw4wac9D1cUhhIGJ0dpFLkWwGVUUyu7hEm7HzrKELSydUMAgxk5PjCQQGubaHslILS6Zw6HjK0GYX+BWMXnV8JgFDqq6ZUq1QH+aSF0zQdBLQNBcq8RiZBuHEBke03TBs+86Ore3hWsCoEhpy7pvFegIt+xgozb7WOR5K79KucPXifLeJEPodHKsDwJEVOU3avfXKPXVJt+c+yTxbLDgsFutjeGz0fZiyb9Kd7k0F/dzQ86B88nw76uzTdPXg+oDsHU/aw9eLXw5S7WzWXv/fSf34gfe8I67c2cr2gMkTrQhbS6DSZWL2oHp3p0tHsrxCs6hOwAGMKB+7n/yUUuHXZ+07tijyGsMHhDsLPkM9w85+pyLalqgZLamFmPVfqILinJ0vs8szfQJHX1TXfry/PXv/Tl9zTVS6bPWR5yvRWgi+71Ebn7Gvke4GXBGCsWYbcbIzHb8coDMN5NB+VE4bBj5+hmGy+oi4sg3jAQVAtfNOdDnzv6a2mUmO8JNScBA8UOps74q2oQcyfteX3V+VvkO+hv/j4D186XCkQzzdULe3aKHJq7BpPyE9MYVwolNlgd3NaavCS5w5EAM+WurS2UiF4gnic8ORAR29YD64MAKQ665GMK5YaqZb2jErVsjDxDSID6FPWI3OeZUz0IP4Y/5UiX/achOyRW8VNh4d689cN/+xGj2zg0x6wepUEjHqZ/IzPmDZXNLdnl5mv4lH49PIYWKqUhM6qCFA+Q43DedruR8KPAzfCKLS/g/KCzhUNCgcs6JWxe6oyu+yTCu51HZh+ZSAibypyO64L4iLxj2U9xvx/RCecSJmzCH81hnLFn+LILA5Odt8DpGmhStYKfts0qmSfumjsX1zMrtZWEGqzUb1p1XUSBRWH1AiQ3RHq4IKKckrTkBhLq9uNGyYyqZKaCR40QMVyduNs56PCrqrv314ARHlXCYNFYvtkyV2RJuD5/lRWrzcj9WF/cS1XkSAp3SyflljrIZezmd3iIPbkTNFizlMPFRI0+7hVSGlrRI0YVWrj+yOvGL1hpBSVNcp91QJ8tXrFaY5R+5VZQTUphcvHbM8YwOpfvX9z+e79xeXpydW7t28vP3XKSsT8bCOtPYnFd4HN1+7nILUIRVlzYJ9V170xMsPoYs2b3nbxlDsf2pPKbW13C+n3u9MKk2qjx56yx2z407/99I9fDl4fHP39U1nrPR+fofyd2P0EWTa1xKewOPBQsBsh3OBiMD2clu0jYjQYDfsD+9/lcHQ4HBzuDFYPfm2Oz+7PlVTbB068zQsj/aVlLCM69j0WFI1Wyd/rye/3yQtXiNQXyJQLPDjgChfyl6Kst5q7DGpV1HxmVs2QMtfVHekNy5eYEo4KCAq4tor3OWczCMXPZHO3ZoE+9hk3AEAX6Rg+B9hnuUf6M8OK/EsfsVuvid8W67Q2Fx+R2Y/kU1ey4eOMKzAUPVo8WkOr2lMQ44+LrfZ+3ZpKo4D6pzL/KovJGaWYAo4wGN0GIf4WikdDM24C0bQqC3B0jxe2q7HziXG7V5gmYxhFdKfv0g8xsxSL2wf92z7aI5qLNDTn3W2e7rhEfoNfNdCXJ0csh8ZdjJhuRM0EglomQUbrJHprcF1U+vbjWFtnBuUSRFdc3zgGQcoUv4miFKG2rPPLRr7S1gi353LBtmnuOR9Gapu7wmY+d7Cdi/tEga2ONXAfGG3dcwuC2Z/llZYpfMhUZ3x/lNBZFEylVLtK67X7DThc83BjGlcKXlUqsXya/HuULrEj+bOXL7Fj+FOWMAHC/yeXMcmnyddaysTS9m9SziQayldf0iSi9WsvaxKR+mcobRKR+2cqbxKT/SctcRIN4SsvcxJR+rWXOrGkfq3lTuICICsQ9z+55EntxT9Z2ZMa7X+m0ic1wr/i8ic1Or/aEig1Kv8cZVC6Sf56S6HU6P1qy6HUqPyzlETpJPrrLYsSFwr5Qkfrn7U0Su3FP0F5lBq9X3GJFKDzT14mxY7hKy+VEle7NUys01KFG6LQS4+wuzQvM3/pmDMKf2fygUz64NKGC/45jaqIh4Y1eeajTA1Vyez3rR74uUOb0BtAj4nYmR1SRZ9tzH7f6IE3ewNb2OhIiCyc/A3Rp1Jdd4Q1PGE8CnThEK19ZApcVzXjR+PA07Bkw/DfukLjPUCzcbc2vukQUgBXcs2OWq2HRl0vxMXT0vyWLjVMEDV2ah23oRuPlA5NWlsQVgNUaWhWFoAT71rDlTOEhNXH8eby5UXPA64SKmguZ7J0MdXkKIeQbcPQEXVhFKML8uzo5GKrFwA33bYIrTrQMXgUih2EKxQoHp/SPGcZ+f+eHF0eJeQXKVhyVgVkIMTOQrrMvlrSp09CN9KFjgacpkzeilzSLAY2BaeIYAbAZY9OLuCSzSetV1x3d21SLQ7J+PjwQ0HN/IORHyzNoF2HXXGo5YJdhUU6Rg6MG9+Glt2dXgW74DdKlWJcvZVgfYJ6h+MmklP0phVLMRWtl5oPVw9APEq1Oso8J3bQrkRCYv8e9/BaNb6KgoXXjUkZT2JkHX5EWs7WFupzrviCqiXGQ0NCzo9nJ1sP3qtuDgeDYf32t4qmXjeFcaxVJ3Xt21B7SCWLbG9N9L0+2cMu2p3qOR2uqdeLn46GD3RbxcKuoePR3v4DXe8NV/H3fGLXe8PRvV3rjLF1LcKLi5PT0/Oo6xU2LRfrQzQ/s21XeV5ercHTo9JcfDpIcweP9vZ3Dnbqe3jBF2yd162vz16foifbB0DE0YFoa8Y7m0jlj0Y5rXkjCCmhUoLP97m9vU04FTSRaraNeetgcGwvWMZpH/y88d/J3dws8l/Pjt4cRYfblKec5ugV/mfPRTX4K9eE/Gw1wg4AZqsK4DXDJGe9Wh4fYoIHwMRo6KGwx4pLabG+lfTaLqSY7VwQmRqaV6uLmq5SZ5uD/d1BYwl9ZtBUR8xUCHaigL8H0W31zb9GLfhN47Bxh3woPFhZFx4kEyPzXBxQi2XeUmhq8/JWrC1OA1PAbAeboHCr2A/6wKlpdZunI+kLVxt86TW1OHCu15i+YNp1RGXVzLcsinZ6XFTW9n0zXrAvEWt0fP6+HmdkqJoxE2yi7lij1QONCkitLKhYV0gdGiYAUwzdtNS/nk8ThFhGF9bSx3jQxrg+K/y+YElE2LpHG337iYM9p1XcwiqDs82vOXYgjO6mfk/8yJHtJzvJi/3BIBk+3x3uPWKIfFGs0TO2eYTOMDcod4sNQL7k/BR3mrWuHRWk34fST/BYjD9P7C8NFOMILB6DuDnDfGtCp4YpLLtQcHeDKJWrKZbKjCEUdCXNFBU6ZBdpRBH04OQeaPzW4XtTMfMAQYoG1wxQj9mZ9RBy5dQjamqK2ZQrxpaYgz3J5WwbQU37VrWwsml7NBjubg+G2+Cn4GLWd6FnfWRO3+UqJlZna9vTg3T/YLCT7rIXo9HQ/pGldO/F/g6l2c5+lk0fsUB8RMsVbIY1qhVhJ3yONLs4Pzp7c5mc/uP0EUN0aTbrHpfr5nPGtxHE9Ye7o1Pvz4K/3wakwgtMuV2VAY+/AetwKdtG7LYGg6TmIIyCm1FJQCcRQnJwTTbsx432Eh7u7xzs1gjFY/rqT62CXaKqAUoYYHwsFwBB8cWqO8NsgdH1DNdexhUAJzhKtlprLqAcBMyPtbndLucg3hV43N6Dx01VEHVR1t2zi4Y7DnX5VZxyd3uDFwl1bml+gyJt7bdaLicy6teFXD27OHqzlaBNBUZ2gAXoShKlpZkj9B0VWS0VCaZ0UprK+e0ue8nZub8pZ7pHTt5ckHjEhDwDyH1XBl07tzxbUJ5X77UZ+33CEN87SeXK97TAeyhWqhKkc50Hime+A0wBsfvs+A2sG0sE5AFHLAzMbY3W1QkGLx/5ic/m5EjrUlGRMnIBNa3J8dGnMaEUZm2pNxUDoBfy7HgLS/M1x/f+4lOIj6AOWLbOiTyJO3LzePIp83j81/cXPfL2r34+z0TaI2/f/9VqZBEqTo8cv/nrA3Mets5nzX0uU5q3cAuffPJ9N17evNpqKU12eVhJ8XfObj9lJFLNqHDAUmseTdyVJs/efsZmPhPp5w6W5lel4OtSHLvGTHNie7RDf/8JY28s9E8ZP9STv5LqCpTW9YEIh6MT6tcD6iv2Fw7Oyx65ANXlvLWkj2nOp1IJTh81RCHNFRiPK4zpPm/tJV+AtVcv0+2nBkp9gC4NpqjQPGMKkWF5O8N9NBgN+oPn/eE+GewcDvcOd178x2BwOBg8elRYy2Sdw0JwyBWGNHzRHxzAkIaHu4PD0d4nDAmwOtOra7ZcOzLQUQsMyIMTINwDUGJbbg313cXjzoVoUGmpbta1saySDe1HoVWMsDy3D6Tup2pYEbwQJK6Gw4/rCCjJ3+e0mCC4NsXeaPipnGB3hRTssdlGjXxBbCJMYMbAdd2YvoDTscKo9vf2dp57rq9aEuYTRv+ZtjkAOVvL3FlK0azqgqZosXPTVu9HA1cJeVWaNVOc5le16P6nXriufiJ2VeFS67Jaxd2nIKD9B7jjdBlVIZvGlT5h7os5dcDPPcLjIFd0EPoALwmmVm61EGsvhSzs0HQ6p5Clqtrc3dt7+cMPL46fn5z+8HLw4mDw4mQ4Oj4+epy0CAgXa5eAUXDV1DIyhlwKMBuRlPiZVSVv8U46MAWP7ikUr+GC/CjJKypm5BjQmFzQ5zIhF4wFb+mMm3k5AUfpTOZUzLZncnuSy8n2TA6T4e62Vuk2wjltW8bAP8lM/uXVzs7z/qudvZ0W/zFYo/9Y+eyM+D/GctXBdPVkNEeFkbPJLJcTmgctT7CVLzwag/wjLNPPNEw98V+DZdpCJ3MuICxgdY9penH510p17ZFXf72ggry0RifXqYxM1541XxIwVJ923r8aq7Q28k8ayh9tlt63UWtT+Nkj+wps0MZAHzeWf2d70t3prlctihKMbadOT2mtup2HKQ8xqww3m8Nv/tF9fAC++UcmPThxCmUslFq6mHiAaaRVsBcg4FhaFUNELQgS95TWMLpBGZ8xGV6J8R99qQyGNasxYpulc1AQqwpklrKzc6/tSeVuj1Vfl0WR8wDZtQr2ccrNcl2IicdeELbvM6UwitF6oTDEPGfCXKWtALgnoefyVvYdilHaCqgMvW/qbprfrKxVVQNZE2Pf1CAoXWdtgqUyc3IEOj9tEAjqyRXXcl28PnYa0NnFW2B2WzE46iRpXUvRkdM5s8dU0AZ6mN+eHyFlxuRVDBpSl8xSzLgpM8SGzKmBD+0rp/8iG7kUG4ek/3wn2R/uHuwMemQjp2bjkOzuJXuDvRfDA/Lf9eu+dWYDvbeyxKc3NqKTaGBNz+PJYZUHOSUzRUWZ01otYjNnSys7GUrN6Ar92BugjaKHXLni2FDqR2NF+mkupXK2cS+Yt+3SeEheXiUpo1raAzmHJ2I9A6zKfEE3ChfWwJYLEOORnG5f5E+kNlL0s7Q2L4XUhubr2lWb59A8iq9mahbMhSe3BsAJ5ZYbVTiiCqGhxt+EkWshbwUWKrBDgY6kIr+cnceGDNYEq9Deb3nG8iUeWN72gSoW8Gebdy92B7sre0YVm1llY43C6h308JCs6v/tuIumNUkrR0+nsPpbySasvua6axQ9zZHpSpWR312dm3iR9YJGcnb05ih6rpNwdxBtH6kZHLl0+4eSCamvjrhiH6nx2M488npc+OJ+Tc6OAdOJnDpnpVFHOS54RlfFBxrYhXFZrmRl/SqTi6rK/JNL6lgRCIHo8AkHDNXtFswVxYvLDtfqhAry6uTo3O7zI6xeXIFbIv1xeltIeFlXtIzzh/K6e64alMSMF8x22Q5VJr7U8RjzHAhKvqvnJsXr8yf/+QFDw5do8MuzWpFRzTxubrl2zwWfZFw7D0/ORqgmVrH33knlHW+2FeaqupDXJ3s9SDDbIgixw9zRn5CjLPNETUMBFww3dU1MllBlXaVU+0D7Ool4slPvMUVcBKx4qVlBFcANupZp/ZR6pgW9xmJAPYJVPed052pvONoKA6xyuKvzTDMT0o3bg4aHI5jpEirq3AQzlhIFobBWn2ECCiVi8B85BVWiH6w+16CXgf+iOy7IFzkQpC8UR8qqRC0kEbJ1w63j0pmO5JnJ0fNesB5RzNcTz5dbjzDqvnT645fPfPxjkh7/mHzHryTVMYg46ZDGvYjznx8shQV1p5qlsFxdercPrXjgQhsqonqcp8cX8G7yvZdE99byb5eOgk6hOrjbZl4naRQJlwpV1yoIYMGoLhViyEXlte1YXThjPXVoTlV2SxXrBUycBSL/6h45kek1RkUYygWYNHaD/59yAmn4UKYzC4CKq+zv+5OJnkTpe9soDl/rrwut+Gq/HtGcFmVSajpb5QiGwsbZ1f3lks+ZsqYgpDeBTMdZgoiTUIHY3ZNW9X/t01DGuGaxcIPS3RWBxRrKkVp0kdLcjntKrfZjOVQrsBxpTYfwgRJzK6EfWGu9+Na1WqdQCeeGKTrzikNlHrvXdS+UxR0ApcNgNGmk6SqmaVXTKeP6OlGMZkmcUfupV+5GmuqC2mfpkmczWs7YFlTSsodhyrSeltagfkZnM8VmtRLlwHea50Ca3nII9AE2BWu3kVTm+SMR8WCoWLtr/WO1/Rgm/sjhfjm7Ai0AOY3Eo5fm3ri4z7Ko0N5xZ6TxlrCNbG7q+2yO0KJU5A0zP5y9vahZI9ATIrm2266IjnoKLYK145JTVAeI/Ns3l28v3q46FTMmk6/IjQ7k/Lu40uuD+Urd6UjkV+dSj8n6StzqlqSv3rVuifzmXv863et2br652J/cxW7Z+jW62SO6vg5XuyXo39/dXjf218T5zZ9c27GWFm2qM+MMvCrnT5PbuZOKY0/ZGPx7dq8oZkoltPcPg47qrPCPuK6fZjzOb426cVzg60gHPqLZajyIYQmv9KDoObpuq+uHBaOCi9m0hJr1S1kqwsQNVxLgjqLmT/2Uuwh4hTHhztocTxg1iIjX5ELxES6EB+pVCmB8RTOJMvgeabquxUJeHx3H3QYO2IELaRymOmJLgaB89/KYPB/sjqA2cTmbAZbwITml6ZzI1DBDnrkyYz1y0J/wKvHZ2ntbWI3SabbOy3Arya8hKvqfZM7uaMZSvqA5FunTZMZvvC8c5rQyZHCdY8cUiq2VwpVM5sKwGVMJuUCTkt+4B/G6yvnKXeXc0OJ8WcxZx+G5+evGYNAfDPp7p/DvTn+0s9EjrS93fQHr++9Nnmb63jy4zyG+yqX1wg6Pdne0q98LfudcUl5vAcP7t5LmABUV2ozsRPDuUdSAnOu+8heV2rIc0gmscqeIncoM6ilZU7c+fUba5xubyJXQT9gMMLufwvVwn9MBroRkCR5Nmuehej/3RU5albZBFD2Zy6Ex1IKm12wlCO/VBuva++qGy8X6plaxlEGonx/0VzLWdc9tGPcfNF6pkyld8Hxd4eBvLwi2T555nU2xbE5Nj2RswqnokalibKKzHrlFB1kboAKfbNFd5vnTUf2FYUJaNwsooetIbQExyvmWul1fNLVcfi3/RW9ac3vNlGBPyKWHx4C9BbLBsFP01hVSaFG+m+wmg/5wOOq7++Um9U/re/g6ZjhGTHSMum9K/9Hkh4/w+FLz6ftzezdlwkjdI+WkFKZ8aL9Sdctb+3WNmDeb7zVKw7HrZ+y8DVA/2rCZVPx3fEI2B8mFkZViWhmbEyVpBiYVU4CQCnKMN8CP/OOakanMc3lrW3YGTB30lDzz8SFs65DkCA6/oClwVPC7KmfxtlUW9gxJenthrZ/NTai5gfdz4IxxppSLq8g53r+xenlw+8Sk0uHClXFCznNGNQA9klKDU8aeNbJgvo4IpGBiV6fHFz3L1ULJQmpGuIl8Yg5Yvq2FwzAfcSStF5Gvtc5XFVjDQTLcTYY1atur+mnshEtX+65hI7yUihznsszCrY2/UMKMCbiyd6V2ATEo59eMjM0oWbCMl4txYhfTzaJabe0ro3A/38PSMeEOyyPsxZkalXEeWuwy0uu2QlmsiJh7n1J1wVIpMl0pRHOqyYQxQTAKrT5tO6O9evfW2Pmj4hEB/mqd4YgwOoB3WtPg3tsR1PCjkjYBVjt74sTML3yA22Fuaqx+4LSzKaE3lOd00oEfe5RPmDLklAttWOP0A45giOS/bxhsNMivOiI2ovNLB8c2iFgnLqvjFEgbCMOEKxLlgitjCY4FWFAsCUKFFMsF/z0O3QAWho/vNQNX7JSMYRQ8G9uVgh+8IxpdWKkUU5wrmtf6BHA7KoSM/IwOG75jUa3FS91eSm62oMvm7r7oD/t7/dGwPxqMdke7L4aj5wfP+6P9F6Pd0YvdwW5/tLM3fLG3//xgvx/ikmqDeDqH4heWgxdzqTymqSK5nFXhJJ2sogm7VwYqma8NivEIKuDJKS5l6MnfHMMNkaM5Sluo+4mv+YQKekWzBRcbPbKhGJRNErMr26D3D388KtSYWmLGT5eX5/D5/qjQlz7cPeTM2pcItbIX4E2CeVOq3Js2mplQQ8rzzk6Jyv1IFfutZPoRKRr+hYnMlp/i5ftoCc2LGES2QSaBXpvzcnDw/H4SXZGEFYj06Jd/zA66dA56nNYHx/sTy3NJbqVyNZ1a417DrFxChJ9+aG6eWWJBamNp6w6jf7i70z1Va1MGNo/ctWFTH4BilzVe1w6/XM60DzINc5nmHOqE2TFqQH0EFHNADqe++mN42s4oz6rsCTSYMKqDCCn62lCRUZUhGci06tp6/I/+O6Ssf3ZS1QOzp+g/+seOUC6F/bUDOHm0w3b39p/32cGLSX84ynb6dHdvv7872t8f7g6f7z4iLtZP0oKZuVzbRNXmAruKmHmuuFXiJIS4D5P9ZOBK43h/yqzkGUCx3lIdoLQPqwY2LoOzAyOOF6XdXyyOizYy5OdgTMpvJVNLa+Vv1O6n5bQiA70ooXcIBCoUS/H6kaW0dHLZQ6hDik4zshnH69dK6bQn0LQWNF+SjBnntCfkba0hiHmZWINeZLVgWi6AyFEySAat5fHj6WWPnL+9sP++t//Ii8vuOV9z5aPN19xhHgefmpUizdMp2lQhZBwmEECOa9kNE6rxqsS7KaJqtrFYrbwbQhr//PgYX+hfgoPQ1egnx3JRUOVd7ouYZBoaveWmqlQQ9ba5qUncrGvVe2PmLC/cbLtZhm4Uo0aTkEdGyIJrUJVnUBrTiaL2xucLOmPbM74yur/nsWJTptTaAEreuearWK94w7dOCg/8NcnlLAYr3W7QrgspNPvi2gh2u6o6EhP5Z9VHHhrx/QqJH/mX1kgctZ+mkjii/2jR58h4OtkXTeETCj/Xaof0w18+RfzVZF1o1alcTyLzHHO1oabUHSGcn1//vb5vsKPuSM7dQT0hbL03G0DXfbeKQ7i5CDFvPsAgtlXPal8+DCQQGojBBDwAq2KpVFYdhigKLDiAf9b7JTWvEFQPQbPbxXcJIie4NFyO9pQrdkvzvEeULKEuWS6p3Ry5VdHUVmi12iZ3YZuEtuZUZHB9RkPARSqFCGrYmXsdtTnXJiWai1keNVOxAInzbWkmtFQQ0kF0QQXUX97CPR3T4aNMOljRkdu5uh1Pc07X5WUJSwR7wYCOasYq72uvI+Ldz16lyNqlufC1FjE1H1jJAWW6R2Rp3B+KZIvfwf2UgjPRkyHoouuuzr24qtRYm5Fa8evspMms2vKuuHXx5vV5a58QcnbSccKtbOit0dV9Fs8Fu39FtOtCmvlH6K9QqGaxnHrlPj6QaH3SyoEGx589sXI5m8FJxNI5FVwvnF8TvgSD31IfAdWBy6DKu7aCrpqtj+Zet7pz7XpZmVpzAPJ3tq367PuPnJX12xm91LmchY4mLDq6AFSCjC25+Fjy/bg2EP9WVZ5VustayAR3herrI7RqhB0Ey+L2vx97RWNSGqKouxkmY6T5e7gU4MJdGVtzFdn3iOzuUGx8XVpaq16mZSxUGwIrBnmS2kU9VXLRCM8KG/OhgppklUKa1RaPXNTklmqxuWkwnRjTZwN9PZJJmJdQwr1KsWnrUNs3VG3ncrY9LQWUHdOJ31ArSI64lN6ThjgEZ4cdVUju8tNQR4sMvHErNHbme5eQdgxyTSkwnUprSLAbpiA7yzTw7eE0Fg44ZSYBzQCXNzSC0RKwP1y/mWQ4K7iBlvbtSuFeyhL8PEVp4l0V9rSVPp4YAoWWUXG4wC3tf9qKE/HlgvmZRNEzvqVKjHtkzJSy/8Phn0p3oHmHz5Ap5fwNkUidNT0CT5a6E2ezYEfuRLdnnqsbjbqWx+QvdQnCJt5YcStpTrWPRueCG+79eqEH0BGc5UFJWmojF90hk1LNfHErLMaYTKQ02ihaJD/4v2rMQgcflAtNct7MeOoUSC6P4T4O2VaiuGATF36mXHiTzC07CP/EwTtfY+wObGyZxmh3R/cOZZ3Jnc1l8FSjC993IR75ULgAapbSAisAR8Id8xzgPiA1+F7VWfcrtl0QC+FI6thjYekk/6I3tJPppUjbGb9PWvijxnLXnd0Yzgvd5PJH1g73wNP1gdDHnQdNzSz40K26r9hC3oBGT4S11Rb0X1KFkDzFIDjRyYn6XEEqU8GUZhmqml738ESOmw4WL/Hjg2fBNGSIQUiCz67zKTW1J2oldgjRRc4NYvUYUhZ2AODHK3JGCqpMLYAT03oUxbso8Mi4Zv19Ls5MnABEhV0aUGMigxYrW7TaFa6VXrwJasPwg+21BpS4jKPQJpTFpblVOJZE24MHS8+nzjqjCKqMMYVMpBJUIamIYLcg0DTOJqvvp5xRYRnUILk5ObUNDDVXWAazUhVbgfMv45pOcpYRLS3nUwrn8YTBjU6c+zTxYdbgVnMng2JGcRZApcdXKIM6tvMFK8jwBRkcHI72D4cDTPiGYMPXS1LpTytXhwkoVnDYryACJOCn37fRnc6wYIZCGnusEbnM9UiTRB1kwU0sWW84dc2EIGDNGHn38liTvd3Rrp3aneH+btJBfzKlKc+5WSbrcLBtRiN0xVqI77ClJDYD88L4jtJUKlTXZTQqu6bssO4BKaLCn90VElFo0r472mkvltHOgzxa40Ebccrqu330E6/MrMY4YJE/7xpLobhUq9WNeNxUN6bZ99Ne0J84xaxqkmtyQL6vmPMfQeVO6rIo1B6y7yuQ+4TdFSx18TlBRLvV04CsejHsuLXf2etiayDg8dvoozsmmBor75iaAeq0M6gpBbXII4ER21wVOGez40rSAJeaLtyzk4utXmxeWfuoRbzbmTNpGe9OeP/jOHmQdGutwXHirTVLrDZcpCYyCq3VZk8HWaD5lFd0p7JAD1bDQuskpTXlnTIhTPi6le8/ejGEDuvJwystAvDa37MCIuv8D5z8iIrWvJ86Y7uRVBp7MN9EX30EETekhNYwI/F6Y7EohVPP0I8lb5hyqiStACoJKmnYToz5qGvOQZ/8+ikIk751H7Tomm3ixlid9qZKCFnpNqNyF6xruxyh2TTjN0xgyZK4V+dQKpQ0MpW5s0W8p0FNuFFU8WjhUO2wDFw8hJhp1JkXUNyfqRueAtBhaRALx3a2RMOgelhfL4vIt8TT33r25GITKa97xNxaXU45Ym5rmWZcEM1N6bT2W3A0Yb6hyKKoEyi9DrRU9VbsKZSFODWsuxIM9e2MaUPOzrEWu+7BvZbuxZEst1yxUKAmOlM/Iz4LisUhlF1ahruiygzDWzuycebvkqxwOj2+2GhvTMoXtaXVEbvQMmUfE7ewiYELGLAAGjcExsCMTKTdN5BH0QgmPJuSMTIYgynGoESMLbOtkc6lCN8rB5rUI2O/Wd1PqKrwaiZ0ueg4kfYPagxwEsQsr9YZpQn5B3IabhcEoH35wZGzcwf0jKuJanLL8twJuTAev/0q+Ky6/IvKexIjZd6nMyG1sSefj8U00keKVnt1mtdTLV8xqgRZWIWPmq4ahXaB5Hw2N9uBeX2eARh2h9J3OH/7H/rN7k//8frHvdf/uX0wP1P/OP8t3f3lb78P/lqbirA01uCS3TjxjfvT34tro+h0ytPkg3jnKzqyjFTW9uEHQT4E5nwg3/s7/Q+CkO/dpT7+zcVEliLDD7I00Se4zBQ0dy/d+U9xy+R7UgpY3B/EB/HznAmyoEVhNzOcGNrfgdhTzVk5Cym4kcpDLbI704ub7LgcqUQaQGFqAsh6lis3nN32HDZ78Bpo8mHDD3gjbloq8mHDjX4jeZBez2qpSMEUXzDDVIv+uG0/lIfprxHenNbQUY0fnYPDadrokQ8bYdLgU5i0DTdaP20RI5IPonLD1l5xfhx73kGvgSICXVDFmYN/5hrdtTGlUMMXMfcaWo63tMythCnUoFe4eI/QSYLeYXu41ppFMquRhM5rPbpN0dGXx6SKG/WtecdeRMRllVsbZdJGYcD227OLc02kipv8+/mbcDSHPN9ko+2dBV7WxMhUqluqMpZdfQ6q1dm5T+3E68rIWR/95NyphZJ37cDB4YtRMkyGSf32gVNB11stDyDhzv1h8QYN+WdekN/e3iaWhkSq2TbqaVZl0Nv+eOkjce0vkru5WeRblc1x4Y4VUF9yV3zQv6Xd5NOcz4Q70EABfsPMy1zeYp4B/OXSfkK7kJ6AKryPL+8aU7tkdZ3RQqzE4vudjG8CFI5gKo59oFnmTmCX8G9XvldHbnIq3MOxE7jaWxA6Jpha2HX291dHb3CF/dbnov8bfmEoRkxwTRzkWEKOcqseRkmJSI+/ZrfdJhz9xfC3u48H2iOaGqENVpeodFdLh2Yic3EgIANg0oJf/2AwSoa/ESZSWugydxq2tRgawV8Nc/cXxq575GeumJ5TdZ1sBYZ/LC7JDiBxo1vTjgGet6OTapFqrd29cuBRNII1ejzeOvMdB3NfHNK9w3lktNi684bREEUQDiwMA6l+znSo8Gv9pmsO50dIWviZT3mN7E4wq4cMni7jxiNYfYp5497tMHCqXzpMHP9jZQs7Y6fbyBnVQ269SF6DXr356rkXk5V9gpKH3SVgPfRIDuL6XzS1VnuI7grehK/PSg4ZrCEFwVO9DhZeuL0akNcqDQE9JJBMTLNIe/0/2E+8DQOmZMXhnC7tyV9mRY+YtOgRXtzs93m6KHqEmfT/Ze99m9u4lXzh9/spUDq3ruw85IjUP9uqOrWXluRYdWRZEeUkm2iLAmdAEqvhgAEwkphb97s/hW4Ag+FQMkVyHDlH2bOJRA2B7gam0d3o/nX0+vlJXsczgq8JXMbmN3/unpBPImEpOhh3IQiM29anRoqRkd0uSjCISE0Uixtkwscg0OcnTkN0SZ7f8zn6dzhBfRaJHQWethHxz+Fnj3VQCpKmZ9soQaSfehTFhtktOcb5hZwTSE4YuFguExeLVBpufEwow+zcr47YLJvxNgRgzjkEZ1TlxtcecMlnqrnGSTgoFJwCMIdlFTxPj3JUqaDRgsg8W1wARImBNtNFDjV5tpGTu6FRDXLH+uDkgcvOMy1zyM3xhatbEwn8wrgOldbZw0WM4z/cG2wMZDtsSFIwI2Q0pEKBA1AZ2ki1c/7JFw39R6F2/P4M7jAoVtE+cIVhzw1XtMAHhGa+hgqkjnwqvy+Uy9XGvaEK4/8ReQMXdlRMx5I8jsgnm1v0R85yHJgcX55CHzCRwRZy4c6JFACHXMSX/DC+baBkGHQRkGtpLDMnD2Vrhp9w78LC2pTlXEj3TlukXDIS6LMVdS5w0xEUc6C7bsQAkEte7RvthwsPQO/hEJBiZFz5wdQVGbmoJiFdLNmhclwKtxXHib3pmPXfZop33E0YlPAYr3y2hIcEIIIh4qAlZFElbxGFvUCil1KeJztnFRn+7Wt7Khx/n8U+FYa+Z3MtZOE7t9oqTFV7fqwv/GG1sGv74a4kfDzuEe4e0sFQ9lRcN1LJIFuyfFbYxron9gajQY5tWL84g44+/dYgHy8a5JQNzRPGiZwV6HneT3ncw2GYXlSwL53TXjqnvXROe+mc9tI57aVz2kvntJfOaS+d0xbDO5lpnFa2c4sbyDVGMpy/X3sowwcWvtdYhmuh8xLMWAWXpCLEv300o8ry9x7OcBx9z/GMEg9/m4CG4+obRjR4FotxmGG0XESjQGqhOOrMaWG1VSWaAVEMP+hXohlHn35bWJLLZRsW2YQFxN/8U7ymdpqlTppVCrykXjprzuyNNXbWXJ9ffFigfjy6lq5QAB6E5bEVMGEJkP9mqeDHgRoGCb2F2TAoUgX9baa/YaRYUM/SAioPq/yFHNKM/znrEp4MSCZCIBNIqmYsYUnY38nSlbKBJmw80XMcuXYPcnS7P5YW4qX3n/3D8+gM99L776X330vvv5fefy+9/76X3n8TKZI8XgTve9lAnp3hAYNmhkS1PdN3SDHJaVpvuY0LjNnJbNirbLrX1iNxVMbYLtymEcMbCsi0A2/L2Oplc16iPWX2M3NXKa6MpxhpOmEqmofC5wqt5HXx9l47ow8g+RIF/5nAf8AAgx9EmjIA7sPonPmpSGabgyZQCk4VONJBKfc6hfozDLzYhutOxzTTM+Htue/vWkjzWy04OwtcsnjElIZiCfiuyyqd/fyrXZhsyl9QriQZOtuuKsv3xyzN6nTcmGZ0iLmbsSb5xJ3xi0Ed8F69+q9zfuJVXp+ZF8PD0FIpp8SZJzQsRfYLftvuM03bi2rzOM2Vntv6rJY2e266p5KXy9o6ZV6cOuKM4J2o7RZZheZ6d8ll6IAsJ1y4yJ0UUeE6Fh7GN6YPvpfFvoUCIXoDdccpjauO003eZ020ABY2TQInva6zNJiC0MEA4aZsTBBhW14NhERvpqlZRjNd1XwEcqx1PpljnVvU00V5dprvW73Bbj6rmhD5sFChYaUMU7o5EUkzTuK7hc1Lz0+N/ohr3Lw4L7bSe1EmaqQ9PC3dG48WFpaxmtdGZkwz1SCZGNMEwhOpyJOByLNETqs7rvjK4/w9+Jr5g3zm86+gJoXjuFIAlkmjJ8AyROcpbEnqoYxiMZ7QzEXFhLQZKiWrciarI4RRUr4jxoilEwCYolLSDI2CAU+NUGEc6N3ogoCAIwnOVwYPukCiJ6Pg5ykY6n9BK95yOHP2UFgfOd86qBPuKBeOKxzX0mb1Xma3OGMeb6fy2eHje2zB+ZtzNoaxuHH5XcZ6XwK9Xwn0fsdR3u9bG6w5xPsdx3dfgrsvwd2FKpife2Q3xMmgQxae6OfBR48e5IX19/A5Dtae0jRFFHMsxHWzOvpOdIHjDtpztoU+DuW+VmR2oiIKjhXF/wxHhRxiP7QlBMe0NbHFWNg4XQYJy2TRUJaMR1yzWOeyLuVg16Q0VWV179/u9/bLKAD9nKdJzXG2zY59Z+auGqghQ8VslMVvi+J1drvCfxI0g/IAUUabcU26HztYAZBhQToDlDk3xBw0yMHu4A17+y5J9tv91ru3b/vtbcZarVb/3dt3+/tv99+8abfiZNEXPB6x+EbldZ1hh3b4irAch+CB3DLpWxZUMbLe9ne23yX03dt3O2xnt/XuXfwmeUuTvbj/Ln63W74+CSaviaOjcuUGgKmVtYCn/POEZR6EWYqhpGO410hpNswhmijsllKQvLolWcppP2VbbDDgMS+qz0lR+1/2FFGcPRWL2s7zkyyBpcmGZCTuQoahSYFfUVuNlysmm1Au0iDDVPRpWpELfjyPEbaI55tQPde8uzSKDwDB5tJXllzKY5ap2mygUxzeNl8rYp0hZe5lD/SEMZ2oMRKktmcCyBQtCRwxdN6lGJPu+dGvxE13ypVG8ODAtlCK91NW4OmpSXIPWHp2SLX1uqpnOhMaj5gfeDtq1egHzD0igimKnSPKBnh9febOqR4FMMxu3XhlQ4U923Ilt2Drbx2yNKVyayi22lF7O3o32ycb8NZrC7R/FGNDMkav/GTh1Ya3YMBO5aowSXjRr+bhlhMeY1cYXWY206LnjTFsFuD6Se0o3I4pNZ+uniPb2zvtb+YEuZBy1RaAxETrBzh7M9xi2MtwOmEN16FRj2j5EbyMKq4OIP7gQWEOiJyMGySZ3AwbpC/ZXYNk5oMhGzdIlsPH/0Nl9Z2Xk4VvVuq1xNyClmcJuyJvR+9C479s9x+Tj9DTehnL/xf098i5kNpsfXJ8z+Icf3x1fvzaN+95Vmb14fmX0jREUzlk2od3oQVaxcze313YSiyF12sp6Mig+BKmKWUyYOtMi6ibEKrhKZ4y6IpZDdQAXL8YaHIo5ETIMnTUV9is33r0rCZVM/KJnJ7TsEr6K5yZsWt2nzxrM/7RE9naj3aid/utVtR+s9veW5Q/Pp6MqKqt6WyBhw9OzBhg7xHQ/vzYNijsZI4K0mxCI114jAR0EfMXm/vtEgsGPBsyOZE806TPMwDZhotjQgeaSWjLbsSFvqiQtjlvLBLWDNu8Eovu6dxWha3hRBznUhrrHI1QBAyMR3C3BZD5WlLv9gL1GDH7Kr7+3d1dNOCSsSkDkP1+KoZbeiQZ1U3JsEnn1narvbvVam9pSeMbng2bY5oau6OJwmmaCXk2jEZ6nFYPpFa8/7a1E++yd9vbbfNDEtO9d/s7lCY7+0kyWHR3uH56PXgN6i5NM4JcRYN1zzsnZ5fR8a/Hi/JXb1KjZ2peZuMTmdvw+vnqvnPsTlv4efYCbuNx7gPeY1cp7AyA4KPHr5wXivy5KeZfGJvX2V8aQ4NSgP+3KG+l8bCbjhuO8GQr2IpBt2jf6xFuGa/d9BOeXBMx0CwjStOpcjFmnIpwrVg6IDTzq2u4mnBUM+ZB9LtdUwK4vEJyizjxYvbMsK6K4M2OlHRqQdlBSFQOAVFUNQzTUvs4O2QO9ZVIc81cP/BCFY4YYd5wC1TZJzo1yhdv9FEyEymM1QSF11zz21J599waKvDz+jzbUmq00SAbzdT8O1dMmv+2W5H5v/b+bBGVkVsPgBue5gDNRBZYNtT+KHJ7w4wNKQvT+S06i0PH1UE58Fbb48JwbH7r5/EN04RmNJ0qrojIyEjc+SHHxjzza0LujH/sX34tcI2CV4Z8glPDf2GM8g8amHIbXkKDQeVqwmMucuW7UlWX4Alma8J6ig8zCnHmhA+Z0j2aDoXkelRXzBNyWezBRvxk/sA39JTWbmbBitan2PpsmHM1Ip4L292B+h7HtlLQF7FBs3g/pKuINiobnpQA0GxVUdH5DWVTkFuVuhrR7b39JUXP7rn6Kop5X4iU0WyeTN/jn8Jez3xAaCGWsPFA5ZXVMmebS1JufuLZsMZmS2a7BFG9RfcJV/5R1yEJlWKAYDqmWT6g4BJiiWgR+MFs9LCHJiva+UqWslvbYqUzMbvqh89dQJ+o7otYjCMzJ4vuJ3EEOfPLilpTndd3tHztdidmUuM1BCNIynyROw2aCmx5EcvpRIuhpJMRj7FrvyrOqHDUW5ryJMRxMu65zJV28xlT+5aRPCuAb21XYPfV4isuybcY3w97RxXJM7jaYcmcXocXF58vel/OLi++dC+Pj3oXnz9fLrtkOcCv1AXT08XhSxYnZNygKlur8z/DmWZ0XPNLb6ZY55sP48F1GtSDwK1icVWNxntUvOjFEfy0F/74p4+//vb209vOz8uK1pxQmo4nCwj3ocueI/M+0SzB627f8MhvDjwUzIuAF/HaNibH07J6RGy3ttvNlvnfZXv7oN062Gn9tuyRAe/nQldZj5x4m10tzGmPDRIKHTHnvTfuPJ8BJuMJ+lLF1x/6nrPJjN8GBwdLEOpIj3hhR5TSVwCGqATPbcwMIVLXbcuYbiyd4u0yGiCo4Krm9CpnMyjFFcU837KAvGE+5JqmZRsDr6rNZhpSnildcjkgfjPFZnCZLkdQqmqdltbiKzr7qXIaj2mW9FK+EEbKHU+TmHoVtpa0vLKUP+Rp6qgihircKOAuGE1WKLvZXEvn4/lJra834+PhlqVpWjgbgfyhFrDihazgBYYuIGlCg0tJvOe36DKxdBB9g9uBTzQeGZGXbgisOjg+/fDA7cDb/ebiFwSGk/5Us56QSW11q++nxjJjf+RwmykGDxN/yrVOGTnOEk4XNgAMD/Ek79V4LXh4/qVU/fogAyeZ9pHdxQiXDLZqLzgwlzlXj++1xLoXzKRw2QO+h6Uhd1MV12AuP8Q6poVRoUGl9XOeakyUhrBgEkGWHM08LuaA3uABYkPD2KJBFD0iFuKf3UNMfgHOBynVmmUsmcf+qS0mxuFYQhhiymFtHB6LUF78FNpGcBsZ0X7dlaI/l29ezO7qBN7gezyeCvy0V533J6+XYQUQD2tiAu9uEVTxoffkKbSaXVoTqUdUU4J3wwGhdt4lSGWZltMQ/XhtKRJWqMUE65AsQm/WnZW50bpvb4DD7a5xPNGFPfkkslWP9nlN5H79fXOSP+VZfk8+d5eRfI1HlN0pj51ST6H0myu8Jd4+e5+7rrMDh1vT2eEaSC5AXMYCr7PSgAsuB9w1l60lwBcYYdFoPPKN2irrHsQfmFko6+cVRRU29GqvpACg1gyNuBsOqaM/JSrvN2c6zUKKZMaMVX8dchz9cP2019t/MR7x7eUUaAl2ZSaRmTfVH7mNpPRpn6dcTyFEL3k/D8Vm6VhqmeEIEJNFMm2eRH53RLNMZMQOT2KaxrYXbmHarUT4IKW1XQuajdi1exPeMphsOTprvP2vkBnWBT6NSoe03xODgWJ13exXCMbZViR5+RRPSNxciFDfiSAslHkavTWeoRVyzVDLUXnLpc5p2rN4p+u2CyuU2vkcvupqRNeR7PsgxUvshCGEg77R0QqT/dVHK3K8xNFqv/gEc2TZF8dKzgnMb6ql6K35JZ+h9emv+YhKlvRS3peQ+FcTqc4yxemInw6vkSBFgKuAoSfxoFk6qDH30g1P1HTcFzZF0bxWT/dKspWqi1yPjiBWTeDr8y6T2vvN1l5ze+ey9fagtXewsxu93dtZ/EIJ0UtqvH58GAlk3p0jm2mugFoMbyKhfS3ciPQDuBWsesNm4CoE4vOjigG5AxDnUl02FNxAXyNHSLO4v/vy5eSoQbpTNRaZS/IjP345OVJFvTb033XJujBzDqymU39Xir3KfLNWuCatcn0oMqVlHsMtGrW5c+nUDhdKDlCpYzE2VE0kjTWPobRvzDUfhtfy5ydHRLJcATz+HUtTKNcNLnGpk2bsd5gAREM+Zg1CYymUmgWZIa6tiJGeUHrOHVu8He/u7SXvBu/e7bzZW7g0tLhcWd8u/MaID52ZBMHyyxskCM5ILLzemZEJn9dc72kpfJdwc8U13laXM/mKNlywrTSTY9cEEdDEonkt2wtjgfbRVoAxPVpmMZl7yy0yGVQXhhm05h+4lJtTEtjeebPo1jEvYDRO9mpSX5+O9nCK6qRq5LEO1z1r92On/ci0RXJcDRNv7+0/MvVee5F4x5JT77W3H5xaJYwtksWx1NTdo+Pj82DqBfbddw1Ps+mONAwb+O93xZhBygyJbek81pLbrChJFB/zdF5B36z2mlBpVMhLAvfTErgXqcAoJPuS4v0tU7yt4F8yvf+yTO/5K/AdJXzPZ+Al77u+vO8HJP6S/v3s078fWLm/Txb4fAZfksHXlwz+gIT/bjnhD7D5khpeU2r4fHm/ZIh/TVwvieLfQaK4Xa2/T754wND3njYesPJdZo+H9P8bJ5EHYniuueQBiX+TlPIqR88+s7xK8nNPMK9S/D3kmVep/p7SzedQ/51mnVc5eebJ51WCn3sOekDxc01FD0h8yUhfVmLfW2L6PBa+p/z0efQ/4zT1eeQ+22z1ecR+H0nrj1L+fHPX55H9bFPY5xH7vWSyP0b7801oL1H9kte+nMS+h/T2eWQ/4yz3kNzvPNk9YOW7yXl3NH8/qe+e4pcM+JcM+L84A97txeeaCF9PrvtTBPOSDb+4tL5pUvwTyfp2afNPJ+wbJtY/nbhvmHr/VOKeW3K+Je4Z5uh/ozT8xWU0Yd/inr/urjEFM/8m/WMKhv++nWQKHv/uPWUKTl+6y7x0l1lkn/zt+8x4Tv8dO85U5TBcKDzxpGjwSeFVW36hGUtQUWcTf51n12dmfONFP9UQm8xSX0nWf1oXRt/WprIGu9u7208lrkLdOvpEQgGUldwmmcwntf1EUsFDXIDWJ4XXbMJrfQE20KVJtH4pX8LA5ORoHdvAUlmjKrXkhpefnmCcvdl6KtFcP+9QkXdxgNKZIIrZgfB5A6OH6PP40kKq/B4FmZHDIA3TVxke+CEHQetzSvpS3CkmiWIaFC/XlggXsLpjfexcC4ZFptMpEROWBQnvi65CPjGUP213l31cFossKWvbETUal2Ukn1R2S3tn+6m25Z2Qxm7pJVyyWAu5Rg9p/bvGbA5LMPEEz1YpzwplayTGbIumPGYLy+bv4fz++3i9f2t399/Az31xcMmLg/v4Bvnbe7b/9i7tc/RlPXHf3lP1U//Vfqgj5Dl5md6O/At9yBkanoOH6El6hv7fIyrg7+McOqn8da6fo+C5O3aLb4c1eH2OOsmGXGkrC9ur+yL87OFm3R+AXYLNtcHws6eeH8DsBPRbLDnI/uOtrKH4NMzcXrvp+9kVkcEs5E5yrZltBd6niu3vEpbFIjFWXPGqfRDSMyirDDaIyuORedu6TP9s7M3jeyjquWDDn3Imp/azRhmoAdp9qwnubFGkqkGhHqavXaeTnvnsOvLoImJirdt+rp2NEqBOMe3M7FsmXfUJoGoUmb4e08C87xfHP/ben5x1Lv4LOWeJM5krBuxvP73PO4etzs8/vb/sdDod+B3/+eeihg0sMZ40X4OjmkE3KC/kIUI2YEWzWUbzQuC4rpbKC+XcM0wVoS6Ret43Qf52LdxCR7D8imfDIMXNPu83A0xJXhlhdn9rgFCPfz3vnB31ur+9xnUPE6E8DVwX3pTImB3XTmlr5CEj0E4IG9WM/unL6eUJzAVju+HSlPQLKm+p5FCtmgKEHQ6b5WMmeQy8FjvXjHn0y+eLI9y4xz/2fjK/lUgPdlmwiTy2UsJiPqYpkczmkqOT94pFQ3K90d64npO2tfn7xuHBldT0SrKkp/Xkqs+zq/GUTiYRu2dPgPyDjVXNvF4P4pGmWUJlUl5vPC6ttnAYImqWQ9wSi3Ix4rd1MNDp9yW75bBe4Om4sJqZr3JcfPzX6adFCb5h0xro/chvWRNOF35rMyjFAMoWKsR2P3+4/KVzcXxVeGFOVZ9dXh2iZWKLQq9OxsZc+cBTRo4hBdNs0M8wqbq645kh1Oy7hd00qkc1sA+oK2bsEFTFLFXDDAdvKOjoeQt3tbJA/Gs+RzBXR6yfD4dB1eBXJBTSuU4RnQX+OgI32rO8skEWo7gwikCrlW2i4qOHTaLNAPtTMW2O6jGzqF0DGpuDmGpGJvxWYAa3FHmWEEomnAEOiqPP6DF3dgH+DTwAh0CIlGcDb8qYwAANlU3JJKXmSZ6ZE+b4sGuzcsllSIIdGkNahhKrC8YNojRExdzpJAYAzANToE1gz0YuA+Ol8BktbmFGrq0Uo2vPSccoyFgy7TPvjYROzl09GFMupuciilnCJCSQN4joKyZvmWy4NP5iR2ibgNwgccpZphvEPWrekoxpYyxHAyHvqExY0uOTiJwMyFTkhE4mzGIPnZw7va1FQT2fXDfgSUOSNuYCCg0kRsmQ37LMsKAlv+U0TacNkglj4RsT7G7E/DbnGiajELnsTwsk2GCqg/a77agVbUftPVc1tYjJXGOcuJOmeEZQNWIKt4HIjECk21jWskLMJ7f9GyArr0Vyhc4joOcV8rOjGtGOWDox20ZxndtoL0jPTLUpzVZQgBQ3omX0LojvFjivXJFXiIbGJBsI+IbZUEZlwqHnCVgcEQUKGGqUrxkfQQB8LNx8FFRzzBf8Mb5DXhzh83hkMPLhp6Mz1SCJGFOeIdRAA3xHZS0z+5HZzCmn6gn4A3yRRGb/UIVrq7dPzucyV44dqNqAqNz+BgywmUWAz+Ytgq9P+Iqs/HmS21Vyh4n7/ZGTxDxjX3YoPXKXKw7uDuI7ti4Hy4WyqdeRRDh50aFxkAwBttjTFS4RmjKpA24zgaA2wFjhOdlNBlMERVR2NLx7cX4AukwB4XYXHjgd7IhKxlzBnZoxmKVIzaGlzbGmGu5RQxi8BSdH3a2T827xhwGX7I6mqdnIrO+GDNBWggdymVqkONUgLEsQ/yZh2hb3GlWBR5hi5NXx0cVroiBI74u3mI6foIlprkeirr1qzB3zpg5pxv+0B56QZKJYnohsOnavFBIBrzT8ZDSpQPQtlpSUJ6yV21l+Z4AWL+3v0FXraiqbp0ImT/DLYqrZcK0Rt/JL7CawYrHGoB0qKDJltouPPY+cCLxMzJlUbA5XTzhfFB2t2XhinKmTwCI7ZfRmYW+19hv6S/DIK5fzsOx2uZ0c5jP5PhXxDZHsj5wpDZbfJO+nPCZHZ12sAvx4eXneJVvk8rQLOIwiFqla+Aipq5S0gzyeHKGa4spVSN5xPcLqdqJigZBGxtYdgtnobcwiHuPU49yN86QN024tnMWY8phlqq7Lk9BtsjNZSxttpcc1gxcNVugZF4cmjNBbytO5xYydCY1HjGxHC+fs1XpxxErXvMAnhPYcXOxi78Xp58N/9Y7Ouj3zEvQuT7uL8iYZXLjEdTG4eeEmIF8uTs3q0a+hrIdr7Vd37mng/2rEaIY3ljueqTZgitDWm5uKJCLOi9rs8mzgfpk3c3Oz2E+Z0MUuahhnIUSTpCTl2Q3wg3kbSGCKl08ogr7zQYpDzqKWgbFTjTy6xA+WRXf8hk9Ywmkk5HDL/La11PIaS6s2nJ2zmZ2rmG6QiUh5PG2gZYIWAV5mu1PXuFXwZj/p7Mfy3jEb9wvYtCLgZoOhvXOr8nsf0MpaVE55/kx0P8RphPRpEF5GcCSo4kxAJyk4DBC/4uvHQVlhVo+FdquF/7+o7OrNe7uEtxhT3raIZLdczZoOfWa4hr0DURDbtabKWvQVnnwiBUg4dJG6xSePOEkd+5xZZAdPQ5W9uYHAlPlbRqh3HmKRZXZ5Bt5QR5eHSDakEsKsioF7ohrB87j+fY4XrqhPB6m4g3s2mRQe0wchyeXhuR21YWHSHJlIW8z4bZGCwzOuOU1J97/OyITGN0y/Ug4d0g5qBixowUsc3Ive6JqdySrIdFqRx38UWsDJBTLtqB0cIo7WDyI01jliTChmuxjIMdnw420Y/QGnWjCsoyKbIVxhewP7Z+slWuVttLimPFXFYWFHRFIApz4bugUupgj5sKGRbmkC9J+BCztiAOkOTuj/5BluCrjIwiii/fa8wQrRZkJXhhyACjbLiOmMsy71IQ6/5Vgo35VhOIwmCVFsTDPNY7xWuoczlmaE3WOuY6Ok1LmCENogT81jt9ywy/9kxY2yYZRJTUsxNhcHlX6OgXGc3ZgZqlB3kGAg1F5hKs3TlDAMyyGOFEQGwKcOgrIgsAFPU6+b6GQixURyqplvX7GQc70wStlShhPsejz67ML4sHQJ/4qO+3yYi1ylU9zN8B2v5eH+VfnC9JQrbVbt5LxBqIvDQeg4z/g9UcLsk4iQ/yokS9M7OlUYiC8f2fTO0eT2/XVkP7hGkZVttMxYUcWVc5K7lhEQ4o745NqQch0hWdcNkrAJg2g+EdZmICILIozmOJ1J7KEqKmFILpPbY4F/cBxC01R4Km1AQ2RiLHJlVQHKvfjYE2g1hR3oVad79roCtQPZyDQeFZEmFCWmg7I5J/Ree//dLM9hGOY54icsnk30OeBkfkbdj0IMU0ZOTw9LUpiTpLNIEuiDqIzvIR0H4GECfFF4E+1GQMVcXaC3u+XACmznr1C2VA4AUoPjl2PUQyaimOtpXQ2XDrmezl+dTyLTktG0So7INM9YNg8PaC00Xd6JZorJRoDd4w8ylwsBs2+q+XSfdRY1eMvM1CTgs1InKztZlWgh9Yh0IHuGziEyz7Sc9rgSdcn8EKcgJ93PIPQKhYedB8mqa2takuau8iHNaFKVFGj4ihNTIWfIRC8ExS+jCopsyHWeoMmRUg2/VGPW/5dspCLbOCDNNzvRfnv37U6rQTZSqjcOyO5etNfae9d+S/7fZoXIGuNQm18Uk01nUszEaClx4mkQilETNCTFgAwlzfKUyrBnnR6xKYkBoM5YziW8OHv063Lci0s0CmOW4d0IlDykAlPD+kwWKF/OOi8OWSQvLUBzMTbaILHTUWHi3ZkAeErzIDoRYHObs3sMZ/yQCcdtNUDTF0qLrJnElbWZCKVpWtdbtnkOw6Nao0qJmJfz3DzJpTZeuTEVC+vWZlX4lJAxnfp41k0m7jLIRSSGFQRak+S3k3MS8ERga4NJeUvllNzxxFgycDzatxouBfHHqvze7bZ2Fw67GrFKNuQiq1OBXcAMj+mv5k+HD9FVkwazNM1VYD/lrM+q+89Y93+K2Q5d6zlWXVmIGd8H6r1GcNmaJ52zTvDcXOLtQbXVkUM4lunW+5xlQvU6XLLFr2kmX+Fy/i1/kdhToN2D/fTq5Px21+z2k/Pb/ddlO2pM4zre50+dw/nEzAS1M2ED1mit4pt28eGQvGntbgOyaD4cAkLzATk2ToSINdPklQ01NsjbZp8XNrixdV9j/0ZrGtmryDtBfs8nEyZjqth/kxG7py51FjrYKTLkty62GObPEUc+TozJzHlm+xDzTLMhkxHp5nHMlOK39kF0YRWbUOk6AFI/4mg6GbE52rfVarZazb1j+PdOc3untFIZ1dEKOSCbl5JmygZhoAAtDBr0qTkozjqXPhZnsSC59dKKw0+QieS3Rt0effrtdbCc5UMHVHcqaEL6NKVZDMdekCogJJEiN6fhjINr+JyIhQq9nlRQFQoAymqfrwgwmvUEX2+mtg6+vZRnN1P5V1mGFasOrdhDdUDIbHUMkyzpzfMp19y0nA9HTOlgUicjnLsBjEwmLPEk533nivol/1CUbzWCEgYYzkafjFWyMRAiss9FsRhvGCW1EX7wcGd0jNQC7itALrKYK2OV2LbmEPFK+Y0ta8R8AZUPBvzejwjPvBppPTnY2sJH8IlIyOHriFxiaqQWaE7d87G/nOpPieLjSTolmt4U64oRspQqDco1pX2WKrScMqEh5Q3RjQ33l6dHyp+jG7GI8puNqvoLpFHaFV7sde4GPwlseu8YDHLzNv+R0xThrYPEPpdtFRjqRZodprax+5hN0KGA3Cr4Gl79l7eK3e4RISeZsVCp1DwIn5MKBaA8bAtW8//27zYjy3sv4GbkqS0lj2lWxM9JeV81AgnYdgeqylCfpeJu/jaf/06U35tQtht3d3cRo0pH46kdATcGvhlU6Y2iQ/uJbTaLo4xoAYCNvGK5jpumsNk2VN7fjlTeb5devkZpExfklcCTrRSCMTYa+M5lgmhJeWpemQmTXMxpRWsYWNTe02LSAza+gdZjgwGD/sNmVrtRLPev2OXp0esGukzeXyrk7oWGqqPhrtdACZgt6/ZK8JJEVQU5O68fNqiENasE++D71oygFR9SisVKLKYe4fPSvskVk1G9WyaM0hWlrz6DN8hZIGLw0LFIM3J61Dk3KquDHB/5ocK9slnljo0pT2ti7ovhACYoN8gpEWC055rBPr7xfYNhc1MVxwCEmh5JH0v7TGpyzDOlmd1YJYnApeFftu0wb6T2fYdM1pYz83DnDZsXY9Nm4Hpty2Vrz9meSGeNgdNwJXCyKhF14iZZSYG2gdoTCL9JrOEpJdhhgRCqpYzQTGTTMf8zyMBGEfpfvyg2yFPzMlwDFzzB21n4xXB37U2AWGQDXKvZpL4smWNVGedv3qb6KoTLeraSXS2Ycvbt7jbbzb3mdru53dre3d59195+8/ZNc3v/3fbu9rvd1m5ze2ev/W5v/83b/Wa71WpVmVhfSPAb68HuyHifmUWqT8WQZ4+KikbsQR0oRVobXkLH1QHCVoaZ3K0ERB8tzQ8WDNzwPs1ojyZjnm00yIZkYHVnw54Z8KtVBGGOmEMsDJLE3EePFmUy9+1KypEO/4ZFExChKDKhg1bSd1SRWKQpiwGgx356CZ3S7MBQxjYVORnwLMHX0SuHVAyV1Qq+o46bG8p5Mevu+WTEdGzAFAvx4MLIpWZx5UkvSQWkN2sgB6AH5MvFSVHK4yKyr/jkdvcAg2CS/M4nt/v/Db++xlQZyTC3yA8L1eCvMHlGzesE82Y72t6PWtH2wd7uzsIItyy75VJkY7ZQF+6lZHpSJKFgPYqf0Yo53JFcEZlnWRmIxPqeAO7jHpR5BilBHs8nHFiRVxbhDO8PNB3ybNggP3UagdK6ZamYjKEMguk4et2o0Gc8HN8VCpMPjUKiDp7CUVX4ZUhdADmE1oT9HJseB5VbKogOGu6CyatLXLC08OpORmzMJE1rbGF27OaoHIDBG/OKDwDog91zZbbvzOvCE5KZEz1NpxbWULk2W5IBPJjCHmbXTsDGVEgEU8arq0rqLd0d7LVag5Iwajn753Rw83nDsI2LLXEymN3psRhPJFeBgSQGWNKeiYTZO+oSy4Ve8VsG1Cu4twlTcwRrv1JpvxYSY3F2xvSGKcI1mQileB8hr7w2Lxx2o9XNRh4zLXmMGh5gXGZ0fLkw3BwvEFaL85RKoNcPycZcQ/pbcaz6v50JbdMtOVawZwwT5RRjxRfsm1QiAyJ+oiT24v0PEjuxXhJNfqrJtfmetT+NOQq/GumDMULnhJqSnTdsj/UHrEXZfrz77s120mfvBq32m13a3t950++/3d59M9gv7ceabnZLnpvbbJgP++ipVa4SCnapfzPBGoLyf7tfaJqKO1x+3+k72Mxe6YFUZQ6VxD5qCDXmZV8Co5ouS9npWbgVKt6QzAdDwxMCP42pAg6OU6o0j23dfektcm5FGF/E65hcaZ/SSYLQ2XtGtZo3CAagrBKG3oMTj0nmHzULeV04QIgVMTAvBkawg86Nc0KYIR9N+7qVN5FIWK1ZO243Ub8lYMoZPRPsBH0nUBeFB2R4bGc22db/DV7ToBArROmDJBRIfkVwg0awCI51rxaLpJu+6zfpB7XHiafMAVm40RbbSzMqOSChuqNmCDDP4poHVTnljWr3YGRIMNOrOQaSOfSyzc3C+QKEYJvCB3cdwJyfrTFz9yGkI9KW/4dYxoW/J+CN5tkw52rkV614KeGVNucFySelo96ec0IZUknollvUNiuXDKxguN31KqFqW83dNYWCcbvnNWmiVvAytkyNaYalHIrNMRPcfM2W/add1tAqAI5Za/ogohHh+DO8loM7NSF7gYPoKgWffE7AF2cQsNHMneP9lewEf0IHbqzjJJjk2C3QyQAHEdKPQSWboW72DX1A9d45y+m6pFWvv6J1S8sxtw5tPSvycxlz3S2IL2b5us9pdbAWJBXihlBzJCF+BdNEZOl01hMPYN69dq9KYyfajnbDqEThl7qgRPHJIzEJfMpFDRyoR6UAiigGt7OoxVyNky0A2sK0jGheHMJsjKAiyWwMWi6SatisjrCsEYpfiubyfmmQqhIRYcFYgVQVMhVUXX2l3irMerFFVwVPD5UVBbPEIlM8gbtfIzNjIqU8YyHkJdbU/Ic/UrEgCW4esjLf6qEJnRjKwnR7PayfOwkiYuj3+rGdZxTTEJDBFpwBvUXhI8FgG0IpzX/OScFzicmlXtzPJ5YUVldZ+b5UV71UV71UV/2l1VX4Jjrg4ULZ/YUlVkjSS4nVS4nVS4nVS4nVS4nVS4nVS4nVS4nVS4nVoiVWaD89kxIrIOalxOrZlFjZ3fGV0iKjlSHSUBx/wlcdzS0vChB+iJYUYmnZ8NmXWz0ojmhFeTzDcqvFXb1vWHNl9QN5TjVXoQP6UnP1UnP1UnP1UnP1UnP1UnP1UnP1UnO1NiJeaq5eaq5eaq5eaq5eaq5eaq7m0PlMaq6glTZK2WY3XRafPJzdtGFbA5vXNKVK8cHUpaVDxQd2HqJxLBBuG0D9cS6i6b3IxHh6ZSm88kaRYfjTyeXFMelcXv7vw39Bc/2BpGMG7d2uskoClNEGht8SJcXAlg7M5/FeDpe+xRHGgE6Oug1y9uOHX2xhjMtYpSQW47HR0pbkqBga4svAUKRprHkc/QAU+W6AYRunER+OrDXsIfuFc+vMGMW4SNHVBh9PaKyvNl5HpalYPAJNEP0QiqEyKaSeFIPe8AzCG2Dc0ngEkPm+Zw7cT2lMtMN5GrBgcSzGk5QrvKwZCpoidcW4VxtBx6XMqE3joGFmnSF94z/IgrlJLJM8Ho2D1qqPvXAZBI/nvlZo6hteiwsnniXGZxZSEdH/HxZrZecrtQCBXoIl694hZgtsZeGH5CLbKgheuJ1awGPkSVqAW6R5Href4S9BPt8DXM/hdjWqo3+nrKVVhPTc05xWy2RaWjLO1KqtSc5GUYV51dMjdtUrprzqYfefq545L4YZS656/al9zGbty6velRcg9AC56nUvT34NxyEqpilTETm+n6D2xPapB1cZIT/AnX3X1bo0yJnIWKMY84qcirsG+cQSno8b5CMfjvBrrWa7hT91kjGXFFq7d81M5FW7uf8a/3Z0duI+3Gu+23t9lYVDkx/IL8fn7oGTsa9va5JD7BDwemN2aQ0Fqy1q/d3OjlwaBR6drvUPJhrHIkvyWBed4OyZOcvoyTm5j+D/IMncdn9JSMJSfsuk05KdbJgySY7/taKmhNBDVG/ubVBy7M0sT4G93iyFQMgrDo19MTTld44YEN/85HVFcJMRV6P/Mxu/X1owA56yiMINK1vEA0+K4pSy83gK1xPGaIZAOE+xYt0NPbe0ElpRpdA6krk+KDeMTYiWNL7BXWW+DuNGC7dBfIxTbQsG6yvmdy4FiKCYLxBAx39oQ/1FVkx/6nt0RuQjk2xzU0FhVdZk9yOaKwitOOsKNV1gLRnzSDLCnCqE/GqXtHNAoAv1LWtgDTCsS6OI+TQIy2I5nWiWFEF7Bp3KWYOMeJKwrEEkown+25xiDXvsN6Al+JxCjs3fN9yzxkHCp5/Q0vKRtYxFwnq+oVyU8CFTuud7FNe1wJe2GzIYer4hsitJMvRgCFyKGMKcXpQzAfWw1M1zAcV3xueB3cMVsdkQ/goG8vGLAMg0KHY0T0ooWLcx+QKlAGVTkFtdJzWi23v7618VrL3/ShCjL0TKaDZP3O/xT6FbxaFyyDcS5IrYhJSqh65lzmrYauYn4+TXh29gNlkQZlp0dzmPUotyxX3YtndMs3xAY8MIJs8QJQb6zugN7CoYBWMyVzysiGQpu7Uebmdi9uIPn7tQ4jXv/mQcmTlZdD+Jo4kU99MaVkFTndcJyvJ4O8eYSVthxAiSMn81BjneQaViOIRscqNixVDSyYjHhEkJnYNdNmY46i1NeRJmxwpJtMyVdvORU0ZvGcmzoO5r4PKs4KvFV1w+eDG+H9ac03kWj1h8M68e/Pji4vNF78vZ5cWX7uXxUe/i8+fLGlYzBze6rrzILg5fghuATFVUm7M8f+KxFOa9IIdCToSkTwJAWZhpzei4Zi1iplinKoHxhLS6wpZxOgViwWKiQnP4QZ+oQY5/+vjrb28/ve38XIPUzRmq6XiRjL+H7N0j6O2eJWjy3vnKQbel8Gwyb5br+YkwVXieV0+q7dZ2u9ky/7tsbx+0Wwc7rd9qOLlAFyxk6D9yJm92tZDO/Qv00RwdQ+JROV/lZ6OYira/D+km/B6kmfcZwRAoxKUaKPSw32cpkwRKjsPSTLCRhEgtegAFXwsQf1h8g9YTKtOq6VqT9QC6ecUVmG8W4RXukGualg0k41FDRhMdUp6pICo1YqTPM+N6QJy1hIEz93ShpWX6ytGxPhGWuuov5aB+MBY1jGPOcfAoF/VJoYgVt2jp+2vhzIy0Zse78DotiAHFEhkoZ5/vijsALBv2hWHsjkD3NJ8YCsj12Ex1bbF4uHkvmSLXwEUAe2C+0WeEJv8D+sZdqoGjYh5tEMWz2A8HSQZZQbfPpzMSWoeUExZAP6w/IGZRE7ANcpAGHLJR8bgSug7GnPdeF28+i6NAhXG+qb0qiMiJr8+2eZqoiWQY1cLqyob1b4vKoopctkZizLZoWqzXSvIxRPRw8lVFNPf1O5IQkWEWb+xBGV0WxVNc4THl7KHCvs/ILzxLxJ2aqeXAqEFRA+/rLfA4NDorlHws0nklaCteF4A8WTqIIIykGbiQNW27TzQeAbREMJV7m45PP8x/o+7f7jf3d9fEZH+qWU/IZPVt8wCL76fGcWR/5HCLIQYP83XKtU4ZOc4STtfhhBj24kneq+L8rI25w/MvJaifB3k7yTRL18WTPZx7SQVB6UlH6vG9lhTip6Cs/L2NL/IwM24qf7BG5ASJseG7wufRYCD1c55qxMAaT3hqNWbswDr7jAzoDVqqY5qCIwWsCKlWvPbwomH3EyEXutUfpFRrls2/2D+1gWccjiWEpQwwSzGODab5dMLWRvaI0YTJiPZ5r95u/DNASGa7doKY2Xs0keGaekBjRl513p+8XjOXkDlTE38fYQpMznnonVwTGwGQ89qNEWPFamrexYAHO+96uWCZltOwXn9tBXJ2KYoJal4PTFyp+f3Z3GjdtzcstDcf8oymnh+1Fgs+5Ej1aJ/XxMnXX3u3Xqc8y+/J5+6a16vGU9luvccO5jUx8c219XqVAB+v9bjE4eo/LpWtDV6A7oeT9zY7GaFwiR1mtGU2AQ7LaWg8Inay6kYqeS43bGojb4X3Yq/rLPqXYj4fMAQo60+JyvtNzKzyQ0JBX8aoHpHrkOPoh+u1aRk/Zjzi28tp/1JWXtkwHvGm+iO3wfI+7XNILfJIxiU0xjgsHF4bW+bkEZNFAgZP4qw7olkmMmKHJzFN4zy19QJFN8p6eBqkdFiX7WR2dtdudnijYbK1s1BjYUmFgzCtcG0MOBCdnhgMFKurs0OFF5ytPm4U/3ORdXkKRlyFB48/ZCZbOys1WhQVThBUd80M3HKpc5r2Fs/fe5JFXmHCzlcuVayBn+W31hLMrHdrDVevEljc0IDJ/mpDAzler6Fhx3yC3bbsS2qF6mTpt+K6WalZ18ywsVZtM6KSJb2U9yWVvLYEUecT4HTET1cuBSp4XRd7mqWDGotH3fBETcd9kWL9qHmF1+pgGmrrjGLAHbGfpUHYfZzmiUtWSBmFnxMxv1OMzwKByx/INCrBZ/iBFXnlErg1ldHwz9cNuBHyY3pUA8Ct9/rMXvUn5NXG8M+NBtz7bOAIG3O6c02CE2J5oQ+EvFlzVf7MMWD2yQ1cARc5d9gFbQaukAa38v718EL7nGFIphF0MvND+7QnuMqfnagyuh/UzkI69mYdUa3NslJtNoRdI5jG1ZXDkBZ+BS/oivn8wOYbChJcIEW2zMfZ5YduAzUB3IzTVAxFDrfzNCOdFNCyNMOAaFdLRsfkVeeo+9qlxzC35H5UBOXHR7F8211R/k+utPHWUpaQ/3XUuexE5DeRseikSBrDtlFjuLUstezoT92NJWQOQNq+TRtQJBF3WSpo4pDxS5VepJORzlEXLswdnlchdXtvLuT4gFwfHlxNqB5daXFlaAbnxb9LB0qMWc9v0muUwPXMp35kez+PF6qhueCSXMh18a2IXFcndI1f/JDBN837ElJR+dLsw8UDkDNX7I48TaH7XsM+aH6+bmBiRXjVCxsvQFYIXv9wEQOXfXlNMKwtU/Fc8jGVU4vIcHJEXv14cvT60fSJzXar1V6HJVaUxNbNV5h7OpendSU9mMM3Gid7NXH16WgPzvd1HOkwjhrRdk20dj922msntqiUqIHc7b39tRO8114kkLkkwXvt7TUTrBLG6nolu92j4+PztRHMswq28PoKCrMKFH2RWlRYmrYuu6Igt/f2d97urENFjvmY1Zkt8unk0zHeSrk0sjDj3OLXBoqTCOlMGTEohdlsmTAZaT1RB1tbd3d3EacZjYQcbmGhMXC4NWYJp024fQl/ju5Hepz+ftLxkP7GGBnwmNMU72r+u2GzvFxaSER+MXb/GFNEaQb2IDDDlSu86VtANj/mWChd9EQKWXeYNutYtvq25icA+R6U9qOINU2L7Tof9XSztb/bWsueXDFvdk7arM93NU6bSLA94hpI/UaQUXYdQo81cGWNi+NKl3zSaGV5nFu6HtdR3GW1pc+Bqw4TbIJ3J+dCJazLojLm9/oYWT9oxAfnL4QJ3I2Z3eDDEnMydkuhhyTIaX1axu7WejfQhH2LZNPD8y/lRFNN5ZBp78/PTzZdS6bpBEr4JzSrK3/7xCNr4zQVJ6ThWmRCkr7NUGxiecQMy3UVxU3YN0Ct8IKY6VCyhBzOadjGZSW+zX9rTs3yjN+Ws2aeyPR+tBO922+1ovab3fbeerjn40mdeIYdjEJbfm1ODwAWkvNjfKtJJyOWCtJsAtAzPEYCuoj5y0zn0QHPhkxOJM801k8BstGtUZQDzSSRDIVp2w+6zo+xSFgT+Cz0raSZ8vXFCjvrijjOpWRJw7avwzaiWKNjLU1JfQgTqEcsiHL1lrRmKdUlg3jAJWNT0Dxb/VQMtxCspGnsNqMHt7Zb7d2tVnsL4nk8GzZtWnIThdO08AeRsZXn9FqI99+2duJd9m57u21+SGK6925/h9JkZz9JBuvZOy7NsAevUI0mln9/VtGc3fPOydlldPzr8Xq4t4W2dbNsp1mF9Q1/agCQoI0Ww8+fJwwxoEgXYUHWIJunX6nPuQEygxg9AZ5lKTIfVO2gXYTRWTMoFLFtmF/nAEq393fersNeQMuk99zN0Us0oMAgNVaUmo5Tnt2s5bq5xjgELD44469wlydcAvq/pb+KxmQeWwNPeW2R9UsHH35yRF59gaC6JIrFueR6GuICvOrORNzRuaop7i6V7inGFrF5HnLgL/GmbbYKn2eapSkfIjac65QsofmIzcJVfDjSZRB5rgqorrnF+a1mu91s7V223xxs7x3svolarZUL9J9Jo83V6P+7dOZchMWa1mnZfp0rcvVsGnyuhY+63qEFO4KuxMOzbSG6Eld15oy89Bx9es/RlRbzpUnp2puUPizs59S6dB2M1KSbF+x1uhIH/07NUedLYYXGmIsg4gatVUtwuEQM/Ev5CBxuO9qOdqIVXeuUflN3BDI7n503MqbyhmfDSKd1pV5sXEo6GPCYnBqWybkUWsQihVRoY81bClRELlgsxmOY1QY2CZXsoIqv/fHk8hixuH+8OD4+s5Ddn94fX+CPF8dHFYztX0Zcr+i0u9vhHl0kBLeuLeNmDQEpvTz82fftd87T28g+UUtgI1nXWWAJLbG7u7MiixaQvqY347JsXxbsbyoXaa0uZyr/7OUyHdF8RQ0vGcAE14aPdeHGJ18uTknKsxvXjeZxjNVHN7O70LDZTwEg+pb/0lar1Wpv76x4OmD7TTkFZIsozDZau1vz2WEnwCyAqa1ZhmZwnyq2v0tYFovE6OlyIzaXhe2IJQKD6yJThSnRZRqs5uN7UDkXbPhTzuTUftYowypC5y81EVniUeFsYjt3vb+v00nPfObh4BQRE7ug/Vy7rRvgVWMgR7JY3DLpip8BF7Mo2vJog0alXRz/2Ht/cta5+C/k3B8Ic/Kdf3qfdw5bnZ9/en/Z6XQ68Dv+88917gCsvfsapvUdT5OYyvmVb4cu08joe7PK5oXAcR0igJfZuZcHGkxYMjfvm7A8dqk8ybA7FM+GaeHk2Of9XoEpySsj6+5vDZD58a/nnbOjXve317YOoVigggauiztSqFSBce2UFtkK28XihLCPzeifvpxensBcMLYbDkAD/Yi3VHLAeklZNtQjHDbLwdQFXouNbcY8+uXzxRHu6+Mfez+Z30qkB5sw2GPew3Q968v5a9BriVxvtDeu5xTYbP6+cXhwJTW9kizpaT256vPsajylk0nE7tnKMPflfVe9+FgPMrKmWUJlUt4OWH5pdY0v6ZgVAO6YNTE5KuAC18lfp9+X7BbjCXDGurQeM1/lWPn4r9NPa+Lnhk1rYOcjv2VNaMlozB+4YRMDqH6tNiD8/OHyl87F8VVxBeuOibPLq8NcSpZpi6VydTKmQ4Y3YMfQ88Hs/s8wqbq645kh1GzqNQmneoO5Ful8KOpmitw37Hlxw/CkgeNj3rJfrSwvr2LmyO3qiPXz4XDVQhwvwJCNui4jsErKWiGV7bUehlRMs4zJntJ0oXryh7wICNQbwjs/bx0fXVh8clcSnkMfGeww79vsjWnKYy5yFV7jAsr+l4vTqg+xIp/WzV+Fx7PcduHFNiAzJnK5kZJtxIS5n+BTAfQ119Wk3+0VQ5Y13shvXFqsqpJD6M9oNEMPp0Yqn4FzMBh4RrqXJ7+S7agVhRGDaljBdgkr2tk10Z+wH0vNBzTW+JvPmq6EIbDBcdPICB+FNk9NmiQSfzf7C3/ik9vd4A98crtvf50Zc0zj4Llxrtk9/mh8YfsTIvfjLw6DH3/LZXqVkXDIHyANoEljCF7jU3eo3ZpOqTRv2BT/cr/XetcMgL4rQRTPx2pbJ5dpze2tj7D5tOs+K9PCaty4u7uLWEqV5nEUi7D5/UlGlBgzElMFYQnjuI7plICrbMtwT87NebIlJIYncHuk0yLVns523be517YpE+L3QlFsEQPFTL+hcHb8NQ5xjVcaIYXIkCHMwmcoaMOvmaQpOTm/3fdjsixOhUUXuP79GktT//uavDo5vvxALj4c+kG33+xsv0aawgfjEQVAVOndAHet4ivSbT2SI7cISwHZFcu5LPnV91DtyABFKxwvbZ8o4ycvypw9CKI0blAAuA8bcBFYgcvq6A43WjFN+IBwjaXjqmE2cyY0YbdMTs0UWKA+8/2Zwd20Eya5SMg4Vwh+33dFJCyJyj3eC5MAHu4zsjHJhhtFszDAI4jMZ/8e+Ahm5w0kBSySujbeOeIIBArMphjD6/aP60CdaTHZmFnk639AWqIRxITKoojOEr0qlA0IIE/TBZifiYesP+fwZIBV818uThHgB5OVbb+Zqcih902hdafBxgFUiCJCwDNy7Vi7hgIYyN7WJQh3yWKRKS2x26U5BUpoQ9jgtwi5QBz/wShmWR8e7O7ubGEu9n/+8U/7Of7+Dy0mq6+ZU0/PYd02v2T+YsOrTdjmiigG9yGFPL0c56gXnvm2wmORcS0kz4aotbxV7M7xPjPq0W4XW9FJVbgBbOfJVAxtfof5qtHAA80yxMwIzVC8pqB6NNtHye8X3x7cf80PS5WDCneEYovGlGnIUsiErmqvpbaOGe2BP6++qyZUqUDBrR1MwQ7vlJg9WlfMKUbCF0qnfiyke24DMQFhgWa2It9YB61Pvmz7OmacOWMeJH53d2f992mGkz9yVlvbErDXYAL7AialpsX4FxuVnse4f4fN6s28KJUz9j/hjEWjLsS6CWeJzPlEy+Z6Jsx3QbvIIvwAx3VIe2RtfWzlRGG+fq79U41gMmS21HSTWHSjjLDxRBf0AOn45LX99kyRk+8fCslVfabvWNDcANp33Ql0kdZhSKAXyiRLevW6hNDBlQ9HDHS4mxTOHJy4AUKaTJjXNSrv459mrt1KtnEwFj4MoeaNgRDh9eQG1ImEHzzcI7bPIFolx5DnOJEs5oqlU4dXBX2AU36DCYqTvJ/ymKh8MOD3fkR45pU5LA62tvARfCIScvg6Ipdy6m5jJxMp7vmYwoHKFSAj8vEknRJNb8oZKtb8Nuuf0j5LbQ9jY2fCAXzHUux6eXl6pAo9GIsov5lTHrO2dtZmH6l4xOrLPO3C6A+rejiWZ/0fvOG/PphrjCO9DxzwaxCH27p1vk5+Eod7hom4GHD9I6cp2nf2GXD9rJMZJMamqRMJ4qOz+5hN0EoaCYtajMCcM6+b1RcRxG0oCJeX8MRmKYCiHtswD5Un/N3C/vtsYXCVjJEIM8c0y0Rh4JbezUYggSIcM8tQn6Xibr6qmK9XyronlC3GrqjS0XhqR8CXC7ULVXojmo0V2VFKPjfwine0bppgM6u8v202ULukwBolRVCQV2rEaKUQjLGBkTFzbmlJeVoEH+YoBKpWrLA2W1+LSQ8Y/AYHChsMbEqSMbNxC1m5vGKXp0evGxg084nIxYoUTiIo5oYDqQMVG2qK4PWZE5qZnbeIwRVPmvWDHfJ9nztw5jx05BQrsdjhA5+vvtkcBklNm+yLHX693s/9XutdRC2eJL/FQuraoW9tL+ZgXqsgX3U7Z68jvIPEpBRIw+9P5/atprkeCekyiMK2pJDBYGxkj1rp1FERameqQY7OuiTkmJBXtgAAHD1l8TThOqf4XjWx8ofAA1/REoYV4UrlTEZIfZ3F7W5JcCY8514dnkEhqCECkj8CwXqRV2RwbE+s7sfONvnIhyPSUSqXNIsZ6TJ5y+TK5V5l0UAJRu1iwZqPV4evoU6ikgDzpbs+lhKuNM+GOVcjltS56EfhRHbNj5ZZ88N/fuk2yOd/urU/yeIG+fzln2CbFAdOgxye/fOR/eFfvhr2CdRaFdVedW0UN43TY6evZ2X1yWwlo4F+5uxuffwJOaQZ/3NO+eDaeQynUuTV5xWUxEm2YmnOQyKgaS/PeF2XMfMkQVNiZjQC+bKERGZelfVJRWkKDUx7kIRbX3K3P9TNfHgNgvP5I/2yQbrgcJ1XXopD43YJmfEVi2iB8UzoHlyNLcDpgyUZfAxwOQi7M9uUHkNzqciGUI2eKZ6ACwcd3eek1Wy3mq03zfY+ae0ctPcOdt79f63WQWvFbBvPa58NxEKYaEszi7gJCzDaftdsvQVG2we7rYPtvbUxip5D74ZNezQdmndoNK5pJ3fc+D4AOmQZk+iWeDfnhlVf4ovuOk6sgNU4l7O5sGutNYHxg44TjLA0NQ/E9k8Fs8SLHZP8/WHNVfEnD2VaEU3GlZ7sbbfXKx92PxHZYpfuD+XSHdshimg3A9TDmaX2hQ0L8Lq/t7fzxq1FlrD7kEFCEhH3bNZZ+fP1yGRF5COI4vE/vX8Z7AA1oTHiIXFddX62W7tv18CJYpLTtFfqL7d2Hzrjf+SM4FSungzOS/8ezD+1IdgCelBplsXTIgLm6vwxdgL7ZDKitkqhQXjYjggv4lzVgQD3NDW2lPExfUt3P3SRdVWR+d7eh/fv3x2+OTp+/6H17m3r3VF7+/Cwsw4tpPgwozo3wqxZ3wb9JQZGvIHkPRGh9vmFIazemBlxqTAwhKbGQOQZZKL9KMgpzYbkUE4nWtgeO9OIdBnz1+ZDrkd5H+rFhiKl2XBrKLb6qehvDUU7au9uKRlvxTDAlhEM/Csain+c7uy8aZ7u7FVvRRG8vbme08AGSf6aGIDyQQBHxiyv2L4oGqaiT1NvwWZsxajtDOt/hY9fi4vvWHoOPv6smnPhOMQhecDJ717+szDWG+T0n12akQ/GfecqFkEQoGGcuwhc/m+xR56Nf1+SxxoZ/Ksd/IcUQGm5a+L3GXjzM+yvg8N/P8/cIg/Xa9wFTcHNpNbaquzbpRKXII2RJRHVYszjuu0hvP3DuYLCl6BnlaXHY0mx7JZLkY3DjFOWJVCqDQmENi8ScigrIunTpOnvkJcKQTn5wMPfRjx42WyPs4qs5kkpkIyX1hOk9EAHoKXkVBvc54yQejypQEoYPzS3vBvKfK+I+VwP3u1Qxvaab/dpu7lL99vNfovtN1txEu+0d5JWu78UGLiXhPGTv5UwzFyryoOnrM+obr6NWlGrud3abketvWh7p9lqtVrtpeIcThY11tHNiELbqjqYGV+WmGIgxGdaayjwQTqD3HhfMD/kt5CcbcU4Kyj/hx7mgMt8caTcgaRjZl7FmqQR1rja4kg/ZQHxnkvEbKeaGR/0T8TpiVOqFB+Ua1E0jTWPEceHxSMMM/iLegv0hDNFxkq0U9mxeFyG8rEZGB5iu+9qVgHrAeoTVYMwcx4gLoQmPBsy288GDnTJtBQOJCcsnKHDIbIHi1yNLHw6ubw4Jp3Ly/99+K/SmkALu4imnNaVHrBxaTS5meAVU95TwdZ52LFSMTBooPodas60zOHMd6WtYcEj7GqKUPnxDYqRli66bWGBR0M2fzPW3zS6yn4ZQY2S0OGQkv2Rc4Dln4oclilXjNCS0ADZHIn2vESV8snN38nGJzpkMZWa/AhP72+QxfEicDVqO0ZgKYqz40lrAIIPpbb6GhSCD8ddaA14UhX+j62Wj9MuKOkaPfWNWfCn73jHQ2ZaRd4fTs4WtpZQ3HUjUoHMZQmW6gnCL23ucNcvL/xiyDmbfjHZl9ipLoKLe1KtaXwTjbmWDLriwbfVFrwUW4suU+HgURUt7NY9dPdg4/I2IE9TAMHEYwqse1+db08/LF4oPvbCs62hHVJcp3v22vjzf+QsDcDuFWE0HnlzX2TlzsSzkmvvtfffLSWYMLKwZhWy/jq8zwGx81FGfxRimDJyerowMmwhjVhkA7MA9b3SMyZuMSGxTUaw/WNx1eOA9fAuIXypATwiGEDFNGUqusoeBJA4E5p08V7QvLBnImMNciruSgfWJ5bwfNyA7Cf8WqvZblnMymTMJYYYu2Y28qrd3H+Nfzs6O3Ef7jXf7b2uwmAen7sHTsauLyVpkkMmNeXZ64oyMBQ8fQ3rb9J1VKrScl4KBVRHh2hSLKA1qGeZOzkn9xH8XxkRJWEpv2XS+XedbJgySY7/tXBsr5AEJIRGNiG0fpftQfDcUmJqGRnT7xAxeAwZczLiavR/lshAmulgguWybBGD9KF0lM05LTnNArqh5xb9moOSpmnQCFeRG8Ymthc97B4o5IXmRAvb17PcaS15P68R+bEDEHhigGwX8wVMd/yHNoBb4JT3p2SSUj0QchyRj0yyzU2zazKRNdn9iOYKcqxTGxBGzRU4sNZyYfcTBEbiWVDrfoC9EW8Z1iRDCnTSKNBwGoRlcE/KkuJanAEkFmuQEU8SljWIZDTBf5tDqGFP6gaAS82pfdr8fcM9u9EgG/j0E/D0ZtYvFgnr+UvlKOHGW679ghsLDbFXWpA/4gIMhh53EJkt+nA5RnBrWFyNK9uY0/Z9VcSebr60BzCi/JBWZypjicKTEhLkbUWHL/UkKJvg3r2yNk8Lfz6+EqVK1YdgzPpCpIxm80T8Hv8EoPwI/kH4wJjuPoGAKwfMUE3b1zJna9pS5ieeDXu1ueSb6JL7lIlFdxFX/tFSV23bmRpO1jHN8gGNETsF/BaHJmVh1KJgTBZCYNhWaFqQzsTsuR8+dwGpZV6VzTgyc7LofhJHEynup2uSvKY6r08vPw5RPHOTDqTMX4FBjtVJqRgOIT0YUkuGkk5GPCZMSiFVEVwNR4Wky7BhiZDEeKLazUdOGb1lJM8KWFPuAfzgq8VXqvf/flhz1uZZPGLGfa0u4PHFxeeL3pezy4sv3cvjo97F58+Xa1pBvBitq01E1+Y3ZKU2S4lTg7N8rtTl9nFGNaPjmjWEmWKdagLGE9LqAazq9crBtoeMCq3gB32idjj+6eOvv7399Lbz85okbc4+TceLtDJ4yB49qkLzl7YOni/mrXF5wglCWcM5PBd9v91smf9dtrcP2q2DnScg73+FXfNuL2R8P3KWbna1kM71CvTLvJyheFSuWf7ZKBqqnW/1kK7B7zkEHoC4gc8TrLMr4Y+Xqomhhq8E3GzsGSFSC/BJ7UUHAfWFlg4qx6ppucZTH/TrilKfb8JAuS8fck3TsjFjPFioZKdDyrMAWc58A1oRTx1cc7kZcPWEoKWl+Yr6X01sxntdzTkEpF0Yx5y/2Gp2QX8Q0L1wK5a+vzQ35ttrdnQLj89o2zHTFDuMQcvn+a4v/k2FHf/dyqNrmE+gu8j12EzlMfrNO8cUuQYuCqBGi75OaPI/oEtc6zRwGPBeUUHykB0Ocp2zgu6wdejSkk3YLa8tQngEg1usMFRztrtKSHrF21m8wd8sM85DrosfNz7ClxWbgCtiI+gROfEJ6BZvAzWLDCNE2F6zYf3Jop9aRRZbIzFmWzQt1ujJMjET93DCVcUy99U6giIO2zD0EbmUsdlE2Ni5sLMz11ZjBvEJPfMisd/n7uMxZnRQKG3I8q9q1CWi6CBDlg4iCMloBi5bTdvrE41HPGMknMq9KcenH+a/Lfdv95v7CyNlz2GsP4XcyPpa7byfGkfNNqcw/DzIyynXOmXkOEs4XdYBMCzFk7xXY2rP4fkXHyl/dG1OMs0W7vg7jw97ePaCc22Z4+/4XkNdS4IKx99XeNw0M+Om8odgRE6QGBvyKnwMxEzs5zyF/D3bhx61XmwOJ4tSPKD2QnZMU3BcgBUhF+9HPkcc7H4i5EKQ9YOUas0y/+ncLF0cjiWEpQyy0TDGC2YxdoRZntQRowmTEe3znk3FrWkvBjm4bit2gjjTezRP4aZ1QGNGXnXen7xeA2eQSFUTTx9hCszVeugdW4F0s8PrMhCgHxAg4gd023lXp5xlWk7DfsRrw6O0Ii8mqEHuAmNO9b4Pmxut+/YGxP08tK3j4QmYiY9woXq0z2ui/uuvrluXU57l9+Rzdw3rUuNJabfVY4flCoR/c826+ouMCNlrO8JwuHqOMIUX2IvQmrEgFjYTzXetyywcFFzMZQR1AYKH0XhE7GTVTVLyAm7Y1LWXKZrXlTDAFbNDIyC561fenxKV95uYvOOHBGDDjFE9Itchx9EP1ytpCj9OPOLby2nqUoJX2fgc8ab6I7fB4L7r6JdwhWGBQIqWjnVsAjh6xGQRx/pJ3HRHNMtERuzwJKZpnKeoAL11uk4+BildqCHQMhrD7Nqu3cjwhsJkayG7xmzYCtVhJtpKRLt++z0xGChWV1FhhX6cbb0cPB3qwe39sHvp43S7yQAUYi3k13iyV6g3Q62F6FsudU7T3uK5Xk+ydCuE2/lcTtdaeVh+2yzBwOrbBjqUfKsDHyb7qw985Hj1A9+O8wSbadmXzgrSyc9vuXWQX7O+mCF9ZY0BYAc9xBrh9WOownTETxeAOXEV8LcKS5qlgxFVi7TGWIYVNzxR03FfQM+qEbySKztldTcD+1Dqq7VYP6+/RRusGUEPhLypE8Rks2P2ww1cNRZ5WHAxSpUSMS9aONPgxtdvfS+ozxmGKBqEEsnsrZ8b2qfIwDXx7ESV0f2gdhbSsTe46R2dKuy8ps0msOsC07iew7aDOsC442VRMZ8f2HxDQZIEpEeW+Ti7/NC1fa3hBpamYihyZXsSdiykMsPgX1dLRsfkVeeo+9qlWDC3zH5UIErho1j55K7LoM1RTNOUJeR/HXUuOxH5TWQsCqCbuCpAlnMVFAr3p74pvRY2Hdv1kSaJuMtSQUvtUnwBDulkpHPUhUva6cQYocGed3e1Qo4PyPXhwdWE6tGVFleGZnAW/PtzoMSY9fwmvUYJXM986ke2d8IBzIJ9pVzSBLkuvhWR6+qE17OtxoNvmvclpKLypdmHiwcgv6rYHUWLY3zQ/HzdwAv88NoRNh4QWG2VHyzi4g18Z97+YW2ZbOeSj6mc2sK2kyPy6seTo9ePXtNvtlut9rJWE8zzTXgJcxDn8rHK5TqAU4yTvZo4+XS0B2f0sseyw85o10Rf92OnvRYCi+z2Gkjc3ttfC5F77UWCeUsSudfeXgORKmGsrteq2z06Pj5fiUieFWhvay/eMmMXnf6caYjnamH92XLWijLb3tvfebuzrDob8zGrM+Pg08mnY7xFcalFYcYwxgxCJUeEdOaFGJRCUARrL0u9DDnNKBQmY70m+IJbY5Zw2oRbhPDn6H6kx+nvJ52zTmAgDHjMaYp3Dv9tmzv6NIOI/GLs7zGmBNIMbDRgxphTeOPVty1V/JhjobTHfS2xbtulLbsHx/VtwU9mB4arwDMiYg09ie22pGEgu9h9rf3d1tJ7b8XcyDmpkT6n0ThJtlPosvKu0Vk5mznarS3m4YQKd9Ghv2KOr03iqyyDc/2Wd8/EXVZbGhW4wDDBJnhQcm7F+CrWzIJNOf+yevkPzg4PE3AbM6vuXfw5mZglNz4J8haflom5tfpGmbBvkVB4eP6lnEyoqRwy7X3j+QmFS2cTTqCceUKzuvJv0V+lKbHTVIz7RgHhRLXLTmti6voMm+ssOpqwb1Cp75kPPl2S93NapBAtwav5b81pPJ7Z23IWxhMZ3Y92onf7rVbUfrPbXhg1r8oxH09qDMtudjAS63DyMEcEWmKT82N8S0knI5YK0mxCM0V4jAR0EfOXmY6yA54NmZxInmmsVQEwlluj7KCnPeB7T7i9cheSCIRgEwlrAp+FzpQ0U74eU5ERvWVExHEuATEHW1rcYSdCqJOwFp+kPrwH1GMtfLlSRlrzkOqSYTrgkrEpaJWtfiqGWwjK0DS2lNFrW9ut9u5Wq70FsS6eDZs23bSJwmnaUvDI2KzVmEwr3n/b2ol32bvt7bb5IYnp3rv9HUqTnf0kGSy/X1zqWQ9elRpNIP+erKIJu+edk7PL6PjX4+U5toWJdbNpp1mF3Q2v+QHKyUZM4efPE2aRa7oIf7CkPFZsDIG3HdAagmfovZUi0kHlBNotGJU0g0KR0Ib5dU7bxfb+zttlz3a0HHrP3US8RAMHjERj5ajpOOXZzdLXozX69LDI4Ni+wh2ccAlIkpbmKnKMeWxJPvLaIseXIzhsJASNv0DQWBaIa0Fd9KvuTEQZnZk1xpWl0j1VNExfqkET3hjNViHzTLM05UPEprKXWdCqyWONKj4c6XLrVK4C8ipsQrOqdrvZ2rtsvznY3jvYfRO1WksVKA+ZiGKup3XV8x86HP7q2SEyLRldosgFaBaZ5hnLdC+uRGXWQvjlnWjayrS4Eh70s2+q+cydLdxV5CG2alqPsxKugp2sSr6QekQ60BdniYpK5AQ6dfS4EnUt0aFtBnLS/QxrVO2GsfQqIO11vROW7rk755BmdJkqVkM3FExWPKsKzUMmemEpSDmmJ7Ih13mCeswY9+aXqg76v2QjFdnGAWm+2Yn227tvd1oNspFSvXFAdveivdbeu/Zb8v+WVEl15il8UUw2XbbXjEeM6PP2wl/bDBHbYH4oaZanVIboLnrEpiSmxv/oizy8/T90EWddRozlEq/wY2YMU4XdwAepENLmOjd8m6rEAez5QV0LAZ/viW1gGiT27U7KWSzFnTy6dcYxzLUYQy7GkAnHbdXi6wulRdZMFu79WV7AiVCapnW9+ZvnMDxq5tnsElg0x1cJ9SJnKgzU2WCWry0e06mvQvSd3ykxrMBEQpLfTs7DvkOE2A7UFtrtjicsnWKWhGtVpIX7sSrkd7ut3YUbL5YFLNmQi6xO9XoBMzymXZs/LVEWHRBfk361hM9Vrz/lrM+W3NPGqPtTZLVYGyPEbSBmfHc+F6rItcU/6Zx1gufmcmhP7a2OHIK1Qrfe5ywTqtfhMkgnX5hzvsitsH/oyaiYRY/3MiQmEQP/kj0CidmOtqOdaAnXNKXf1OSHzL9nYfGPqbzh2TDSaV3X/RuXkg4GPCanhk1yLoUWsUghDdZYz5aC+Ri9hEp2UAXN/XhyeYwAuz9eHB+fWRzeT++PL/DHi+OjCnAufGkJAdlryx5dJCa1rr3hZg2R67wM/EH1bbaI2aULsP5QEOrrrz1kVXrk7yVe+93dhVtDBWxZDOmatv1sawA/8aby8NUVRlL5Zy+X6YjmS6jmupH3L0qg+ynPbuCqVIR5t/OAFh/dqC5Kb9NpAjTjLf+lrVar1d7eWUKtG8tCGVsLwCjC9JW1+xGfXZE4zALguJplaIP2qWL7u4RlsUiMsi3M0A9C+lRbRywRGD0WmSrO+i7TYLIe34MKuWDDn3Imp/azRhl/LRbwDoks8RBTNnvZKByoNbhOJz3z2XXRJllM7CL2c+22aABCi9EPyWJxy6SrFgXQvKKixkOUGRV1cfxj7/3JWefiv5Bzr9TnJLX+9D7vHLY6P//0/rLT6XTgd/znn6uuOhZAfQ2o9o6nSUzl/FKkQ5fGYnS2WVno5qMRjxzLo72czr0M0KLBGqZ534QlscvjSYYdoXg2TAuvwj7v9wdMSV4Z+XZ/a4Ccj38975wd9bq/vbYJ5sWiFDRwXVzwQdkBjGuntLA6CtsOwoSwd83on76cXp7AXDC2Gw5Qx/yIt1RyAKxIWTbUIxzWdlMGXovNbMY8+uXzxRHu5eMfez+Z30qkBxsv2FfepUtYzMeVhCjyikVDcr3R3rieUy2x+fvG4cGV1PRKsqSn9eSqz7Or8ZROJhG7Z0thVJf3WjXKvx4YVE2zhMqkvAWwBs7qFJ+fP8s07pIVGBvx2X72a+Gp0+9LdouOOpyTLpfEzFc5Jj7+6/TTCjzcsGkNLHzkt6wJLWaMqQJXRWIApYbV/tKfP1z+0rk4viruDJ3aP7u8OsylZJm2QBFXJ2M6ZHitcwxg7GZnf4ZJ1dUdzwyhZsOuIJDq9dtaJPKhKHAokqkQgP6G4WkBR8C85b1aWUZeZcyR1dUR6+fD4TIVE15oIel1ReGxhMVaD5VttDwTKqZZxmRPabpQYe5D1jxEqw2xnZ+3jo8uLLiwq63NoWHDIE9T1/eOJWRMUx5zkavw3hGgsL9cnFZt+SV4s/7zKnydofdhVoGPAUk3NFvL3UhsNxNMFAR/xrWSqnCzvUQ8r8ar4o1LC6BTcsD8GYqm4eHUSOIzcAsHOs+wrc521Cr1u3qwpU7R3qmJdr39WGo+oLHG33z6bMW9x4a2TSMXfBR6pTRpkkj83ewj/IlPbneDP/DJ7b79dWbMMY2D58a5Zvf4o/E97U8Ir42/OKBs/C2X6VVGwiF/gLvqJo0hmotP3aG2ajqF0bxhU/zL/V7rXTNA7K0EJzwfT98uuUwjlFhdrs0RjO40VC7TwpLbuLu7i1hKleZxFIuNwBA+yYgSY0ZiqsD1N07jmE6x2ZmteTw5N2fClpAYAsAtkU6LPGtKSqwRl5Bru5wgcCdUIBaBQ0wdGwpnW1/jENcY1w8pRIYMYRZjwNCZcs0kTcnJ+e2+H5NlcSpsmfb179dYB/jf1+TVyfHlB3Lx4dAPuv1mZ/s10hQ+GI8oICdKb5q7uwVf8muLSxy5RbgHyK5Ys2XJL7dvai+3LnpOeAn7rA0/eVFH6pHWpHFHAoRs2HSL1GpfVkd3ILGKacIHhGuszVUNs4EzoQm7ZXJqpsAK4Jnvzwzupp0wyUVCxrlC5Oq+qxpgCbo+zHXZL451eLjPyMYkG24UHXegyDsyn/19i87NbhtIOgz6nK99s51jcXagqGxuKrxW/7gO1JYWk42Zhb3+B+S8GeYnVBZVUJboZXA9gOk8TRdgeCb+sP6EtpMBlh9/uThFhBPMbLVNHqYih4YThUadBhsEyusLj5xn5Nqxdg0VD5Dqq0u4zJLFIlNaYts3o+FLcCvYxLgIcUDs+8HoYFnXHezu7mxh4u5//vFP+zn+/g8tJsutk1M9z2GtNr9k/gLAq0TYzoooBvcGhQy97OaoDp75VpljkXEtJM+GqJG8NevO5T4zqs9uEVt6R1W46LYdWyqGNlHBfNVo14FmGQIOhKYkhvapHs02LPF7ZMzs9vNf88NS5TCCHaHYwyxlGq7eM6Grmmmp7WJGe+DPy+2kCVUqUF5rr0q3wxdNyuGoXCIxFYldKA/3sfDouQ18BMQEmtaKdmNZ+p58+fR14CtzTjxI8O7uznrulwz1f+Ssth4CYFvBBPaFSkodOPEvNpI7j1n/TppVmtn4lbPxP+FsRAMsBP4IZ4nMGUPL5nQmzHdBW8jC9YdjNqQ9srY49kmhMF8/1/6pRjAZMlvqPkcs1EtG2HiiC3qAdHzy2n57pqrFN8+DDKA+03csQCmHfjh3Al2YZQ0A9AaZZEmvXjcNWhby4YiBHnaTwrmBEzdAMJMJ87pD5X3808yVVMl2DcbChyFUuzEQIryu24CCgfCDh5si9hlEh+QYku4mksVcsXTqAHug2WXKbzBbbpL3Ux4TlQ8G/N6PCM+8Mgr/YGsLH8EnIiGHryNyKafudnIykeKejykcilwBpBsfT9Ip0fSmnHZhzWOz5ints9Q26jQ2IRyidyzFVnCXp0eq0HGxiPKbOXUSK/VmNXtHxSNWX+pjF0Z/WHXDcTrrk+DN9vXBXGMZ6X3gYF5SBG6L1vna+EkcwBNmf2Ig84+cpmiL2WcybAkPujDIxkxTJwYET2b3MZugRTMSFg4VkQNnXiurFyKImVAQKC8BJ81SANUdttMUKkb4u8X79imq4L4Ygw5mjmmWicIYLb2DjUACRShklqE+S8XdfJUwX3+UdUwoW4wbUaWj/5+9L11uI0ca/P89BUIdsZZmyRJJiTq84Z2QSblbO/LxmXL37PZOUGAVSGJUBKoLKMnsX/sa+3r7JBtIHIU6KBUlUZLt7pjwiGQVgDyQyEzksViaEfQm0lIEC7kVlP00ZpSC7Quw6jtLO43HwCKb9BQDdQuCqlXY8PnyCl3LDBa8Mba0V0qdSTLFNM6dADUbH4t7pMUqdpc8GQNQT3BYkOnUhNkoNVizjcHFNrk4H+60tJPKRb/mVMgNNxC6LVuBC8SnLxG8LVPjFinPm/u88icVzYArvu0zBc6TVcdJTolmBwt8fz8GswUfNsRYX8zwD7dOvvY7xwE2RfHotc5+3XhtTtNw1JvXCL/t0cmHnUDf1ekADNu+u64hK87knKc2Qsbv1Qc390q3daX3rKjJXdhEtNDwwwj5ECO0baLLwRATpihgoY08qV48v/qbZwnfQ4MFKlAhMpIGesWbzEK2ZNAz6XNre/ABsv3UIiDQwUOmQ3MF7lNzAo1+OemhX+hsjk6EyFLMQoJGJL0m6b3ygYrogDj+jaNCJw5sD3Yg8L4S4PFl9DAwvA7lJNokcYf+RIa2w/vQdvDmy6iFPr6xND5jYQt9/PIGdIr80GihwYc3t/CB21iPxA+QjJOnA22KIew0Vi6d75Tx816xjJIov1Jy8zCYeDrDjP5Zk0f26HD5Uwm0/fEBm/6M3SOnYxXYOB5njG7q0qIOehwjNaNCwpd7YKG0DR6GCSExdPUbQ0Do5oKL3QGs5tPXBXo+d/xetNAIDJ9PFYYfKPOHp4zeI1MSgGVcjuGqqAF0K0P86QLqjuj6JeXuydrlFXM2gxRiJmgE5hO0Ia4JG+l12p3DdvcAdfZed/uv947/a6fzunOPaBIH34RMeaMCUfcGUCeyNwCue9zuHAFw3df7nde9/oOA05r6+IosxzieqT0xX2yIS0/s+M6BaPuH+2bFFaluys+j+54uHnhhlpZjMR81XwHG98rRE0TiWD0Qmp9yAJFDtQ4gd4cpFflPru5iBR2MCpn0e92H44R8TThrdqm8Kvbr1AyRe4UJlHQrkdQFyjeA76Df3zu0+GcR+eoDhVDEw7GJmCp+f388PLBUDHjB6J/ObvMoLRIc6gIyVFYNjF5n/+ieqxckpTgeF5o6Pbo9yugfGUF6KptjBGeb4/H6UxWcFSDLhCQsXOZeI5uQrX0PwA/JHJtI9xaifo8RfRllI9c5mH2x0m+U7eZ6D7uh8yihCp77/Xdv3x4PDoenb991jo86x8NubzA4ua9UcY3pNy4zvYLzU4VSD9t5d3xPmvxGdC2xBVEoEr4zRasCU54xiJb6maNzzGZokC4TyU1DjWWARoS4q+AZlfNsAvlEMx5jNtud8d1JzCe7M94Nuvu7Ig13QxhgVyEG/glm/Kfzvb3D9vlev3obqCtBt+8v0Y2T4XnsaeEMaruMMny6P0kwi/kEx06TZOQeHs0SuM9hLz+auWzBeAn2cllsWbeVLgCxwmAeXbzJFeUWOn8zwgy9U6YwFSH3DOqWMqACMJ83xQsvxlYu4OCBQD23sbxqQxfI+ogwvgDLuATyfaH6MaxcUwJ1swqX1+1WTWo0oApPNg6osYH4AY4p3pT7f0upvzDBNhFOwtqpTWcdQYB2kPAF4doyzQBUm/3h5wdA+B3WZUtD08IeF/zaJobPVbhTvynmXgb/m/02h1BfLv0hU/JHRqFE6pJncOeVCYIwen928fkUnVxc/JfBP6D6pFcCx4HggRtUsg9e/Y62/tk+mREmt1DzLEhHmo3VBAS60KhCkELHNJODA5V1IDEHTcgcX1Oe+thz1y0LHpGYGNWygjwf+fUY9wetQb5bI42qiB51+v3e2ujdoI6xVS5T8E2hGG6VK0g+id5RFq2N5STGUgmrjcoYN8nT4tvP1PrVz9Q6+W1USZU6+TOzeU/wJzoZmlorg0/6D2jIZjKpcPhxpP/8oCON4YM/5MfplIYE7R309XMjjM0btmQuu5MjLBv449ZxhMNvvZAzE95LyG26vAbwR1qosbEej/i4sezy8D1ZYLjVZ45bYwGCKhWsXY6lxOFVsKAyJdAyxg6wCzJyd23ybDRrcm7u7ZXqtuaGdRTwEbnWhtWOQr1j3uuy5PrDBedxYfcy1HAb1VIO2qhWCKYmaUoMqTSh8GnUAZiqjggB+p+3AlzQ0mCUViHcCG2Tr8FqPtWviN2Lk06n09tFO1WMwS91iNnkQe4nkVtebYwkHycVBnk4kqo4Kubsl9D0xJI2S+OXhCx/+Crimo5SxCsJ5+AHf5qtaWd78O60A62HTvuW2L3odvrHNdwH36/A0OPu0UfJDbtF8t6qzq9NhxXa1cboMOCLBTTSZREaaSjYTPdoSFJir+OrNHomAdEYn3fYLxvDZ/N3VyBWZJOnkhUQmK4Fhj/rQ+WvP9bD0NvpdFeJjqDTaXxzvQK5L1DMrJYkaxLodlNtwwT6xG9IOpqTuLnWWk+h5xEyjVHto3eVZr9hVK/3/u3kcMSItf9FUgnb7Vxf10EL5tdIa9Wl7ieK6Z1XVnKE1VvKDmO6XijkAQpTh0KgKQ8zgbj2vtrxEUpsXVoqBYmncCZRKKkG9w7xEuFrTiOBKGtHJIF0QxwvBRV5qLtewteg3zk2o/qXdNA1VjvCTeV917va37kWEyFN5hvz0o90Xqi5ILAlNfSUmu2iLHVf69JbPkorYvF8ND4dDH85HX8enYx/O7v4ZXxyOhp3e0fjwdvBWF+ZN92QYUwJk0E1rv7RU6lP37dtaUohMYvaOOaseLXKIUE0DxbRa6vEPGUiAyZZZBL+aEOurNA1bNFlFaRxOIeiNAKuf/KAEjcopN7o5FV9V4AlZKhUW6ecnQVB4xuwVSvZEIpPoFYknxZw7U1uKoct8BVBWVK+2HbIgCXeRot70SCvsWOpgKUJ68lDeHTlFYhs9MMdtfyAdVWTLn7f0kTZaiH7V3OPo1nnhlvCDwqSKe/tp11SCrb3wz6K6IzoK8vh6WdHv2LLRgRhF3dvmVJAlc7M0l0CvX5+fnaeC7KqC6jS5VVdDFWhn3jeme/d4cHg8F1v0O+/fTc8HB6dHr09erf/9t3bd53B8WnjhgU+TTbYB/9uokCn/G+dKsene8d7w+O97t7R0dHRsHd01Ds4GPSGx91+r7s/7A67g8Hp217j+KoSdfKj5lno0+sf1FPI4dDrRfpwCuWjako9zr45ODp8d3BwcNLp75++6x6edI5Oe+963YPe6cnb/cHbQWfYO+ifdoeHR4f9t6eH+2/f7Q0Ou73ByXFvePKucSi3gVEnG2yIaDVxVF6mny3PDiuwn0CFqz2ICpUSPSpVXBt56tFnziUanECK0hmbplhXRcpSgi4IXrTQcPDGZcUOB2/WyNkwk/8b723q+NZCQBcTygv563kFFDaPlC4914nhS5SQVLGaYrHR6Hw3168RmmMWiTm+qpZ5ivZJf9I9ig4m/X542O0d9o6O93q9bnh8MMG95j1xDDoeI5tjiCXZhYwHT0eGSmx6kibJHf7OrMmDeNXr9LrtjvrfBeQ/vO501uvR4MH74OyOdQEuJ3vcBWz3+LDzGMBCMah0k3GXJ0rxDnEcK2HJ0OjDmZGpksSxMEE7kDGoM2HmXEiQKpLrb7yz0soHCBOXkiy0i1PfEypjCkkeoN90hb9CTPk1pjGeQFt6WS6JPiMK8wnV9u5lRJSA0x2uTPHI+qSwtatFWpxrWfmc8rkikXNJ7NByp0ReLPVvIIqHPMwWrnD8I0likSW6qc9Y29KbCiZxZpWZpl53KBjx+ps5iWNeZ7CssOB7/YPxz4P3yoLfO9pX9kz+4OlgeNujji5b97J//sr/f778f58EP3ryfy0uvrHM/xoYXkIawzeW9l+DxReTx3CvnP8agJ47h2HjCf93wPwCchqeJNu/Bg3faRKED+l3l+dfBu77SfL3IfveMvxXwPbjpvevQMiPldu/AgnfQmK/v/S/svqfMKu/gPi/UvqfLqW/gPjvPJ+/HtZvK5m/DoaXYAJ/O5n8dRh8MebvvdL46yB6bvv3UXP47wLwBRi76ybw14H0Axiu32Tq/ibtmRUBjLmFY9vJzug1YeaapKUvNHGSxDTEk7h6Ey1ImPT6B2ljy4UIiScxCPYGkE44jwlmdQC91T+haYwLYJky7xfnI8TIjEuq76tusPDabSrF06lUMsVMQEN2Ew/LEGGgD6nPGWMkbrzdGPkqxzY09klJ6eJxJwS+gnWTKECfTP18bWMhWmzXcXby4SRvk7ztdwSimGEIT8ZCaakLwqTYlbFouwZqCoa2HnflD8HXuVzEP+E4YW27xjaNxE4pRMp0XsmNhpjfkBRaidS2udrtBo2ZLiUiW2yU4agoBVEDw5l5of2Lg1ax11et4JS5tDGb6fv0lxnxa9a2bsRvFaTnivhdtZINoXiTEb8+Le5Fg5cZ8WvW+d1E/FoyfcsRvz5Nvo+I3+ekymNH/Jao851E/DakUD7qNxjxa2DcaMTvaK3Y3kpMb35G6LVWTLknie01k/8b720siKw+uFdP/GjBvXvH+/v7XTw56B/290mv1zmcdEl3st8/nOwd7HebF2rS+HisK1wh8SKpxLqawM6XENzrwfsot7rrAPzkwb0G2M0Gmo4ah5SWBHKNAKgEHW1MAPwVB/l8cZA+CX70OMhaXHxjcZA1MLyES6BvLA6yBosv5iLoXnGQNQA99z3QxuMg74D5BVwNPUkcZA0avtPrJB/S7y4Osgzc9xMH6UP2vcVBroDtx42DXIGQHysOcgUSvoU4SH/pf8VBPmEcZAHxf8VBPl0cZAHx33kcZD2s31YcZB0ML8EE/nbiIOsw+GLM33vFQdZB9Nz276PGQd4F4AswdteNg6wD6QcwXL/JOMjiNf1jr/aDVs1QglN3tWGvmxOcChOvBd/zlM6oYj4dnVZzkRP0GjvHLS02HB74QWE/pn+SSIfQwRW2iw6EQ8QH8y4QbYHRlQA6tkswszWQ62CqQrQCngI0r4zKTnPV0Xb5SDADPdo2hgq5ruKvxIRMcUiC/zArP9EPp8RcWMH9Pk+UeQ6henoQrCNBMcTvtZDIwjmEAkBrCCKkjg2FsAIzrtppNCSwczGKsMQThew/MpIuA80XOfdPp8f46PioOzkMw6iP/6MBSjUUT4jTMtrgs667KnTR5CQmiFwDDmN6RXyUmUC1CVEmJZJ8RhSqtOlkr/TMyFiZ1alD7ByzKNYmmJuEMknStgmoJJHFtSjjdX8yPe5N9/qHh5O9/Qgf4L2QHPeOow7pkP3DvYMiOu1anxipdtrG/Oq/Q3UNpTmdzRWyYMnqvRueXqEFwSJLjUUJTOyY0jCwQ7nPxvaQKCGz05l2Dg4x7kzwcac3OfSQl6VaYJlCw18+n8PH1YWGv3w+tyWE4byLlJIK1X608cfVlOY8xKlUBvmXz+dCX0+aJ+3iFfyTlOArymYo4jdMsQdHIpyTBWkhXcSphRIs5+Z9jmw4bZPawXqADQnkV0MY3bJDlsa5cNkq1pnaciyA0BlDgi8IREArKaTwucBLXQLbxKmffVLQ7ioUKrxGNCWhjJct51/ARdC03RyoscFpocZu6Thwd4mMbsBdMeNqDvXTpamRpTHnr1ADpBZm7qLVOmMqSYpjdPbp+sCNSVgYc+NAvPz9Emh0+a9LtH12evEOfX43cIP2Dvd6O3pN/oO5L8T6UyD6d6Lwk0jYAWZf2eW6EfWyX5UPtpoKXy5Jwcaxb4ojoKC/WlaOOB1Eq6SrnbxGDTFb2IEGvAQxvJENr4sJjvQukR6pLqqjU4EgjEAQiaiSQiaUuqX4knGpxHy6hDrrczgGi++XBrfTJiSlPEKLTEgYZKIkuVofiYonQZ6ToB+eELSVsJlXBku9vhWo77y5PnBpopBvdBE4AxfoNWqd+SllVyrQtjVbJU6D2Z87LYDcjQlow0pHZ35AoGOs7a3Zn1stvR49wtZOlZ8S452yTDRN8WzRzAl9Lx76xFNptG4jVhBcUelN8NOlJ2QkT7ZK9Lr86VLfOcmCImwXbcBzsGRxE3XVBpmYL5+4mcvZVDfJUKcItBKlCyUVMYMjb8kzqNSey7ylR2shuR+2RRm6zNI4UONdQhYUBJOCzNT7lgpwTTIdvkQibdaBdmkFEahJbkjBszSsT2WxCTe5NHq9v7+3KwhOw/nf/3hjvteff5I8KdDGCocXT59XX9iCR0o1inKJBmwrkCCEFfDm8FWz8ylDTPdORAvOqOTKcNEChU9AsYncaTkhSnIZtgBKpgQLn9AYksJQzGei5c4z6FIgCUP/VrLJGQ4mOBgUjcKG8vliQQzLudfcsFgoOXuDhVtoq6AIMS6rguVeLKJGW/FzgXsSLIQnex49f8gMn/d8gAMsKK1Bztfn3tI8cl6aw5N/BhFbpWl5uuYNoXZsvDamcu06eC5LK+vY36/eIOzv7xUWBbbjJtUOmMAwq/51QrT2oX8xeXh1MDh+VzgtMVXlfPk7nC9aN/FdKv4sgZLZuKhAMq7ehZ2Y5ldhOjzCW3tgtM9U37nBfJNMuqda3mQaWK3duBEhRwAzRBaJzNcDS9dPXpq3Q8yUFHH3wBRyEJikWBI0IfKGkGJKpbzhWmkvHaI6y5KkJBpv1t648KzFfFIQtdZSUvAmCck7RWcT/ZNHxoq25o2lHwZDbmvKuR9JtKUIsuV/UZaUWuszeI2IJOmCMhKp8zOkgsQmgQNDMp9xM+Q30CKbTulXNyI8A3mrr3d39SP6iYCns50AXaRLU0EYJ0nKv9KFjsmgQtkigi6SeIkkWJZVhVCRMsYTEgslfWJQl+DcuSFxDNBfnA9FLmhCHmRXW1URXg60cj4zMGA3xQcjGH21WISDpaxc65v/y9e16qFe74ojqgiZZahNMrmbBGS5UYb1cb9Ef2Q41sqGeYbpLvIgkHI5gOPYQqe98eRrSBJ9ZM+5smLUaxmLjGZd2cUBmOrYOjE8u6K8AvATmvx0LZ3g91B7IZ1fR9pubzBziBnjubJV2DEtDwO5BV4GaEJinZBS3cD1u70oEXzcancFFjJYLM0ImuX1nsdCbgVl94AZpWCbAazC3OM4mWT5UmSTXiCySbcgVlqF7ZkvT0t3o8rbmPh8jC3tDFEHg0wxjXMjtWabYtH4WlPyZAxgPIEwJ9MpCSGnQGl2mlEM9Nvk4ny409LekCvGb5hCYY733P4Aodiy3kQQb/7W9jZJjaFenjd3rnhd0kK+AD74tmU+yPtV4j6nRDPBD98X+CYTJN1gyMAXM3yNwu2vQHtGjSvXfl7tywUuBJe98ehazRFRppViJSDwhGdacMKj2laDVnPkGjtT2HgVwcpzXGK60in+mONrAp4YAiEcPPVcOkymlAijNsIkIFZ4CpYhg9doZCWFdTtjhjAk2RvrUZ8AnqBcGMI1ajM3x2xGRLDZXe93p9ZeXZ4uc9SCyrsgEL7Gp6t0NszQ+fDkk0LhiWbaoRvK3+7Ny5wb2CF5aIMMXMxOal7ryCxPHZ6PHJbz+A1DFZyvRH7Qt5Rm4LpYVOzEk3hCUolOKROSULYuSoCnn41nYfbnZlqNgo016a1e/7mKSgC9aaAplkKSxW4SY6kE59q8raHY4AHiU1FPtu4SvZT7R+exL67Rqym+AJ1hUt1KtHAYTeFOXktLhjDjbLmgf3o+Xo1+9/GLINMsVpvwUr0U0OhS8aD+oAC8dMplyNlU0xnHxQOQRTX6eiZItD67lhk1zPMzHpNJ7e2BqEnbHbW77X671233Or393v5xt3d4dNjuHRz39nvH+539dm+v3z3uHxweHbS7nTVKVRsQq1x8XyAfXzyP5jw1th9PUcxn3gVuHa5wQO4pmlMebyw92dUW0uEWaiaEtYomab7PjS5WAunV71tXdIIZHuNoQdlWC22lBIxBNhurAdeo2PPdaUvuqtgaBD+kQphD/0JVwnyBfymFNUj5gdXCMhK+VcWwDMeLVA3zRf6lHD5EOczx+B2rhzmQP7aCmOPhh1ARn0OD8OObXqJy0Dy45hE0B7u671UpKML3Is/74hKf/ii38/91Sq88pS2KvtUD2FUqf1lna3NJ98CD10Xj/AhnqsTpjMgf0jVhQH+hfgmzupeqdzyDU8Jg5HtVPtbFwItUT9YF4kX6IswK/1JxHuKIMEj8VpWg5hC+MDXpiV0QBgnfsa7kB0WN8cxm7HihUSj/tkGAlB7DhkkxyMWHWr0LomPgMZqk/MbLfna7+2JOlibrRMz5DVInEUM3ZGJTeiFHRQ1F2SwPqDfJ/Jlbqg1mbx7TFBE1/FOJazNbmZb005wzcoeNspEF5airShc8xSktLGqNfKvnU9mYxxXjAleUIXzP/6RxjHf7QQdtaxr8NzT49MXQA30coW5v3NUhme9xqL745w46SZKY/EYm/6By96DTD7pBt+/Wuf2PXy7en7f0Oz+T8Irv2CIhu91e0EHv+YTGZLfbP+3uHxkk7x509k1LJ4dqEUzxgsabSoj5OEJ6fLRtIzlTEs2xbKGITChmLTRNCZmIqIVuKIv4jdipIFA/WVl3s4zJl2lif9SlMdjMqIFW7Wd+orFr0ZFCiS2t7Fa4SzPMe/5vfE3KOLoiKSObMs4qMOjZ3LJ1ZQ98s2pf7Af7Qafd7fbaUMiThuXVv0Cz7cEUtmUDPPquIuk/y/iwpsJT0dPOZ/ZuSJjkooWyScZkdtt+xekNrexXtbCNmQNCB7NfmnlMJQWwCrAkM57SP/UTvAwkZZI74ipxbI6sScpxBOX8SBoqBR/kGCXCsxU+uscFQVMex/xGjWz6AOa5z5DZtu1qBe28RjFl2dcWWuAQMMro1zz5wuC1Wgbi4wgtefbqVapOeAx5FhDSb9KITHJvTIVsmbR9L29DlwpwQyY8yZStFAXoU0ywICgmEmUCMhzQZKkQxdQMmOnynXqq08GopbCapDzhgiDq5fvhKIIej9UYfQCzqUbMRbDZ8lQVPm8qsLqdoFs+QDe7VK/u1x1qlDr0PWX7OjYHplGzfz0/+dBEwVbPWdUap3lOpjEVl+io0wu6fyCJZ9tiRyeDJTi8ItIVHhI6lwMLRNkMSpNANwz9J4yPheAhNdX11BDMJmuDjQ5GvILabUzsSv6ayfSRaDtBup3yQeesBwr6OihSEvI0UsNRNosNtBLPIG0MpEMG5R2g/aQl3lwXNFAL/aNNWfsPRFiIE5HpVYqWcTHUrQwV8tDlMqGhl79msiegZAt2CfeCMMFTtE2CWYD+FyFXLfQbTYmY4/RqB7LJ6TWJl8iZYeBQSvEUKiKXMEEZI+lKquohkH7IAJcTWKBtmxdiRjW/FeHfWQHk7eBp+My460J5C3ha2v2HFefx0slfypyEUrCzGl5RjK67ERGLDolnM5AFZsiPE9suzGNuy72Bz+XmFKjhP/u4GdLxtu9CgtorbleYemDW8RRREaYEHF3lHWbGhBV4462iy5Sm5AbHsWihFJhftLSnA0dogmPMQpKKNezcjTlVAaCzoTYgFEvk9aQd9qvyuumZs0Ez+GNiqmsCBOBKWgcGnklBozsqlTupn8WMpHhCXeVXK/4rP6w+B9QxUBioQUYarpkaVdLTbOvn3NHUKB1MK3AbLdkArZ/41CoESp6n4ZxKovtmASCyghcM4UMiz8e9AEXQFEWx2nPb7e/tqX+7MQSrVs01+jI63VF/6IYGMTzoBs1fsNUPeYremX27U8gkzbtL/5HheClmGU6jQP8NVbn/uCGTOYmT3SkfQ+WeeFfpezGJZkQNvVsAcGx1ZyKCuVz8/p8wkFtYERn5s//aqa3bYmtQ2VzBqpr46vctC9caN7BhrA4Lm+S9IS6BJg2FiVxh0wIWRMjTXLMsECf33fjlZqBpB/QAD6+F2K0Wp/111LiStrfiF2Y2V3DpfVGPSNhy5qQS7uDGMZyB/rR1b6/YFOE1CRZUpkT3XFeSa3eK/wDmjn8Kr8kYEmLH3uLEOEyJMpN+H0BhdzetL1Ep0Sfw6deECyUvBr+e+hD+q0LVM6Zsoo8jpLvCoF7Q7QUHLb/cShEdxrb7/GmwRpttAj0SNr0trOz0bpNA39GXnlTcQprqlqgjUc2eOG2Kgo3pIwpyC7ERCNtnwx2b/G8aXxSKZtQdkUjnYAfozE+bRlnxgs5MYAa1t8lVvJbPjKasfzPHckzFWG0BGu0YXi/zeG7ol3n9bPivGhq1daehTqfTuNsMVN4km6sTfoJSosuerRYwBa3ZSBtd8nRBJZ1po8fhwhLDcX9UoksZMfUUCWe0PaFMfQte3nBG/67+eOPweNDtroFGxXjjjTK/sR15ikSIWT2r1vae6na6R8E6TKHGZyQNrgmL+KYqrF+YYi6rjnVYAtJLqIB1QRiexM3bCYU8JcEkb0RzGzDTmOPaY/TVSA2jKzqkmM3M7Wcn6Cg9u9sJOqYui/oTTYi9VVhwIZEg1yT1a/+9VYqlMCNyZXMqPU0IIsQCrltBaicxp9IiZUFkSkOBtrGUOLxC1xCak/sxddm9r1QuWyhJ6TWNyYyY6sMmHkOSVJdg3mkhukhwKPNR/egKNYYbV702S2FYNZSJk4I1mdarUPh5hRJQo3RZBR1Ytx3xMFMg71T0037QX4/EhF3TlDM1WqPbzCei9am/rLuIjtkSuaKSwCWGQi10HwrBnTxNiRpfvAASSbJIePqSqHNhVnQXYeBKcIFlphGtUBpRr9BVq3BeW1qFj7cvGmJ4sx5yMN8/2A4mBT9HbjBvf/h1uJMf9lAVTEILaYcjIAPwJ2ZXlM3AMb11zm+2WmjrPYlottjS3Lz1C53Nt4AEyjhD1z1FVCc+3YjACaLsdoTIv3wuCVPlY+0FHVNdagmew4hMKSuWzVUj5A8XaORxETxBBeI3jERae8EMz7TH6d3Z59FF8DGd6aY1aBu+UMITfRm1dZd9xlk7SfmUeqaW1y6mhW7mXAkDKmyta8nRnMQJyH3wowsSAnMqzRbkhNK+Es68BmyS4IVAOEy50IrzDU/jaAWLsusoYFTIYMavwVPRNqII2LUqDPSVSDNWNSTZoHbhqF6rYUBdJoU9EBT2EMTQew0anscOZ0lKeUqlIQRKyQynEDPgiYD7YbCixKtpQjf1Hd7Hr/3Ose90hE41g1IL9lvvn6hQWkCsDwd986ItEbWxrBtSbZavpT75otAL0/dPUt1FI16imM9mposDujgfISVM9f1NRGcUTkLbIS9ve+cwQsJMKh0PTSjDKVV6zGj3/dn70+JszESrT3gEz8ABiuOlgHLHUEjdrpKDH//K7dnfbLV1v+mYDmgVuqOEersFFbbd7S5E8l2qH6Ab0WUAw5gR51jMibD8Njz93CZMnRrFtvdKzLhYc9MWQL15Ce1WoHh94VJlQvLLY3fbp2+r9ELUy4GY417/4HLHgXd6bYiKZR5A6zewrTiV7Y1Rfp0mWsWlWFTonkgaH34dSeN2VtQ2Dix0KWMReP2bLk17BzMi/BzGlDBpENr87gPHsFHVsQIZB5uK53TNrkxDOm9eU49ye3TyYSfQEXhqHoGucbpUkj8sbUdQD2wPTq0oeDQB184EmmiqbQhRlppyeTMLxeXDDyPkQ4zQthrKlpMWRi0vJHKQasvNV3/zqm831jJc5/9naPHoOjzerzl6TQ/89XvfO/ifo+2jKIPWvO+jWfdLaPW4HvV0p0fXyVGpUC308cubUr936O14C6XdXrkvxV9Mi8f3iimUVPiVkps1gXjuro7327hnLHwAnC+gueN6YJc4e03Qv9MmkIzLMbSDaQDOvXv6Mw7dEUjapK9/r9PuHEJf/73X3f7rveP1+vorgPR91CYhAh9DE2i6x+3OEUDTfb3fed3rrweN16d90023T1xnehvooy/yZaWZfRnKNdpae/CEWXq9qU0EF91qfA2LCU8hcaweCM1PXqd6r5e4Z4Ghhg37lS2a9HuNrwI8JJCvCWfNmj+tasB/aobIO0+QFEqAF4mmgxiaAXTQ7+8dOjM0Il9LEeI8HOu4sHLkeHPABf2zCfFXAQ2uCPqnu+jwaCkSHCpDDE2orGrnvc7+UXN3SkpxvNmeuCaJUU9l70bhyHFsW3+6gWsEBJCQhIW+33pqbqyhlDpQPJljptvZthCVXky3tlal8ShwMJJipVjA9UaS6FBvN3TeXa+C2H7/3du3x4PD4enbd53jo87xsNsbDE6aN7y3bouNC7qzYkpzoTu6XYQvEX4jEPK4WBC48vGLwesj2bpZ0M8cnWM2Q4N0mUiOYjpJcboM0IgQd2M6o3KeTSCOacZjzGa7M747iflkd8a7QXd/V6ThbggD7CrbHf4JZvyn8729w/b5Xr/aG0ip5f2D9hpiOO+y/wzmpnD25qpm5A/vJe/gew5z8v7WpF33SzAny6LHOmrU5llpT44u3uQ6aAudvyk0zvfsTe2zB+vy0aj9YkzJAtDrQvHctuSqTVkg3EOAegGGYwnGxmB8p0bgN9P9v61mfo0mBK6wMQvnPNUf26GNbDT3Nm/1M4Ul/HcYe2A7IJkzSb3u7iHsFQLceMaxaTIJ7me11FqPOaQyzbmQnqCuw1PNWtR/Q5KkJISLiDZcBugBbb0G/YkWE5gwK2ZQIfSLmd+KbvNzYaUK0kDSBfnTZtDbheKYum6XCZbz1ya+vfTwgs50JOZrJNOMFEfXuCkMy2HbmK/0h/EamHGUgkAbuPyfZSmQR09WB1+FCFXYFK38524FCwatpW514FpWuHV0hWCl/BMRUCak51K9E0/gpNDvIvsuopHdJGHMsyjfDwP10UYPpGhBJI6wxPVb5L35VYeAhIVXIcwwt05wFI3hgbEdUj0ZEiF0iJm/YwqQw0sBXeCZV6U2r0GyoG08CaNub69WmuRMcqZGQGdDF8Sol2sxYljkJ3SiqAUP8TjymdUuSK0/0KuysN5B7tqHbyW3N4ddYB7gePs0DiD3/NozNeDg0lxN2dibbYHDOWVk7GVK3z6ZecFPrW46lx+TNW4g1G5/q+msScpBkjUknHl8fbqlZJbrgLfPUXi0dnwrFiIeXgGvGrkwtJ9rtpf+DbQQdVrGMYEW1iAU9G9qh4s5T+VYS+dcu7CHs56v7WTCikPULQvV3FMXXykIEX1CQIUr92MdsjyE1b9Si7QVUymJs/5sIOm8DbXmrKU3m016/+lMO1P0E7r4OPz4Gv3Cb5QGssCJrhTw98paCoc9uv3AR6vlOXIyXS8hsJyrjtWcb3/Rn2oGOWNT7nOrORagSaeVNR6Dqu9r2dOcG6eDkZ91bDtJioCEIlgu4sA8p9PmcKo9r4yzdv5mqWoud+0jV3P6atIU6r7ZISacxwSzhuid5hiBNJ2c7NV5uQgmGY2rU1Yp6k7vre7RsNs53mq2nI8jBDP40TP1Cwl5RGr3wW1rETIlMpw3X4ydRRewZEvHgVfZhKSMSAgYMHz4D/+7mnHz353OVVSg8kGRz4W3S9X8pTsla2HRt/NcGeMJj+rFzlqb2cNAwrWTqUpcNVVWI8PvO9MnHqEvZ8P6iWhSmafwVfMpzj5VZwATPcHh46EtH7E6GY8qh8oDJ7NFmlZMVrJuHj6hHbAuo1zN+P/+z/8VpipTdUnmjPjbg08j7+fxAicJZTPz7NbfGooODyZzei5wUl0ylNTUPrcXt25vbfWLFySGBJmXt3S3svqFpySJaYhFsVYnejD35uOu2DQRSWK+XFiXzqNNnI+7YmJwHk6z+NFB9gZeMfUdWut9J3bDmpuKiE4h01Lqrr62lXle8zLNmKQLsvNE2vy6UOipjXJhzvFcs/jkvqgZ1/yY6xTOoVGnA+Rjo7UUAPK1KWbMDEEeY36L2WEg/jeP+RXFbZxJHlEBKUI5+P9D/4qG5pcl8p9DntflTgdWzVC+BmbW4YZc5eg1zwXay1fMCFrDq2kd4eayn0/dAjyXbv2c9DY3/IrpTnE4NwVf57iQlm3Cm0yzdULlPMdrhKJM14CQOJVZUnArgxHE04XOCHd+WYh6T3CKF0QqwFKTJQZ0IxLMMd2TG75QH1sm7RiWBrklOIZ280LHeJx90k8Y9kI0akFCAKSNFZYESSZSAGbqUWji5ZOUR1ko10ckBBW5vWuGUSaCg+22ae/NLoVpXwlX523bm3nnjqm9lOM1Z9bvuvtgB77HC0KJWaiyR1n9OrI0vt/sXz6fozm/0fEsejrDrbCS25AeZmnp0qpoIq+Y9bc5gW2Qw3eDhWNx407AmZwTJl0NlRQxLp2VWL6JcjU3aqfUbgPjYHClscBKdG3yvQxcKPVsoxIteClmQmcgq7VMY36jV40Tqda8Sp55DtdbaGArY0ClbW+mYh2gXy4uPrXQ++XoP89b6DOJqE4b+vzl/Q7ySjpsqcVtKSBsNTr1hYsmMmm1UZ1jNN++cNB4R+8ti/dvxKwMgeQdXeitDFRw65Q4nYkme3WxwCxqx5Q93tSVY3XFAk4mgseZJHAq55maqTkxYRH5WLfPecPTK6WSu9YXd8NuXvG6ZRgEFJdw+7xw4jTgS7ogdeDB64rpC3OYbKhH4h7KqKRwY3s7FUuzPhID3XP2B/GQHutuHirN+Zg8VFzC7fOuy0Ml8Io8ZO4OSfG6MCU4Hju30arrGVugccrTG5xGJMpfKWvFt672rLCPDAfYFHxfLIM/N0n512VLH5sg+d04ob1Sh3p9pkS9BT/Ph8vjCt7x1Aj3gryXoJzZxxH5KiEwNLLNC/zLejeWGgfNCY5I2lLKt4nxQJf/bL+z+FF/XfqdTFisGd+VYKMCRVSogSOIZsXxDV4Ko9xC0GvLaIO6jpeX0AdpoRrYMU0uASSmlFaFL4OFMmcBchOeyoaULj+/FpkvLDWhLE2ScslDHvulqIobXvEF49KGchuFOr8GkKFuUyREtgB+NjoKnLpjiPHIFZWtd0pzgBTXrXp1pYmyAuoHlYLE01WKh3okmHrNEdZQ0M5MaVOhNG8dnUMFirGQ9hPT4yvUwByQr4FjYbjWAq3/0w5HAQWimSSpzj03UJjwKxYvSzwBE9A7FNgzk/KuxzobIl2SABLBXWldZb8bDLIo333V2Vwt5LtC4z1DE8qnetHO1i1iKuBOlaUWoLNcGcO6UF5bYsgyhwe8zeO24A2NY78Kos5HL9QefiUq88dUSL9lEdTpuE3EKsDvvmUvEuOOEKUyz60Yzn/w1hErZFkxoPfcrePpul4QaCgCRuR4spREjCWXdy7cvAov3GsqXft5rcnMK02mi4iQ94JLvUiZvhVsDFxptnVA8+e7Az4jTUEiV6TphXcqP1SoFk74W2WrgjlroGXO6WyuYzzNKzU2XqAVMrzUjcmgIAqIATeSzjGJSEJYJGxPOHtstSDrXhcxEOqc10f0gmDmJ1FQpt9XwjtXd2GEFXahoRYXgk5iYnOR3IBt9PEf3ofTNPUs0LbNVC5/PdA6kP66gNIFkXPeyEsDivvuNUknu/qlWqTmKpU0OVm5LY/MbMbD/fPpRQt9+jhS/365MHXBOIJSY0ofGP3nuT8IUlO7kbZHp+eng4sW+vJpeHJx2kLD0/NT9f/5KKWTxtYVvBvWmM9oiONSJUJYis+rUApRIMlroC5oZV8+n2t7I0usyQFnuoixmKPt3WKJ4JZOctKveSNd7maCpGK3e9myfKdXR4X97VIPFJmqSqLyYL4sV2scKAhhpwxOzAvXlsI0rZjSOLa+oTj2MeCPRsoHuwL4Ng6/Bf/aNitJhtuwbdFV1OwV/xRQkT/rA6wevSLLtt7uQvLUPp3vYv0W5IcWgPwjI87sW9P9B69CJDaaZwusAMSRDv/VweMemFRqrSSnmtcnRHC1q5S5BK2mLn8+vUCGVcaC4DSc6zqVkghpGMS4sqj0WaI8jt5giBqzB0ZEN1D+1huvTPQUL4pXMatqDBexYfrA5R52USSzH36kRAbiKVKAes8XaH8xT+lUtj9/GpTfzt/wrtIKXei8bOtSXElNJLmSqMGCCJFftK0A871+yEz7CQ5fCM83Z55fiTsTmWmZZyxaUhDoCzcUNzUak5Q4iznFN8D3Ni3WK9tjHMxzEifTLK9XBdZXyrNJTMScc6nbKRgFIMU3+cH/GT6U01WrR7xdh7+DYU0rTnZDgTU5R1FaPeXO1NI2t1wF9y+E2TP8hnpF4LZxApfcsMQYL0kKRpGRyVBLapmP74bnWerbWSkRhMlC2fF6pirVV3s8SPWwzw1qQWlcECwyU0jS0x3fe1+jbU+TFDvraJH+6KYKV2SP14LfsMhx9daY1tjpXeZOjRlym4vVXYLCC2VsoQ9c+roF5HyXVIcqxczeRzFhMzkvtivT39l5zj75txMXA+ueqmSWAOw8u8sJtMpWuQ8GNLc+Jwr+fwAAAP///2y4BQ=="
+	return "eNrsvXtb21i2N/h/fwoNPc+Q1LGFbS4B5u2ZlwKqi+fkQgequk91+sGytW3UsSWXJEOoM+e7z7rtmyTAEJRKuulOJWBLe699W3tdf+uPwV8P3r89efvn/yM4yoI0KwMVJ2VQXiZFMElmKoiTXI3L2U0ngI+voyKYqlTlUaniYHQDz6ng+PAsWOTZP+Gxzh/+GIyiAr7LUvr8SuVFAj/3w/4g7IXw9elMwQPBVVJAe5dluSj2NzamSXm5HIXjbL6hZlFRJuMNNS6CMguK5XSqijIYX0Yp/IAfYbuTRM3iIvzDH7rBR3WzH8DTfwiCMilnah8fgF9iVYzzZFFC9/RR8IO8E8jb+/BTN0ijObyy/r/LZA79RPPFOnwcBDN1pWb7wTjLFf2eq1+XMBPxflDmS/6ovFnAmzFMBf3q9bd+BB9vYJvB9aVKaZ6gxbQMsjyZJinOH1Af0P/OcbLhDz4Um/fUpzKPxjjPkzyb2xY62HEyjmazG6BqkasCPkzSKXUkLdruGlesyJb5WJn+TybOC/xdcAnvpZmmdhaY6enw3riKZktFRBtiFtliOcNupFnpbJLksH40JJ8s2FcqubJULZKFmiWppeu9zDmvVzDJ8gA64haKkNdJfQKacNHXB73+Tre33R1snvd293vb+5tb4e725i/rzjLPopGaFY0LzKuZjXAb0wf84wV/DpvsOsvjhoU+XBYlLA88sMFzsohgwGYMh1EajFSwxDMBezeK42CuyihIUhjOPMJG8HMZU3B2mS1hqHgOx1laRkkapDDveKCIHNq++L8DmAjqrwiiHFa0zHCiYFaFUkPAsZ6gYZyNP6p8GERpHAw/7hZDmY7aTP73WrRYzGBVkbq1/WBtkmXdUZSvdYI1lV7hJ3De4+WYvv8fd4JhkxTRVN0xwzDm8eVFls5uLkrY4g0z+gOs8yybypzQ1pBmZSPIzPBX+KR83QkyaGOe/Ga2IG6Zq0Rd4/GAqYzoafxA5WaCsLsCDvW4XOIUwhNFcA38KFuWMFX2BHg0QFfQeS6cJBjzKgNhMGkqdQ4BrC0uNHR9uZxHaTdXURyNgK8Wy/k8ym+CzDl87omcL2dlAuuh+y1ggZICT/+lurEdzkdwYmIYHHSUpebp6pr+qGazLPhrls9iZ7XKaHrXYXA3fTJN4cuLaJRdwTf93mCrvnKvgT4cj7xXmF0P/QQqGl/qUfrb7e/ubuItNlj7h7urYEAp7xTh8Afmg2meLRf7waBhH53DtNKbZpXkRAmfjQIYzbIUjjgpr/EgIS8t8bKbyFJE6Q3OeYQHcjbDI9iBfkr+AbZONipUfoXLw9s1w212meFKwbdl9BG+msOVB5trjg9Is+ax6kGFmyAdz5axCr5XEbIEGiu0Ed0A9yuyIF+m+Lb0C6yGLjcaaPidDFWaLC6RX8I+MayZdjbSHyWzQu89niRoN8VzkvEEIW3O+HJpEi6Z3GXkl8AqFO5AHCydVDNUYvI4AansRmAjJXA2XHM92P3ghLsbo1AA9NCg6dziQexY+kLcCoFIJSN4KnTO78HpG5JP5BL1ByQrDoRu4FASuPkCuzdcRhxnSk8dcWCSOWAr8G6BxvGqhcZgz00vg1+XaontFzfAoOdFMEs+quA/o8nHqANXV5zw/oC9PYYzCQ/qRZHHiyUcCJih1zDOMiouAx5HcEbTLVPGB5E2OU+hkVzs6Rgtk1kcaj4lvVRPdNOZvvVUV0/S8SdgajHe1NiVN2UTWXdeI72XRaZhdo3CTSoNADPQpxAOVkN7dNIinnAWRUyTeAJgNq+SGDg9yCbFQo2TSTIO+G2SgZLCSGoygw6ngYs3T8a4d4xc+ircCXvBi2ge72y97MASjuhr/vjvO9FgU+1Odiebvcl2r9cfRZtbW2pLbW/Fu/HeeLQ7GI/6vVdjQyKOpwwGvUGv2xuAMBIMNvf7PfgT/EcP/hf8dH74DzPDkwhY/AXN0X4wgbOtvGVVi0s4Rnk0u0hif1GVLMcTLKzuI4BJBc4HxOTMFWAi+Xy8gO2PFwvdPsXL6hInKKzA9KMAqGX0aJxnBS4E7Osc2eQIuOyQd0gSD+mY4QGrr9ButIUTPfEmojr8p9nTP6XJryjBPnzcRqJCzsP8it67JtENuC1xpyS+dXixNzz8u40BimBKbNNl9LUVhBHzU3zLsWQxBcmcJFP4lV/jp+XrSzVbTJYz5I3IAWSEpuHyOgN1i/k0HG/YB+lYJNXKNVNgx3TX4CYRKSmwUpJaRDlxBtM2EJEqFbOOeX2ZABetdWUYNghI2BlqUM64QbwC/qEvFBoq3zT6I5AGYPQzNQF1eL4ob+pLCXeZt4q4UG2s4jm8evvy6UsMOwDp4Dq6gXulxL/N3KK0X1zqrcnLKgoXv4tCWminJjVXsZlV+yxvcekImjOPkGQCm8FdeLti1Q3gLf4cBEPU+upT7Laj51kYdwtT/bNcCf5kV2iCayHsdfPxwJVOC080XZZZms2zZRGc0U1/j5h6AOfLvsLCQfDi4OwlH0wROoUwuDtTRTaBE7hR81SVwWmelRk8JZS+ODl9GUBndBsucjVJPsG8L+G64Hsab988m2FjyN3g7M5hbuBEwcrlH0GBQktBlqMcq9V4BVLkBF+AGxlahVMZxXCqgC3iybzSMjO2FWdzFrBhS4hlggcxn2dwxMYzFeWzG3sDku5iqM1A57whfQEITWSA4cpyULqcj4ycetdVOcuMMOYthVwJ3A6aGrIxycxCUW2ZRIw0H5sNL6soDcFivn0JS4CNwy1pbpyCdSIz9XwmTrxxO1uvv93f2fMGnOXTKE1+I/YY1q+RzxETSPu8cGfZYXVabb9bqb9TyKnM/DtnJNRLbfR/zjLcea9fHzonbzxLKorhof3kDs3wQN7EI6Z3YVTItkvKBE8Ab3i9OHLwROLVxLHGl6tplMekCaCgn6Ug8djnWQsYJWxHhQ9A2JrMsms0f6GS7Nkhzg9PpVW+jyyZNdrwA3zcoYyOHZw5o//hM2f/9TZYROOPqnwBUgz1wqaLhTCOWldsLkSBzutUK645SdgKLU5atdKzBAwhLSIiJgzOMmDuWtmBS4aehL09D9a0DTTL16yZBHiV5lFCSloZYMEHTr4WpZ5XFu4irdSSUu9MgBxGJAuWSJbZduHSz+YJ2US6A7yzlsUSJ0Ratdo0vA/k/XOZ8gKQcs3qsrZQNzRm5xdk4FqTKE7xenXpHGvToDEocnsbuh9jAqbDwwIaWhkLBYJUCdoRcnw4kyLLqU8spXdYdPqDkam0RAePXSU43OQ3ZS0lOFCVk95WJOUykuUAQeoGlDTTBxzwmd58+h5AHjrN8psOPqpFkaJM0JKboq1A9i3bnVFcgSUtcXvglOKEgSAwM2wM1Pg8W+SwJYGZPkBLhjmBeSra0qRot7NJRPaWdChSj2Ez81EyXcJlAcTTbqZ3DMO8xmkpoC2yt4M+WpAR8uS0g0ox365oBsfr5BM8iPskDIL/sjNrpEArE/E5yKNrTZPe98NQPhjylPmyZYoWFSs6xku2CfOFOAyTxRBJGYZM1hDNYguYTRHuWTIHGcOKgchdZMWs7BT+213bMOZ/25vbsVrdlKq4R4x3VpxtPP5rHiHf4xdsoDP+MjmJshGYYdYXaHfLI4y3cwsKhnBubj/0+pyqLByDDH3RkjHgEOXzxtV5g/qAErOhR06GXkUg+GKcxW3QdH6dwQ1XwrEJsAffl2l6Xy+a6X57sKodzR9MSxP81rGymM7qRGc5CAgHc5UDI20gcglrcXORFFlbc37IXQQnZ+9o0msUHh7cSlZbW1NIalzlwyiN4vpMEYe/3woAj14sssRcr76zCngLSDIxixwge9EvNQrW/ztYm5EXtPtqM9zpb+1u9jrwUVTCR1vb4XZve6+/G/zPeo3Ip2XrFeMl8LKuFimcr1hp0dMDMgMbb1iQhO+mIKCDnJkDO3BlA/QngoxCkrMjAxzqq9+YxniHJzkLhWOFl57oD6DTgDTAd2eHTEGXiZXO7SXL5M2CxeVNgYEDxuM21jyqcEh4m5VOhAH5ExM2mMzpjoeJ1qOtG5BGGUhBaTce19ZmAd9Es7ZO2fopNc9sLSpASkus74191kKyHejP4su30q24VowbxTgAYUE+ptl1irpMFOBQqCN4+peT08AZU0Bbm0TKK3Q1X4MMA7NI16OcanbI0I/1+dvb6m31HsJmQROGUbXJwN5TD3fxr+5fDm+jqyUOJjQ1MrC/LNVI1fcfSve/Wdn4Sa9V1LIx2OY3su9NvA3XMV7Hk4O3B85zjcTLRbVxkE/pWo42vl+qNCsuDpLcEcLu2RjJ4p5Rmge8ccBW1tqKvldZfnpxcnq1hbsd/t156ctR82jcxnl+c3DYTEzFGA/6vPGKAn/ik/b+h8PgVW9rQP5jjmbDMLJjVCKycalgRKQAo794tztKrAyOsu5LdmmKaCTBUtdZ8PflYqFyNNX/A1T3T1GsxskceE6cwK1GPg0Uo5BSihIybQr53DEykBRUnALmgYNI1BQk5OBsOSaf9ZU8KDFG7IthGiLT4uXN4lI1cN9erwt/to/p783uYLPi9SrD6s5ovB+bd8f6OZqa2GICOwVGJfYDjj58e3BujHHBCxVOQ7EnI1e2JkKxPGlTs+fcNJeOY39CAxc5IGBqZ1kEExHN0LGBd+AEzsQ1mj/I3oc2bYzNWK8NegEy4MOUXa36FGWeNGvA7mxg+9/KfLCd6wFaoDfqU377UTrfwKejtiarqKK3r8eprIHLKNz+8D4CLpCr+KJJ23w6ORGZ0mUyvcQIWtupniPuu0MDAW4Sa5KL5UgrqWb9f7BeXpb3nObELoXyCoYKhvIchvOuIftacz+oup85aFLcyhhYlc9JqgWON04KlFdIbIrYFkYxNhQsuhzNkjGQOpkkn0yL9MwLjCre39jgR/gJtLi8DIPz/IbYYsaC1qcEpUgWsoCnFQlQeYMhS3Zd2XaGMcnEdjlikmUqDBEiE9C1gt9w9Oevj2xcz9o4C5cf1+qM0ZkNb1eYaW9zN5hOaNMblWGyxKP9K5p94SI3S8r+cI5Hc0T42UxvFZLX0aisFqUNG6PXrM+xtt1D8jOD7BoBCY5hPahRQMwj4b7wP/mepRmr15ACssQ1wZ5hM1nLeuDvq44zAyaOtDYgWOTsunmbN58J/9y4c7t2fX0dKtg/4fxGWuCNwScDvlgL3cAEMrlzKxiDbcJAaawkfphurDS3Bp8NQvir7x2+jreJLXmsUIhpV8dj2TbWOnzm0gwZfDIj5yyIgllDSAsOYFVJsMwWFzSML8D11GSClxRIMNCrbBQZ/QsF5/Rlh5Upo0nZebf2YGIdHe1+IyaAW1bvFeeQhHUGWe3XNOsEzOAq0T74tjkjccXbmKJdidXYI33u7RtQ+/Ow3S3j2u/YU5vl7P/EzjkUY67IL5BNbrsWYaVeHx2cUiAnj/jINOXulfX66BR8O2tpcGgsCqgDrcSEdQKQe158054IHOZ6Ya8BMkJFVzBsjCSrK7czWMwyOMbgJCUby5sRcif+btuOem9/3/EgWwswrQdZ6nhhHp+OAyPH28YCNBgUrsPb6GzRpOquBHdWJwLu48vWLLo8U8RtsB82zOW5Qq2uFnEdCVvCKOosvXFTXlg/cbYKnAuJ1BzSKDACF/229AuObmhEAPh3wmuFEcFOn2hHrEtVFDnYsKlaCdi9JV6Xp6x2us+6/e52d9DvDqC5wdZef/Bq91V3sLMHv6FZszvY3O7vbe+82t3p9nu9Xn0QT2cs/MJ88OwStU8211MWRZLeOVVRqG7lgXk2U22FTBzkeUTpT7SVqSftryC7pJ8YVXXL/H3tYzKK0uiCYhExbShXJHWn0wtskBOI7pg3G0OWLWM/hEx/cHsEGaeSBnw6TaQBNUUKSzrJI5NTZofBdjSOSdbGBIpMvj07ZhK8sVkLaAq04dMRptgOWOPCAzpRsHFgEtE347QeoD2QE5IskXi4/Tw6LyEqKUxYrk+CtAtUSKZTruZAs346gNcLOKJOT1XKmKYokFQcPSA3aEheFb+Sn/LHjdqGKOdIOtcGH2w2KSypMmEPCRYak1OkvYtx/dxOEPdFuVZuMEUSm/w5YXpwmycgTOeuuY68ZwlljaGogKymixmI0KBKr5I8S+e+ndrurYO/npnOE5htCdSg/R+8e//n4CTmDDcKF1xW+W9dct/Z2Xn16tXu7u7e3l7jdLbpEq1PqGaB0SyJijvm0syhE7D+OXPJwmdtNuOkAAHnxhXFXD2a0967sbpaVZ0W2Rb08/Lmou5NejpG7fTD3qJEh3cRp2DeAnoFWZxpy9hTGEg0Vo2DL4su2g66fd87pnMF2jt6JzpH5ORI3zHEQjXDqxKadPuDzS0UFfZ60WgMy9JrprjF3W1odrN56lQ7bjD6sJ6U8mQUvdE818lPuXMay0E4V3Gy9G2eghvxRRit9OWysKaj7B3cU/NOJzj4DS9z+0lDguFNVzpZ9Qzr8X8ZzqhngL29q46d+Zk/+mYmNr8JHj5+zOfMv4BWZ6aAOgz1qF0Uhei6AG0aB9oJpuOFNZ9iags6ViPgZipK6zL0dVFz8GRtWQ0k3uBJmbDviJAs6S+zKTWZOvfOzRWGOxMl+mVSXOrniorESKn09i7XNgTGcKCLXC95J1BTuqhRar4qgtfRfBRHcFMfnsJ/x/CRafJgsQiOU9CczMb/+Q2+gp9LfnbT8YngayWv4c9CckdGCoR34AyAkFfCZzPqvn6I+PMVlZcsVhfovY9Q0fC0GAwJOvO+uV2dgcUoVBUlwdP/SS8YJSmGEVG0kem0WD1BC46QKsqLaDbNcliceatez6i4pHwP3ZkRAJFyNpRzZv7tZndv95nxMqBPxOhIGNPBwRPGUUOR+04Mh810pCdzSnQVy73dtzw3ltz6viguo8H2zsrZ45S2fI8BY5SB1h2ljeHU/BWZoaIFqbEJZwDKPODQJSqlrp0jRtL6qqRik6i2tysDOIajVXdC0qA1CJKERscBcSddTiLBjsG4GAslAhp/nOWhFyFkEBFyBaOMOFABuArsm+/enQVo9WnyiMxD7FOFnxbjEL0zNyvPbQmL1VrSy0EcJ5LSVucWdOeqvGR3rRJSmucYDZSC+TGl8O/8ZlFm0zxawA0XqDzHjFQTPum2Cqc1id1wVrQv50s4StJf8FpFV+iNdrK2Jjowil61r2hJwbZvE3NQhUzHl2r8sQla4vj9+3fvL356e/7+p7Pz46OL9+/ena+8RktGmmopPPGMm/dUB8PCjeRlEwISRG+APQxXSL7IvOT7+z28Kpq3fI6xi6c8zNQewkTRaZU0Z32EBZgotGfXhSF4yBk+/suPf/tl983uwc8rz6VGXFthNmO7Vb0ZQxQ6ssD5SHT+DV7BiCPAALrT6nx90Bv0uz38c94fILLJZu+Xlfk8nTG1yua4415aP8PENjZZuue84ewiHklSibFOYKZ0YORtZ57fo3hrhtjSeW8dnsrLxF7vXiQFrryPtoS3P4ylEFgbcs4RUAqwEZYLmEmtf94NSpzsM+e1+cJnxyRpPv7VDyIwx+lEU7TLll5QgBEUUZR3DWaNvDjyJv8eRrvKxFjpmCRZ4XFGMHY/vCNJ3DzoJwJLim4NxM/BEhNcIiHSUBFImK8DwYi7z2nEQYR0BHLMzHV8gWT75rBO03QhVvX0BpUMPOwPMHa36a6zg09i30aVzBGm7gt5dKkzk5zFBOFGY7ymLG0irYymLVFmd5bQFU0rIRkOTuXd3Tt4lXcgVlatidSrgD/eg0XUwqBtroExl/Cebctewq2jqB5Nmfknhd0ItVuOcTIdPoIn7qLANMm5y0mO8CCe2Y/vgSJ0WtEnG8HxOKIM7htK59J3U6quBQPNth8gOQQzMUbULgsWmTa94j9sMwFMuInzqNH8CU3SQjJU8r7wAltijoHzqjjsJtlslhHMJswyTOp+MPxvZ8Bkif6frvcR/lyosvIpxV8uorH6n2HowuPO4W4qrEOQcQ7owjXBC5cR4eXm+rbNRcTHaDMJ27PzqEhicEYCmsKbLK/ASMhW4RC8SbZMYx4wGtwEupjCO9l5E46zjRGoMBtR2k0QZkdALbtl1gXKu8bHA712udcur1KXV+nv+LZBhCjKf5g1PkiDY367UFE+vvTWAGagQG2/CuozisYfGQAxRsMZiy/GsuRvFUo3mxdefGPlfUnOC46WijcHnyJ0bGNqUb1djF4tBBuKNwg2pT7prYkAcXmioT28kKbGva8KCUIxEJnDD8NOMNzAv77Dv/5f/GsN//pf+Nf/g3/9f/gX/P8FbSu7TV5qioedIdlLh38chhqeulB8ZPxJJ4gShX4YCsKx2u8tm2G6BFVjQ6Ua1Jqb2TDNbIyXOVp/NmSGu2MYcKm6NEvhZTmf/bHyTbRIuouovOxiKs68+Ls7hf94wJ0vh28FjoubqwQl5+KOC2XNmjbwrDgIj+Ulc7QIHUMfOUG/UFpfEx3sg5EPPzhykWZS4Ye0Bo06RCPrpzCiNCVcX1DUQCK7VEv6DUZBeb9Dt2VVjnmTeTuUSCMP93VCIlfJcIX0ecyI6JcotMqMwQBKt9VrZbBtmL1+WCNVKhl/WDPBbvpdeiIE+iimRT4divrgtko9GrshNwxcbNjAP4cwP9+rm4w0k8qGdZtsuBpgAeEcJBEOEiV5vEY56GJoaOO+MRLdbne3WXcD7n9Ig+C74A0eehfBetgd8jdvM8qjYVE4hQu753Dtteo97K7xqpqQuT6eamcfcHiSzrU27YckX9KPRjMQ+JyIOB2dP5hld7LkxoEVe4OiOraMyQeIdnijgz+UZFhrpsvQktGNVj55N3lHpum+T4EEcXxIGyO0Ly9wstHbQrnDMp1w8wE5bpNMGQV0a1XNTUUmS9xQ3h6GgqdoIOFRSSI4XoIDctvFG4XQY8y7t29e/67w96rbpmzboVkad9OS1GL59B271W3yMzeuhbNe2TSymqN+lR27DqICTEiOU0i8Fx30LiOS/eLm+fMtNLvhrQvb3PGJriEO8xptvjUG0C3WwuCvCIy6QLMibfiCke3XsGKBMhPC+edrxU0KfeO6rlnArQjU6iUaExocYNm0WE3Bd4CiPMG88vEdgrnzqBUs2fpRFQSVLljgQ8MZ6hlXa4NzGkNfhSdbooX44gg4D3WsI8mQKIlodY7irgRTinckLqgD3h01Iq/phW0Ym4Nldh+KGd/DE1MzQ3swbsHpcjogcTSmlCmWIgsq6+AikTJIlQGYFIQvMgal/oiL2zrU0+BPpj4uLhCdV96C9qppW9uRxZnjJ5oTvS72OcWo0gWZNj+nZ8GMktEazEx/lXBlHtraSphlNviQa3k8EWaZzbtCE+8zZtkzZtm/I2aZexx1EruU6/m9gMvci+QZvewZvewZvewZvewZvewZvewZvewZvewZvWxF9DJXrvs6IMwcip5xzL4CHLNkQQZiZ5/cA96lPNQu4LtXyHiP3vzysgm3i64fYuJfFXQZYWU5ETEyUoqTsXMD44PFwpk4UpSb8PQjbAOM7AHK3JdDJPPO/dcESxbX9MxnbLJnbLJnbLJnbLJnbLJnbLJnbLJnbLJnbLJnbLJnbLJnbLJnbLJnbLJ/A2yyeDbz4ppev14h0WCVTH4yuc+SUR7lGBwQ32CU3djRdtCAwpa0TCdUkmdDvqbwRa4VSzGHUuVRSjhmIK9fRgQ07vWzxkKhTXonhUYrAiMdbi8agCq5vUJiKo0upVMN9jU13wVHPIAuqAMfpb+b4MUwhAkcvpTys9pABJPw1ySNs+vCvn/G5L7jTFl4scia3oNz/qlLwmxt7DVaPDJu4JemBufR+N3Z6kFBPtxD+Iyn8OXwFCpT/w3BK1Qof0ZbaA9toTrVz+ALXz34QnXJ/nWwGCoje4ZmeDpohurU/qshNVTH9wzc0BJwQ2Win3EcbpknlD7DebzdFpjl0TZ38SB6QIDst0TQ2Y8H/cdRZEXaFmiCxh9H1ba4t1uhChp/DFVFrNSiLarOjo6PTx9GVUsih2fHFZ20egHzlYJe1nm0KJoy/0k5I6DF4mP9MH/EYJTZ5iDUBotVkD+jsi2D5Q9oZieKsZPa2KtBd/sfxB7w4Yx0+c3Bh0cNSIWUc1eqsYFcbAFG4/SnwO0mKKN8qkpju8Zh14b4aWfrAaPAizNKb1qDMtZ1B7mb2jbr6LzVGE1u+BR82CVMmyeVj2GkDmFtj7YS5vyIwZ5Gbiz4/YPD5i+oOmP7o5NuHjmynXAz3Nvp9cL+q63+9gOGmMwXbfo9DtjbYTCE0IYrQPunx3zSEJhDqAi6XQoIoccCh64AvxFXudZzJglmEy9yWFKWsihH6woNYRPMKckVz5jkJWoQfpQXuzROK6dhkJxR/wuGDsjGhCwBgi+ntF1zNAVlqDI2CLxm0SuQek759WW8POWHYQFdhAuMplM3xCgY76S8RHSKLpqrCOBi0OtvbfT6G5gOjF757hwz/HLV5cnpijGREC4aAi7HO7u9zfGW2hsM+vhDPI6293Y2oyje3InjyQM2SJaDzAtDvKDD0CpAu5yEz+FmZ6cHJ2/Pw+O/HT9giKIHtz0u6eZzxrdm2PWHTwfH2ghPP78z5nS+gtdW9I+khecfeXt2n39ECrdIYgd2CC8Fvy4VHUDCx0mLawwONVnU8L0UbxFtUSV0Fk0wM5ltU8wX1G0hDHqSkScE7mYalzQrjb4YAukEirRPzw9fBnx/3+hO3NYpbEAnmLO/Uzw7pUnG5W5NznrBMS6RFz8mNLBOCz8pu3YmW4PaqVPJrw5fPiSD2RvxyiBmNTCDiHxxTgI8Aj+xGY1CeDD+nPsKCq5LDJMHolHquJ2116AK3X1O1WNgIuFsyLzY5GG9ADzPhZJe/dxoaPn48Myand8D485jaYt4MXFQ10I7t8PhL3XnmIAMb0F70nw1lwjXEvcYwzhQIDGFyCv6xgcswOf0Xg4OymAOkzdfzjvyoTXZyqAIqsnZV4wBM0TiKIW+NgyM/9ARLB1UHGwMJrY2poszIQscjghBuLKiSEYcFRITYATKfw48h4ZFy5ztWiMUGhoDrZmGLatmZ8uYx7OotfR5Bq+POJXCLIhGlLMYYRqOn67zvG6lO3nbSLpTwKkVOy1R67BAjiXVgen+4VARV0/SGXH8KubMFzpChhBGiCvpKXEb1GOvXfN9kPfkT+MstF19wA0jhh3n1FKokI7xpRSL68zNCZm7yNwI4z18e/DmOCCMHcE1y2ZXKH05zGl9vWCMmqHDYkoHTCGjClZ0iYLoViwynGLjdnEaoXMJZ9LwKoxrlCjIapsi/wRD4OuFydwf4vWiHEQKZ1koJPiWKHC9NGU5+wzsA5PjRVksV+S/QtZNA6YZaFwFbdaFKXU5u5oQY/JQH5JiHOVAThj8ovJMo9nMySx6KXEczEPtBI7srHEXDfn2zRu1xSo755e2ws4jeQztTd/8paJY5ReTWTRtzzmpA2gGgWTJI5vkngPq2SuggUhCHuzQfnBw0AnODzvB+yP4D34+gH8P4b+jdw3G5L+vvT/COJv3Bzq25jZw2yddGhwTpw25bi9gghzCIFIHCFnTPJrz1jPeG8/QTlIXu7KchghfcZFYWBBmC0WDBj3o9/0aLtmiIYn1yQcvYTBZyo4qFqAY/lhcPaD+Ue4Oy6eeyBrAiSwKhNp1A0UwAgTmU+ZOGFip3X/cDIvANDMUoeS2eesc/eWn4/f/5c2R4YlfTFbIRTrke4LVjnvFAo91t3kj0lVYIa2a/sFQoX5NxjRLu2TKQFHQxWF9wbkqmwPC/0EKgv5g56Wb+pEV3huWibu5oiDyAbERpoFhSiSMQud4Qh8fjo6OXloB/HuEmCtgwi9Foft1mRHKimlZmoJdF42KDoIH5AkCIrPWIDCps8RBAZooFbstEOppLnmJH8pO8CHntz6ktP+U+Asfdruadf7d8/Cec+++ptw7sy++cBJe4hkPZIR3Zc794RvOFYOD2Tzpz4lhz4lhj0kMsxvoy6gHoiXdLVkcwP8qKd+sql58DobBQc1CB7N4coqCnKI6hUPXsjGsmBj0l0Nt6ZO9k8BCj4Fr4PIuC9ifIzWOEGs50xGEMMTyRqtGXopJhH6a0gWjxnqPJYHfGvoctENNKBp2FQPUgrrvTM7QyqwEYw2Na2sWha0RVC28PSd0Eqdplgv4JfpeRUVCQfKmxasEETkR+ZfFFZRwYRCNao5jNEF9x/7aryo+Wg7+EmqA7qsZ1ebtOwrQfAzg7eNDEeVUGOu9DoaKOzLDKJHSxvOvrRtQph0Qcsf6T0FiaGwt8CHXb9ChD9xYMkZrt+kxaWFamTBtVQfAqlRYArQ1X2z9HhGV/tG0xLMA15+M/0W2YKsrwv4iympmbhTR1fhYvEQfZ0wF29FCU8H+rBz6270Q2o6PWpwwg9r+NgZfU8Rw7Pl3jg/v8++8gZ67rpFa1+IRK/TqtSobHedOQE6ufl0mOQLgYCDb529aNJFrLzpdYGZ+uS5DmSFu/LgI5aEh519qMipxjcRz0KBPeQrkskYXEm0hZ6f9FcNMac1oAdE750hqGoG82xXjqDgukCAK652B6oCo0fWq9M5o6H0nr2emSq7xOs3FYx3F/0RSNT4K4pdHlfkPvIyrhq3TD3thz905s4m3c17/EPxIxqfHVEJ9naTLT3B/qPGSVVz44CP98APjI72A9l9SSSiCd1/dRdZ+vJCu0+3FDMlk4qw0xwvt7nRXDxlCeKcLoKu1Ks4IkwrsBzZqyvzwVsJfJyWsd3AMOzdaPVB+sbxo8T7CiC23SvqtxKOvfeVoM+LwCAvnBJQ/Ju5cpCIETkJmYIQaA2GEpK4XdnMDmTFF+HLilouU7FTUynUoQEyOChTENPrfBGQhso1KUAgPJcuLcPXathSJs8KoJ7OohI9VI1L+64SjyLk5oEnN1NykJXLIOLSyOl1s3g6jUdJy3NXPfrgV7qgDJ0vqew7bptiNCda8eXHw/cnLhw6jTaMo82LfYVg9F6vS2aK3lCp8Mct3iJR+H0imQpRKF8/1ySDUZDJtB587o1JHrt09vL7W+9TnEjnGS6kJthf8yiQX0F/SVjb4vWdLzzhLCu/OHjrjLV4/sjvuuoFWpfKLM7UHnjSJ0Hyqe4Gbe4J7QcKSViEsVU6m1aNCoXQMVG2tPaPL58Q7FctRl6VZ0yRZhVOF0fZDEL1DPeLwu+HqR9m8BJLq4HFM0qvL4Ethl0m3+HUpmYMjYBYzzD7FlPQ8GS3dKRM6Hry8xOKzxc1Tk352iegmaSDNgzA1Gy8lMtiIaY8mus0wANx8Z7If6VSJ5/+hNLbo+KyR6GIJr06hxgO/yCaT1erqPQmx3NtnkFskv6nH7ddbi1rUiDRY6djZw2lt8W6skYpNPZzCqyTHuqgXq9fseZB8V6NS+vMR0x5D8ONX/xHUPnD1p3jlfqkrkzr7va9MHvEDr0x56QEixmMPisyaniyzmR5Ma8sHukLnw4404QBdGMSilsjUEqagJFkgJkoPotCnpHAGszL9JeykFjOidPNBcTMfZZI4hMdoRY3CGHAQU8Szz5oPVsa2ilInR0JASsi5cEMxZ+bw/sTVWed83Pk5Cc5fLBTV/EJzlyq5XKJ20xCAHcLSk5krd8Nq2TKflAXMhoPTm3Lr4cPgnFrI+KXJ5IC8SpA3E3hnfGRLeHg6PbWBAgkCvIeMZuS5hnHrmEL/XJfR+OMFVadc4WhcJ7MYA4h/H4y8c0aXGFNRR10KGR1CNDwEcJ5Riga0HX4pn6rrUjXL2HGDQqQgItrz3BQxRt92FBNj7f5ndBWFsyidhm+Xs9lpRsHgx/pxl1lcac+SZhbmg7uZhRxUr/SlpDoRosWn8hbgJFuEnfYT1jz1Dr2tyo6PBlQoSColFrUClpWSnVQn0RbFZiZkIzVeZ4YFkf9OF7w2yIOYuSWZChTpAg2ZNgJTJxs6soOQ9nRTkYbhwV1GoISYyBEROnrHKSkqgc0cUGLKGvxB10ES7zTG3rjA/R0u9agbgUlIRRgcwUeKkN6cupmRX2GTO4NJK7nmEC7VLEMoT5hrWYn7p5tKx+gmKW8JlGsqBjPDJIBimZM9pzAVlesz6zxGbo0y+qjMHnan2d0edo7nap5RFiBMMzrcpLnYzrTUM8VaFvp0qjlFUyMQDEjQvOZSKhxvtCEPO+HkK/ESa2uPX4/eHGE3IUwoJdCgEuOkPF/qSu7K8Qplyx4dssGtmzgNHQEuGJt+lJkpzMWZ2W5WWuq8ha4neJq2gI0EwEQ7mVf0Y00zCr+wyQiyuMgwhjQhXZhZKTKP56ZL50bRRyj0dDnaIh5yAL8OYzctpkgchlk4CoEklLNhUTXE+iBOaHcB7BQns8upn/5iYFWjiyS+aBllbsonBQ+RHoeE+7CfEDaeoABpKWNIpCGWbyUqgWbG1OOVyqVcVNkRvRIqwaydLlc+9JNfBIjr42RUUu0SZY2x3gQ+JLFTrFeK95pwBI42s1qZ5OfnajKj6taYg7Asx5m+0oBLaJKy2+otCN4STQNmbDlMEIM2LK26XM38/PWZZkY2C4kJRkwlK2VS2dYjk9A7VQxxZhkXPY4cKwHOwxWYUy/20a6O3qmpnnmJvBPMJo0IVUXZwgX0WtTpVSj38PzK6aps0X0DNsXBK3FsM1u4BraN9sBAvOsEFWpbBowhEColyq0uTojPXhQV16SXtSJATZXjxpBpEfe2E0IMrWTzpCxVpapwQ130ffvA0A6rKxGNZoqdjc8TRBVJ3HQnUPEko8u5XgPaJU5Eie1snhTU0D2dxZkqKK7VLEulXyev1un/zn4xy126FSw6mOFa/y4HxuWVJXDNKUPs5UL3cnFb0x4tpJLx1pbn3WNu581IcXw4To7qvFWv18qxFnJLtHPzMQCi7PMJBv5h9NQh96mrFDNuAQeTJiYAw2UXHJ4nwduyBtiQJj64BD0Mo4Bc9KfqNWhVamY1a6NkGoyWVNpqjSJvbIuJKvwAcofbz+BOE8Gy0sW+XKLD4EbkchOYFhCgvAR0y2N2XTEaOylvJFfMILuSeEh3kikUJj3iogw1AIqGmIzcUp7FcqTJqgoYpn0duCj9Urg4cQOkEKMpx/5CiYgvQ3L4JJbRw7Fi0r+OsHNmsh4M6V1p99gNnu6+PxGdfeyk9RlwCObSeL9RRClhIDsz5xSV1+AEGK+t5fNYFR7Ep2jqmFPiVJjvYGIJKN8zd/VJ0KanA1QZl/gDEIXDI5suRRCyTA/Dy0mgJ2wefSVrJTopvKtLMEhYpWzkFVs7W7v1wCt1n9XktnCrdTkN3Ih/r+M7Gz78J08d8rxJkjvgjPA64x+nzOZI64LfKW54kSwUpq/duqcZh3ssder+t4EvZbYBG9X5yJavFVq9+SNqlQkhM95IE9FRld5PEPICsT+Tcsnhnx2xnGMWlelWDhrcx/UgU5ay9a9jN23bg1jSblIlRe9mlD/OOqgbXy0ZueIbqAginoZIy0Kv8t1Ca2Lg8WFqS+ESFUrmGWhtmUWysE2gdJjZFcNftWca3vuo1CJYLlhGpJfcw+XPKpoqmVJ/HlFw5xMH89FxV7YiOa03QMj2d7q97e5g87y3u9/b3t/cCne3X/3i5xng3VzzeD49cqF0U4FgSL0Z4SwiSvxkjCvU9AhWQUxXaK3Jcn3dcCnNaOzdM/BIR0xt8OPLjtu5C1TM6uSNXC+M3WBZnYtYj4fCJZsWnfC95sSzCb8eJTUdy03No4rp9U1WNQMHMc/i5cxufa4pxJURNDJ6nJUdK+e6zTRcNguEPAiduTDLu8xXKVbe4CKsvJmkiyXIWDraIUozgXzQprZl6T4QFW+AAySNz3AuGe2RfuPGOZKuvajxgLLeTLf+TmI+xbOOZ55/V2ihynXB6tLmt3kIHk28SDMa6j2NtdUF1zSpVTtSafwZEbyW1NptUr1IeL/hxak/12KVB7VO6XHZiCxzcViDTm0rUA2dSS9ApLpEDFI4fDBf6F6ySHkUZpdH13KTlRROHHEKlmNmB3ZblIixwNZZSjVAybFecnVzC6vs7PWafjr4/vDoi3lKQKWH0Wir1h21ZXajrcl2rxf7lKVTVXyGTHJu7gQuxaG5KibGXymj0Y0xAAmhLAk5BdG8G4DcbQUOEgaG9sJxZfHKvtTiAlzzGrkwFE7pFPYsslrrnjTldoBYL6WLgc26Pt7XSJBzv8tdXkTXjeZGuFrZ7oWni+2rqIYVxXKOEgOmFkdot4VfOkZSkLtXJ2Nd5lmaYbGjsVdPdJZlH3UGbFLse3MV/K/q4OwnermHK93Z22G/118d+h0tzV+1nqvxCh6l6LIdnXPosKGubqXqBiIgNi02uF+7pHiOh4KTzfFlcZk4qWcM5U8o6oVfPKpZgxY7k9ZamN+55XOiGdr5RJChs+A5IirmKzE0TeqGT1t4gdO6Lrm0bMHALUSBB37ollgD6SqmBI9zxC3E5LBrVJWp+I4+prnCMZNfyH7IYgZNSJ7NOm4KCbZCJ/1SzRYcIwMHIqXsFDL/GegmkJ/YBoSOG0yEm4KwnxtMKbckCQhXDSIPzqDviHZlqtYEWe7FQVMjlB8aS1VSlDxQUR9IQWFetVwwFK1sKypwjCoyNc0axWw5JUmgbkmxiagRnYRUS88sDx+QKEj3L0i/cm645WEFasFTBa3Xlzwu+PxtcqYPVCS8/0Hz7k/ve+Td6M7VNgLctbDrcnPIfpJdfqtw4G4XE+/BwJv4nG74hcCNFQvQ0UhlhD27XHhGHQRbKF7iTnYPixbuQbnAWUaTSq4obfxWNR11AnpCSn3E2fjCGqCRHaDsYxKsGMQsYAhe6Mj2vix1MjwRAnxCXWltfXjBqz8kvwx6nVD35hqPaErJk1g2a+Qk/+p8d01uBzacQg20UCoY/sDsipJnMFB+qNn08BhFy2TMNAbvlYjNDTfZGai+/b0ALrHBzn6/xz7Tw+Mf9nv/1x/7g63/+0yBnAALx78FjEA8j1JYpZw/64fyaL8nP1ghF1ldsSQ2xNXFQZxBpBv9Av9b5OM/9XuYWRr2g7go/zQI++EgHBSL8k8gKA7+4ExGJaDDLFXTHSsOpa/6mkVF8rG3rIxvqBE4YpVmki9oeDjfnY65OdILQgEEVnuOkhnKb8a0BLKWBlgyNymcdbSY4J3NOMXs4akJc2+zUkDKpOac4PYShnZkPHRaDo+9c818kzEdKyIA3lq6FIpzz9lbvDIxHbwCxXbJ0kFirUPOAB3SD/BSTA39WiSPGA+DrsNFttSaa/DCjE38MAwsyZKKxbIwoDwsnMoYyephCzHaaFdT7cXYIViywNYdZlcYtAW+opCPoOnbWeCVlvXKTSmXhXXhGn5Y5rSf7LSkApIrdxwZEQmEF+X8osjGElbC63CL8FV6XNjWxsDG7RRMKrFBuDN0rxTTa5wQ+yhbDTvuFYNRilp4oxsnIZxQ42p2vHcf7erAQSkaLlWZVh8YnIGd28rIXj8z2BRN54zN6XSqWFDR+DlnN4XY4OrWdwx9stbmOYuNPvCT6VSrqCa5WJRmN/TMVAq7A3dRDgtdyUDbHOVUhBuKX5JFnSuOLUcSqqD90JXan6bFF1w2pGPrUnRliF19XXUPlqhEptOXt1VRqYC4REV7GZjvqXXYfTcu5IQOJ6szqaABIrgWjIOt0byhHIQAPiCUC2vFGZUNbqJ8vHgdF5giN8FP/PbQ5ynSpOEf4pqSV3jehpY0e/Vf3tiaXjh4E9xVqVseXKtRQNUfBbEqrdDjNImnFy65RK4dlPWQCRplxtwNFfIMG/XWmYnkTTkczTIKxiiSUg0bNs05AWxJOThQmIyT3xf779X7c+WbMFvYbNJB8NP71wiv+FFDd91dx1Lvy+qu061weWAKaQNW7ITImbhZZhQHjsbcMUKPBxHvGAn2ST3EixrLX0jhXvJm0pVrPKI0n/VV0dUymEG4WHYb1MfGH3s9sjWuvDxJ8fGicGTE26TGySyLGqOj30MLAbVA+iHWJ0gYPavKCAvhVXDcZ5QYWTjwmhhOz94zGhr5r8TXx7IAntzwFtov0JS2UnruLYNYf0u2OCwpT83eM6AOx1sWcKXgtWAG0cM9A2pBg/0SQe+4QLMUpkdUJVx336MkNwJzEsL7LRyCCt+BiE1ciz0SFaRITIo0DJ41wd4hKYkLSld8BBr+5KnTtdbPNK4KJ6DedqVTVHrlUUIgYvq1542znWpxAB1yg0YffXRyOBZjNP7GEixibE1OQIAbDqBpsz5M4wmqzRbG+JQ3D/da3TFTBJHLgcSmA//8eBfmXQ7bvxoUcqMsmBZdtHIHC4+f0n4lHV/hKuWaOxWhOBmXC31xO6GmZiUoMFl6TcSmMMawzqJ05W7Zma6p0RbgbioZIDKeGQ9iAKdTqps0hC/Cgr4P9fchBoYMQ8189cdDt5qrsebbjB/OeZYuaoKK5xVmroZFi67hfNmjeXJ09tJEo3pvGPFbtjXG/QfoAtQ9Mtga3u8WRc3GIWCSNpk7bh2uE6ZkBly/RV5Vc1jy8rP9hOyEvNdTKCHOrq+wFopl41JucRbiOf0tS1sE+rtbSfWGhAfCMg5cYSdA20m0EJp9WWSGATdaJpPLWm906/Bxrkk+gHpz2HhWV6Mfo92W3Ze6U41dSQj4ER7/LCXV7+RIOl87XmLc2cbBHLF342i+5sBpR6NRrq5Yx9WPn52vvWSVM/jxx/353DKTBNqVp7q97f1eb+1lhY3WM4q+MisVyGD5I2MeKTzQN0BVQvkQSLjLwY9rdNN3eEtxIKFzdwRWkK8FVDoxuWghSHG9CydCUvhqTAEGmWP44kERri3INnhEUegUo46GDK1GoX/B2EWxKxEQir9rlvmsrRNfVR1SapsKlGmJLCPmlqQU2X6FiUlTPTrfwrOCVpFyyLk0zX6BJO3GcG4va63zlSROP2vsYX926ibyCR5pSoonpvON1a36yS16iT3yn6WfzG8aNBTqYmN78KoPazbqTrZHve7WoL/b3X01gZ+i8dbuq160uTtRq2X7Yo6Mm7/3g/79jvS9A67fWsn1osoQNYcspdFhTQU4m350pqSj4bcUrKoTsAhjigeu1/8HKnAr2fsidjkWQzrg5IPQK6Qz3PTvwKQ20LCZzJSfd0cstiOlDox5GpaVujzRHqjgjXXz/f2Hkzf/0CX3CpvLhpcsQnKC2EIvS2qjGPsq+V5kJSEYa7RW4uOV8fyhagczFs0H5YRx8ONnCCbrryMJy7ClhlG00E03GvC1pdcuZcHxklRzkixQbGxuiLeKSsb8Ue2Xv+F5N/2517/5UHCliD1fIbgY7A1gCiXu8TD4EZgOxYVSmQ316TJaFmQlnwmIAN8tPrdGrmAsQYmb8CSgo1eqQy4DAqmO0TkPmhTsvhu8o8b5zaJ0PYiKoE/hlcskjlXaofhj/hvznDvCIeF+hJ2sGoGr9bOIWs1Pa8DqVRIwQGa+MGXrwzjBAJiLaIZ3V3k5b7U8BpcqDUxnNgIUWtH1fcYEw3IbEr4buGFGUWgflGZ0UjTIXLAkV9YqGJLahU/m5NcVMH2rIPLcWHIb3AVukfiHTj3H/N8jE46ybKYc/FUXypW/ciOzEjKy6x4oTYtFslrw2zpCLT920+BPsAgXrRWEWq9Ub1p1nzhBxSY1gni3gzo4j9LlJBqbxNjIejeAc8dZHnoquJEAcwWjFN0ZUQxV8N27M4IobyphMA+xTxV+WoxDsnw/dqrbzUi9217s5SoGTErzlKOBb8Jx3lM84sT2sFTX4hKdegwVYiR7t1VKaatEjcDeLErdHxZPBha9TK02muiqBfyqfUUkR6d9Lxh3mUo+Zn3FCFb/4qe35+9/Ojs/Prp4/+7d+WOXbMmYn3WktSfR+M64ec8/R6lFzMqetK57ZWSliuYtH3rs4ilPPrUH+4OPtngh9XkXqTC0B921lD3kwB//5ce//bL7Zvfg58dOrbZ8fIbwd4TnibJsvMQnszn4UsCDYDy4HExPt2XYEAA76Hd7+Oe8P9jv9/Y3e7889sqg87mSaHvHjbd+hpHSbGlzeUTDueeCos4u+dlPfr+NX0ghUl0gE8S6GSuPHclfcrLePHMZ1arwbGYoZsBYCusjxTpmnBLOAggzuPUnvZuJKX7mNDdLFmxjnyYlAdA5MobOAdZZ7l5YwIhBfiVi11mQRrYeeWtxD89+4Dw1JRs+TLkiRVGjxbM2tKo+RTH+vNm8931tauwE1D+V+mc1JlFKOQWcYTCaFUL+zhSPpmZkAVm1Wi7I0D2cY1dDsYmhYwSdH0MahePTl/RDzizl4vZG/sZHO2iKG5vmtLlN061PKYGiVRLmXNCXJ0csp8YlRqyoRM0YgmoqQRz5JGptsC0qdfturK2oQbOMWJdb39gFQYoxJcUxkmJtWbHLOrbS2gg3LrO52gA2M/YPGDV3wc187mCbU9Fy0tW5Bu4do/Utt8SY9V3ulPHRIVON8f1OQudioXI0BPIF4Pk36HKdGY+pWyl45foLs0n4r1G6BEfyrZcvwTF8kyVMiPB/5zImMP6vtZQJ0vYvUs7EGcpXX9LEofVrL2vikPotlDZxyP2Wypu4ZH+jJU6cIXzlZU4cSr/2UidI6tda7sQtAPJc8mTlqfrWyp54tH9LpU88wr/i8icenV9tCRSPym+jDEozyV9vKRSP3q+2HIpH5bdSEqWR6K+3LIpbKOS5NMrKU/UtlEfx6P2KS6QQnd94mRQcw1deKsWtdgsktampkofI9NLBmg2zZaydjjNQTKiGQXZHJr0xaZODH+MtbZywbhgjISXKtIzycPob5timTt4E9UbQY6lrzDapoi/Wpr+tdciavcYtrDUkRC6E/5ro0yz/eNGi9IShqhgHyojWOjKF3FXV+FE38NRsWTP8d1JovENoNuK10U2bkAJyyVU7qrXugDtTL4HE06KpElg1LlCE4A56tqkbjZROTaIuSLuBqjRUKwvQjfexIJczhYT543h7/sNZRwOuQgvRLJtmS4mpDg5mFLING4gMUWdljvEOLw6Ozl52DOCmHAsL68agY/QoFTswLhQqHo9J4zBD/+fRwflBGPwCYwpPbEAGQ+zMM8ns85I+dRI6+g0pdNTgNGFM/iyLYhfYlIwiqSoJXBYoJiebTlqvFqtHqWA/GB7uf1jAXfahzD4gzSRdm1OxjxH3F2aTDnkGhpVP3RAPH3ZBHxSbYmzfCrk+gd/hsIrk5LyJbMmlovZS9WH7AMWj2N2BwDk4aCmREOLPiM+WUBaBdUXRxmvGpHQX0dEO7+GW09ZCfU7zZI52MYqHpoScP58cvbzTr7re7/X6vvfXRlO3TaEba9VIXd0bipdUOI+323LoHW1zF/VO4Yrvt9Tr2Y8H/Tu6tbGwLXQMjd/R9XZ/0F7X0PitXRexUm1twrOzo+PjU6frVQo3p+0hmp9g2zbPS4s1fHtYyUWng9TiybZ3Nnc3/TM8T4A5tyiivzl5c8yWbB0A4UYHsq7pnmz0U8rVCJ+61ghMCqYSUZLvc319HSZwH4dZPt3gvHVSODbmKk6iLtl53Z/DT5flfPb3k4O3B87lNknGmDtIT/yjI1EN2uUaInhH2gTAjKIAuxlGiLDh5vExJrgBTHSGbgp7rLiV5u3tpDe4kdxph+2TjTGyzOyuqLHU2XpvZ6tX2UKfGTTVEDNlgp0iwt+j6Db/8LcoBb+tXDZyyZvCg1a70CCZHJkncUC1KdOaQlWaB5GstTgNTgHDDtZJ4M5dO+gdtybKNk9H0heuNviDltTcwLlOZfmMatcQleWpb7ET7fSwqKyN21Z8ob5ErBFGuHhxRqBgTlVpdKLmWKPVA40WlFq5iNKb1q45yYOVbmriX0enCVIso4S1dDke9CnD72GkDmFtj9b59JGDPY1s3MIqg8PmW44dMKO78v3EDxzZTrgZ7u30emH/1VZ/+wFDTOaLFi1j6wdsDJNBiRebgHyD02M+aahdCxVBt0uln+gxF38+wG8qKMYOWDwHcaO1EJNbg2iCAZhUdgFmLDJ4ZJTgjeHoDAVtuRmC15nsooJRBDU4uQYavxZ8b4w8Foknj4xphqjn7Ew/hDwX8QgTlx3BDBFK1A3nYI9m2XSDQU27KFogb9oY9PpbG73+BtkpYKRdCT3r8uR0JVcxRJmtrk/3xju7vc3xltobDPr4QzyOtvd2NqMo3tyJ48kDNoiOaLmgw9CiWGFOwudws7PTg5O35+Hx344fMERJs2l7XNLN54xvzbDrD58OjrU9i35+Z5AKzzjlduU66A/2gDWYlLERPNakkHgGQie4mYUENhIxJAeoCWv461p9C/dBF9vyEUromr74pkWwcxY1SAgjjI+bOUFQfLHqzrRapHS94L0HcjkBJwglL2t7zqAcGMyP1sxu6F9EYZwsbj+RxS23EHVO1t2Ls4o5jmX5VYxyn7Z7e2EkZmlMCKDQp7a9WpIT6fQrIVcvzg7evgxZpyIl28ACNCWJYqE4hr5Dm62bikRLilnixvgtzt7g5FR7yhGV5ujtWeCOOAheEOS+lEEvxCyv5lEys+/VJ/a7UDG+N1xhK/tpae6pWGkeMp1tXih68gUwhdjui8O3tG+QCMoDdqbQTG5ttFInmKx8wY/J9DI4wFrPEcb4n1FN6+Dw4HGTsMRw19YngHqBwb/k0nzV8f109hjiHagDFbe5kEduR7KOR49Zx8M//XTWCd79Sa/nSTqGX3/6E0pkDipOJzh8+6c71twWQfyctcd0oFkNt/DJF193o/nN65c1oQm3B3KKnxN1/ZiRZPk0SgVYquXRuF3BYN59xmGGxf/cwYJUvEyT8guOGcRI7BGH/tMjxl7Z6I8ZP9WTv8jyCxJa2wMRNlcn1a8n1Ffuz1yc553gjESX09qWPoQtD+JVmkQPGmKalRekPH6GtRYLbAUUK+GW6dZLQ6U+SJYmVTQtELidkWGTeob7oDfodXuvuv2doLe539/e39z7j15vv9d78Ki4lkmbw2JwyBWG1N/r9nZpSP39rd7+YPsRQyKszvEF7KvWkYEOamBAGpyA4R6IEmy5NtT3ZwePHRRIvFeqRSGb2ndCq1SgZjN8YCxf2WE58EKUuOqGJ1igJO3PqU1CCsd4sT3oP3YmMMstVQ/NNqrkC3ITZgERg/iqtnwGp2OFUe1sb2++emhJmEeM/jN1cwJyRs1cNCVnVYsFZvugxp6URcMplUrIq9IMSlsCt6EX3f/UG1fqJ3JXFpcabiWzi5tvQUL7N3DH4xunCtnErfRJa7+4jAT4uYMJotY1yQZCHeCVkao1QykE9SWThW2RgS8jylLN67O7vf3D99/vHb46Ov7+h97ebm/vqD84PDx4GLcwCBetc0AnuApNFh7kkoHZcLjEX5Utecs+aa92LZZBWRLKa/DnLHgdgfBySGhMEvQJ63WmlLGWTqHR5YgMpdMMC4LDP2gyHcG//bC/tVHk4w2Gc9rAiaG/wmn2x9ebm6+6rze3N2vzz8Ea3YfyZ1Hifx/NtTCqqyajAesNa2lOYWqimZHyUlU+cpC/h2b6mYqpJv5r0Exr6GRiAuICVreopmfnf7Kiayd4/aezKA1+QKUzKcaZo7p2UH0JSVF92nX/arRSb+SPGsrvrZbedlC9JfzskX0FOmhloA8by7+yPik+3XbFIifBGDsVOaW26zZXA3CeqszFb/6z/HoHfDM8osGJx1TGIgeGzjHxBNMY2WAvQsBBWnPFiFoUJO5CKBvXEQnjQIx5xcV/1KUyFNes5ohtNb4kAdFWIEPKTk61tAcLy97jvItFVWeJgexaBft4DMyqLcTEQ80I6/5MLJurolkFUA1DG2GyLsa1ALgnoef8OusKitG4FlBpel8vmml+e7A6MpweSEsT+9aDoJTO6gRneXkZHJDMH1UIJPHkIimytub6UCSgk7N3NNl1weCgkaS2tqKQ07iyh1EaVdDD9PG8hxQ4yBcuaIjPmUF7TUrMEkBNCuM18Je6y+m/gzXQt9b2g+6rzXCnv7W72evAR1EJH21th9u97b3+bvA/618qDnL9J+QlOr2xEp0UmanpaDw5rvIA301BrMFaeS6qbIl1tceE8I9c03GhH2oFtFL0MMmlODaV+im4Iv1klmERbLpvOka9rZfGY/JmNkmZxdIO8Tm+Ef0MMKdcI5lRMIRnWWZzYuMOn6478kdZUWZpNx77YRfwKYguLZ2q9VNqntlXNTWL1kKT6wFwUrnlShUOp0KoqfEHi/ExxSoeVKgAh0IdwdO/nJy6igzXBLNo79dJjBCbdGFp3YeqWNCP9bnb2+pt9VYv6zdFYaNFZvWeeriLV3X/cthEU0vcSuhpZFZ/WaqRGq9Qo+hprkwpVRb8JnVu3E3WMRIJBtc7zzUSLhfRxkE+pSs32vh+qdKsuDhIclWsKMUZWUbLceaD2yU5HAOnE4k4h9yooRwXPVPY4gMV7EK3LFe4snwVZ3NbZf7JObUrCJhAdPqNB0zV7eZKiuK5ZYe9OqFp8Pro4BTP+QFXL7bglky/m95mEl7idu2hiW+es4PKOOOFs102TJWJ3yNNgAgK/+DnJrn780f9+x2Khi7RoLen3ZFOzbykxNJb/JyxSbq18/jmrIRqchV7bZ3MteENW1FS1QUz2jqUYPYyYIgdJVd/GBzEsSZqYgq4cLipNDG6oSrraCLWgfY+iXyzR9piyrgIXPGyUIsoJ7hBaTnyb6kXRYq1hRgDlKt6XkabF9v9wUszQJvDbe8zLLCj043rg6aHHZjpJVXUuTJqbBTkFAqL8gw6xEC94+C/4JhEia7R+qRBzQP/GW1KkC/PgOG+VBwptolaTCJl6xqv442ojsGLcsaW94XC4iS6nvjs5uUDlLovnf745TMff5+kx98n3/ErSXU0LC4TpHHN4vTvd5bCorpT1VJYUpdeziGyB4Qxx1Akc8CxFB6+G36nOVFjmUSUf+ulo6hTqg4ux8w1yThFwrHmFYquNggAxAOsLTrXyWhSXhvHKuGMfurQZZTHGMbeMZg4c0b+xdBErH6d67hFUmnwgP/nckRp+FSmMzaAiisVrLo1mehJhL53leLwXn9NaMUXO35E83ixDJdFNF3lCqbCxvHF7eWST+F2wWXA9Cbi6bxKFHFiKhCLn9TW/8WnqYyxp7EkJXN3KQLLNZQdsegMrfcwbqxTk+U4Q16BZUdq2qdfoqC8zqgf2msd1+tq9ylVwoEbJppqwcGqx/I67AldFrdHlPaN0lQwTRcuTauqTnFSfMRK7XHoZtQ+1uVeZqV1UOss3eDFNFpO1UuqpIWXIVYJQeyEm+BFNJ1i5WO3RDnNO4L0I2nFS0GgN7ApXLsNPdCzByLi0VC5dlf7Y8V+Sixh/PsN98vpFawBwG+WPWpurpWL2zQLi/bOJ2PsHglsBKvC3aJzuIWJ3qry+5N3Z542Qj0xkmu9bUu005PNX0JtR5JT8gYQ+Xdvz9+dvVsZrkNl4VdkRidy/lVM6f5gvlJzOhP51ZnUXbK+ErM6kvTVm9aRyGfz+tdpXse1eTaxP7mJHaf1azSzO3R9HaZ2JOhf39zuK/stzfz6j9K2K6U5h+qkFAXP5vxhnRPhikNN2ZDse3hWcgX6aVpo+zDJqKKF32O6fprxiN2aZWO3wNdBYeaR1dZSgxgu6ZUOFT1n0611P6CHAqQprPAJXPMmW2INiaskzwjuyGn+WC+5RMDnHBMu2uZwpKKSEfGqs7C4ZxbMA36VAhrfoppEaWyP0bitzRK8OTh0u/Xwe2FWBVOdsaWIUb7/4TB41dsaUG3i5XRKWML7wTHCAmfjUpXBCykz1gl2u6OkdFAVS9DzEscaL1YGUNj/bqKi/xFcwoGL1TiZI5AHFukrgmlypW3htKZVSEXuOKJia8tUSiYnWEMdlJHgjFXK5EoeZHeV2Mqlcq5p8fJmcanSxmLVvV4X/mwf09+b3cEmVq2ufrilC1jf7jd5muV7e+c5p/gqSeulE+6cbudUwwH7JCYpLbeQ4v3rEsPYEkftdvREsu5FLAGJ6d7aizBqiquQFijcgWgED8RUTwlVXX/54LaF5yuHKFWwI/KPoZoSZvdTmB5uMzqQSwhNm1gpGMQC6Zq2DhU5qVXaJlb0ZCaHylAX0fijKp9usNLeVzdcOMutLW2uxopC/fSgv5Kxtr22Zty/03izIpxE82TWVjj4u7OA2w9eaJktVzFwtg48N0oikOUmuVKjAmF42EBWB6jgJ2t0A8v7ZmFCap4F5tA+UptBjBLbUrPpKxrjLL/J/hld1db2IzpWZm2tbXUM3JshmxS7PLqWQgo1yrfCrbDX7fcHXfEvV6l/WtvD17HCLmKiTNRtS/q36nzoCI8vtZ66Pzm7aCjJQN5YjkDTXt51XqP8Oqmd1xYxb9CSxMqQ9DMUawPVjwZ5MssxSZJkzuoggdNmTlFWI5yN8iyKSaUCCRfzEYmPJRXwI/14gYaO2Sy7xpZFgfFBT4MXOj5EvYTbgsHhQUegGQV5ruOUK66WhT1hkmBPgPazvk41N9g/R8YYUaUkrmKWsP9N+eXB8YmRqgGFx2FwitDxBPQIhJJRBu8amChdR4RSMLmr40PEgs/QZ7DI4KWkdGxiAixfl8JpmA+4ktpF5Kvt81UZVr8X9rfC/j1ITk+jJ5xL7buKjoCunsNZtoyN10Y7lDhjglz2UmqXEINmyUc4FuUgRCDg5XwY4ma6mnccw2nVZWT88x0uHWN8WBphz83UsMq5zbltUNJ9XWG5WBEx9zah6kwBkXFhBSIsgjBCpzFHofnLtjnY9rtHZef3ikck+Ks2wxFpdATv1FZYIo7Aw48K6wSgdHbxTV/gOMz1gqsfiHQ2CaIrGDZCXtfNjDNYzDI4xsAdVbn9aEY4RPJfNwzWGeRXHRHr0Pmlg2MrRLSJyyozRdyGwjDJRZJLcKXLwbkAC7MluAPTLL2ZYyiRWx1m7mwVOBdkip0EQxpFEg9xp/Av2hDNJiz4d8JrFc28PgncDqtBOnZGwYZv2FStWKnrW0lWi7qsJSt3+93t7qDfHUBzg629/uDV7qvuYGcPfkMHU3ewud3f2955tbvTNXFJ3iCezqD4hfng2SVi9bJ/IocbeWrDSRqnKgrVrTwwz2atQTEeUAU8oIy2MvWkPcfkIRKanbQF3078MRlFaXQRxfMkRRNxrqhsUjq9wAa1ffj+qNCy9BIzfjw/P70nKvQHHe5ucmbxpSBC3kvwJka9WeYzrdpgcLeuIWUj3wN4Qo80V5gs+4AUDf3CKItvHmPlu7eE5pkLIlshM6Beq+uyu/vqdhKlSMIKRGr0y98JE1YM9Lysd473RwVKZAB7X2o61cbdwqqcU4RfcdfavEBiiWtzaesGpb+/tdm8VK0JA+sH4jasygNU7NKba+/yAxZW6CBTi+YDqj3WCcMxFoT6SCjmhBwe6eqPnp8tiW32BCtMHNUBmnbaxeDtGDYck8GTZt3Ww7913zNl3ZOjoRvfB98cCqHQJX7bAJw82FRbcNV01e7eqNsfxJvdCH7vbg12dvpb/VdbD4iL1Ys0V+Vl1tpCeWvBXTmTeZonKMRlFOLeD3fCnpTG0faU6TKJCYoVA9A1lPa+bWDt3Bg7OOJ4jlXkRsqNi0b0IW1/oU0CxOQ3qOWvef5p2PiGDLaimN4pEGgBgjC7H+HfpfBlDaFOKTrVyGYer94rS5GeSNIC4m4wWl+M9kHwzmuIYl5GqNCnsRdMC28jkYOwF/Zq2+PPx+ed4PQdol2c/oR/ZWfnzWvecuWj9TeJYB4bmxpykert5BwqEzJOC0ggx152wygq2FWizRRONVuXrVrrBlq95PnhIb/QPScDIZ/JMDjEEhu5NrnPXZIj0yjavrzaKPIFRu26zUqr2hpzqWYLWW1ZZeoGCwHATsvtFkEIHBCVp1QaU1hR/eAn82iqNqbJ5KGnO1cTBUylLYCS99K8jfVyD3ztptDAX1gWwQUr3ajQXiyA6aovLo1wt6uKIy6R36o8cteIbxdI9Mi/tEQi1D5OJBGif2/WJ2Q8He9zlvAJmZ+02sD9+JvHsD+P15lWReR6Ep4nk4s4UMuiIYTz8+u/++eGO2qO5Nzq+Qlh7Xo2iK7bvIp98lzYKo0SYODqqifeh3cDCZgGXDABDcCK8JA5isMURcEFB/hHv9/AswpR9RBWuyW+C2vY89aQHG0sq3MdzWYdUPCXVJcMi/rC4ZihiJa/NK3aY/LJHBPT1iUQRO6zyARcwKOpEcNO5HWW5kw+NOZtz5xm7BQwcbqtQqUFOktSgsaCQeCIXvKZdunQUSYNU9GQ27m6Hg8ib1S0WeyGxky9cECHXTFrfe00RLzr1bOCLG7Nua61yKn5NJUJoUx3MOxJfsiDeP4bmZ/GZEzUZMCQm3x18uLKRUvj1ufr5Kg6Wd72trN19vbNae2cwKY8arjhVlb02q7rpNdC3b4j6nUhy8veakY1WHaXT72WX+9ItD6q5UCT4Q9vLGhsSjeRwmJfSTEXuyZ9SAo/Uu8A1ZHJwOZdI6Ozq3Vv7nWtuz/oYq7MK8eoDlD+zgaKz7p/x1jpe2dgMqFJ09FIOVcXgUoEQySXHwu/G3oD0W/Z8qyZOGspE1wK1fsjRDECBwHkOu1/N9SCBhalySPxDAdDpvk7cgqgqZi+QHWVp+8B2d2m2HhbUlqtXiZOLFUbIi2G52SMmxqDqCvhWdY/ckdBzZUKaVbjPLjf66hI19dLTifm9FlDXyeIM1oXU8LdptjUZaiNqyjfwBp0k2VKZceKUB+oFTiHW0rvSUMcjLEDR2WSu/Qy+GiRZm5kh7rGfG0SKmSCpKmcVCdEICjgg5yys8oKvj3dxqkAp0wzQjPg7U2NcLQEnQ/pN4ZnaFX4AN3g21bghifJzrOAE+GcKnOmkftoYgIqtMyCwxkfaf3VSzcRP5srvZLMeobXUZ4OO3DQ8hz/SegvKztEswabITwr9gaHpU6rFoEnS91xs1m4I7nR8c6TutEsa2lM/mWxJGbjHiy3lfEsKnQ0epIm6FFku57pgWQE0TyiYAxaVDZvDpnM8qkubsXFGEF9z0oQ96NF+L3+yY9Joq1K5UJDEBxXuUklj+G2GcJWnLhgr/BzlKRaJZNtR+GfPHixNbrmwMqRqaogg1uH0mZyZ3UbPNXo3KTKGuKRDoUzoGbjaMEVgB3mznkO5A8Yl/yem9rQ9Aq2S2zBXEkNZ8xsnfCf0VXUOOnLdNwiWHVtyqU7PBhiha7O8j17J9HA0/5AoofdB1XJzNjQUdzP1Ty7Ioke9KdPmKj2T/hUh+Qh9BxoYcIn/LWiVKYFPKhiFjW17KGJHFYNLJrjuxcP3IaUIUYhCTq7TqfUeE94JXYCuAFmSclYPWWAsTUp2/Gw9NQiyksvgJPTevKIfVFkkZFmtT+XV8ZNAIIfYUKoxkRMLVpd1J4KaaXjHgJvGHqwndqAQsk4sklUWBY3mqHAcQNnc66k9PxYtLOIQZU5plClcGxx9Jgvra6JoRW8mso/T7CCGJdXJbm6ON4BpporiI4Eq2KLrdD9FycFBmDFQCHOPAwA72Ng+ujRcXOfRjrMmsxqcjPkmCqhDKj08IJ5UMNxPlOLoL8X9Hb3Bzv7/R4nfFOw4ZubwMpPK1eHMShWdNmvwAIywk+/7aCLzADnOqI0dlciksx1R5JkGWSOYC12gq6SSJoxQcCFUpgrWATbW4MtXNrN/s5W2EB/CApfggD/YRsGtnVnhFKsJdAd1oTEamCedeeN0QpFl03mjAr3FA7rFpAiDHjmu9siEXmp6IPN+mYZbN45Ry1etM5MobzbZTvxypNVGQdt8ldNY1mgl3a1uhEPW+rKMut+6hv6kUusbJOw2rvBd3Zy/sOI3KHPi0ztIXw/J74PQ0UIQtHZNYuW3VOBrNrrN3jtN7ebptUQ8PBjdO+JMarGyifGU0BFOqOaUlSL3GEYrs5lwTmrHVtOQ7NUNeGeHJ297LjqFepHNeLlZE4znHi54fWXw/BO0lFbo+tEa2tILBZ0AbnPKoWoteHtkC1YfZpZusfZgi1YFQ2tkZT15ooMty1428L3770ZTId+8vBKm4Cs9rfsAEc7/x0X36Gitu7HomxXkkpdC+Zb56N7EHFNSqiHGcnujfl8mYp4xnYsxG8VUTKyAJUBC2ncjov5WHjGQZ38+hiESd26Dlo0SLY+bgzKtFc2IWQlb4Y1F7QXSEZq0zQB0rhkidurGJRgk5fZOJuJLqItDfkoAdkqT5yNA4NmLAOJh0inBcvM82ScZ2jwT8YEdIjFUxALBzu7YcXAPlx8hAE5lezGv3bw5lKjLPsI99o1ynK5EHPtZZrBxVQk5VKk9msyNHG+YRo7USdUep1osfVW8BaKTZwa110xivpGjLEwJ6dcix0GgDatouNGslzDuTYFarxwuUfHZ1GxOIayGy+Nr8jxHLOOu3aifUnInI4Pz9bqBzNK5t7WaohdqKmyD4lbWOfABQ5YIImbAmNoRUYZnhvKo6gEEwLzGvIEczDFkISIIU42KuloJtOf5wKa1AmG+rDKVyyqJHYliuW84Uba2a0UGyEOUt5ctBmlSfkHWJlJvAspoX3pwcGGEqBn3k2w764VaKrM5GzCohw/C5/l8z+nvCdspmzWjYA8NPEFJhYT9phEitqzOpn5qZavVZSDIo8CX1Q21SjEDTJLppflhpm8bhITGHaD0Ld/+e4/irdbP/7Hmz9vv/mvjd3Lk/xvp7+Ot375y2+9P1VgO2VrtGCSXTvSjevbX7Nr2KQTrH/6IX2vKzqqOLDa9v6HNPhgJucDCM/is4bP4Rfl/JykIyz7yL/AteD8Rs5MuFzlpU/6N7dl+GKZ0uaGz9O/opNkDnwRDzPdGIX2geCtJlrOPIPpz3INtQgNd9wmG5wjlqURFGYRELIezspVoq47gs1urAZF8GFND3jNbRoY1Ic1Gf1aeCe9eqqxIhnID6Cwq7xGv9u2Hsrd9HuEV5fVdOTNR+PgeJnWOvCLWTT6zSzamoxWL5szETBya4b1XhE7Dt531KuhKKAu4MJUAv8MT5K51qWUavgy5l5FytGaFsIP4RIWJFdIvIfpJGTrMF6uXrNMph2J6dzrUQ5FQ18ak8ptVLemDXsOEec2t9bJpHXCgPHTk7NTDAZ1m/z59K25mk2eb7hWt87SXPo+0yy/Bl6n4ovPQbWCO15SO9ld6Rjrna/EnAoUfqoHDvb3BmEf/u97HxJMsWm1Wh5Bwp3qy+ItK/IvNCO/vr4OkQassLjBchqKDMWGvl66TFz9g/DTZTmfvbQ6x5lcKyS+zKT4oH6rkMUH6WaayoVGAjAI/j/AlcN5BvSTpP2Ydik9gUV4HV/eNKZ6yWp/otN0pSm+3cj41kDhpBjaa2MfYPnlBpaEf9z5Why5mkWpPOwage3ZotAxaHGO++zn1wdveYf92k3S7q/8QRlxxESC0f4EORYGB5gM4CYlMj3azY7dhgnbi+ln8ccT7Q5NldAGlCWs7Ip0IPyVxIEQD6BFM3b93R5s6l/R9h0tiuVMJGzUGCrBXxV19xelQGb/KwIOXkYwSy9XjkvCAYQyurbqS+Kc16OTvEi1xwceOSNo0eLxTtR3HsxtcUi3DueB0WJt5w2zIsogHFwYhlL9RHWw+LX60NVSQChp4a/JJPFLsjWBWd2l8DQpNxrB6jHqjbzboODYbxpUHP1lJXIDJqBRyRn4IbeaJbcRwvL6lWaTVj9hzqM+haQ9dIIZset/whg6TnSXsSZ8fVqyyWA1KQia6jam8EzOqkFesxICW0gomTiKHen1P7kf9xgaTEk7w7PoBm/+ZQxrUI7hr2RxtdNNxnP4UZVj4MFf3cwDmV8EXEbim9+dnQRvsljNWMG4dkFg9LZ+jbMY4txt8Qw6FqkFjA20lGROE/r1TScS7Ufff8P36L/CDWqiSKQV1yL+zv3srgpKTtB0tYwSWfojg6LYwd2yZDs/glzUDMmxIhVLR+JykkpHt88BZRyde2+LXV+MFxMA3nMMzlj4ha8N4JKJVNOFk7hRSjglYA4ZKmmeBuWolkGDORfLdPUJAG1uUmJ3oUZNrhZy0h4a0NKv1YiUPFLZQcfPlxSbYxJXQVei8VK7GpVWy8PWxqHL+JCALM26JDk9UkTDLCtIAag1jbN6cPrGJA05LgyzPx0fRsRZtLe4MOTe0EkL6AtLTQ4VzTqPszD7otCx2rw3Civ83zHfNArrjOFNEQZvJLYI2N+SGw6Oz19THbAspS2kzZ2wAASHbO1LphlTNjBXbHTJKNYSJTM9H4XkDD/A76Lc3JTHqZD6TAtSbnCZsc5m81zI0+Ekc7C6jtNAkEvWgTxDqFD8nIDe3SYoxAhV+cmNTjLSVk1Q0jllJ8rnnrnNsZKzpyO6O3lHe8IohQe18moKT+CACLqIg0LIqkxeEIXNhITPqTwPVs5qc/gvn9tTG/G3mexTG9C3LK65Q/iXsXvIoOo1P57O/CFcWJf90C4JY4+7Y3S38WBKe7LuRrglKVrSvyuksO6JeDA6IBbJT6bNoze/dIIf33eC12qKT6ASWZ3QU4yPGV9wM6p8rpz2XDntuXLac+W058ppz5XTniunPVdOe66c9qRjrRZO8+Vc64F8QkuG1vdbN2UYw8K3asvQJXSejRmfg0uS/ttZM+pD/tbNGXpE33YYx7+iQUOP6gtaNJJ0nM3dCKPHWTQsUkvErVZuC+FWNWsGWTFMo/dYM+DZlWfycdGGNprQQvw13+ItldP0KmnWKXiurPkFKms+nV58aFE/7lxLnShAD9LySAaMmwJk3vQSfjSooRPQa8WGiQ0VNN5M42GMOKEeNHEDlcdZ/lk+jdLkt6pKeDKBHecCmVBQtUK0A7e+k9A1U5MyUPNF2aDI9S8oRvfsz8+1/55r/z3X/nuu/fdc+++59t9z7b/Prf0HExEvx2WLwB7SQ3C7kcshsRhU6g7Bc7B520230YYx6UzMXuGXqZF46WNsW7UJXb7ooaBIO9K2UFb3xfmc5Sncz0q7UnQaj20Jmi/CJhQ+nWiVD+3pHWqhjyD54oL+WdA/JIDRD1ikmYD72DqHP9lgtgY0Ac84ZXGknVTup5zUn6nh1Tbc2c08Ap1ifD/C9ZOQZraac3daXLIxMOKSkiW8qNLq5/dWYZKQPyddCXQzUrZ1Vpapj+n1qnkcTAkG9GH43xhxwfQdvxrUQXLRLv87OD0xLG+k8GAYGNooz28CLZ5EbiqyWfCr/kiVUX9Vbg7ybFE2lj5rpcye7u6h5C3z1iplvn+ticOJ11MtW+RzaG53l5y7CsjjJpccuQtrFW5j4al9FH34XNp9SwlC0UfKO56hBbm6kT8uR6rLEsDKoomjpLd1lzpdBNFkwnBTYhNk2JYXaHYgbaYLnWLico3zcYx1uVw0SOeCevqAWgqcuPWFTrDuT1gTIx9aFupmymDtrEUWd8fx+Lp48Hha1Ed04ebVxyKZ3qsOokXa3dtSn3iWsDiNFY8NhrVhHgBWr4rJPIHlqCcYpJffvGw8afzKiqkh1WNmLvLK5/egJrnt6FQAlebIJ0gyZOXJLUlqoIww+D5Kb2ywPkeoeFJlJarDhVEqTEUMNBUSwBRcrVHKQsEkmeGkUjtUu1EbAQlHkpSvlB7UhkRDhh3PQzDUf4dSvL45sz3T75c26rg7SpvjrOLqbVajZZ7ZO+bucirvND6+wRZs3pxVG8bqwuU3aet9NvTeY+j9hq28zyZe15b07dp3n427z8bdlTKYv3bLrouTAQq6e6OfOh/deZFb6e/2e5ykPYwQZhRzTsTVvWr6TkqL407ck6LLak3p12xkJzMi51rBOopOqxRDbJoWQrhNyYm1bXHh9NwJWF7ZlAWqRIJ5s8u8LeYga+J1VVvdT7s7Fzs+CsBomczilu1s6wdyZhpXjdgQUlG1sphtYY+z3hXmE6cYlAGIQm6WlMHZjwecAZByQroilDndRAMa5GRr8krt7sXxTn/U29vdHfUHSvV6vdHe7t7Ozu7Oq1f93jhe2Th1qcYfi2Vbd9ihNF+bLD1C0kAQo1eXLKhjZO2ONgd7cQTD21SbW729vfGreDeKt8ejvfHelu8+cTpvaURHfuYGgan5XMBQDuwtNSDMeTbNozn5NWagWC7JmpjJliooeHUDUSoR0HlDYUBYYrPPA5v7X4mFo+m8KMbZor1wy5iWBgi/hNvIGTAVKTArKtl4S0xzoXSRTjCdZaNoVpsX/rhpIGoVzRfrajdacZDxESBYI33+zM0SEJ+K1mSg19y8FF+ztk6XMn3YHT6BolOEQkJeyp1Ac8qSBLfoKu8Yy3Z2evS3QHf3Gn1hBB7syBZFkcCesnh6xSL+RFh60mSx8bLOZw6A0ktlGh6EvS8VEKavCKcLu3OyCjhWa3XmThGZ28Iw63VLahvKrdm2LLBmG2z9jUMF93O+Mc02+mF/EO5V62QT3nprhvYf0fW5EJeW6cx1bRgJhuRUBO3TIkli69XcXnLCYOxmyMtwM61636Bg89TlKPSO8YpP1++RwWCz/8WUIG1SrssCFJgoeoCWN90txrUMEUdcV2gsLyP/EXZG5V7RNQsKA/trMe8E8eLjtAOaE0LkpvjBFBMp0iV9/M8or595eO3r0Av0gvq9uFWR4Ui5wr8v9x8HP1JN68dI/n9lfS84BRaMWx9mVY2X/OOL0+OXpnjPVyVWH57+5HUTlFE+BRVSm3epBFpNzN7ZWllK9MzrrSR0pJR8Sd3UatB1NKJujNmx+BR8SFUx64YagusH7hYcZvkiy33oqHuG2b70aIYa18XIB470NHKzpO8ZGbbdsvpkhlbRjx44rJ1wM9zb6YGC/2qrv71yKsN8gYj17ePhkxIzJ9h7BrQHbsMFCg9STUXQ7VIhXXoscOgK8BuJ/daBBRO41FS+yBEZfJSkBLJNjuMgmqDXCcuy43RFuiogF+dFu3PXLfMaCLqnVlsLLg2XjcdLxPvsiBDKgIFY1RjvEYTMh9eM2kvUs8XsXnx9hGXGOCd1owhkf4TVastLxNfqYmoE8qONQa+/tdHrb2BJPPTtd+fRDOWOLk9OFztEAw/iNNcvpN54Z7e3Od5Se4NBH3+Ix9H23s5mFMWbO3E8WT0OgOvpXdAxaDs1DSfyczjY2enBydvz8Phvx6uOr92gRjOopsjGBw5uzfDnD58OjvVtSz9XHXBrqzmfBWPNEwCcj+52Oa9k+dNdNDuM8TgbpzEVKCX4f0F589rjajq6OdDkNpyt6FSLNrUeycs41N0vkngI016iUaGMbgptY+au0PqrZoiEZ1YXR7VImM3gg6x366IE5Lxicq2deDV5Zlq0xmjzPLoRUHaaJOiMEEXRwAlCTWns7BQ5NCqy2bJUuh64V2lTGcHNYWVvoPWREo8+zwyi+iqqL5UWwAevvPTuxhwq0vOAV28UxSWmT3Vn+DcaPvDffi/E//d3qklUOG8XBNzwOTXjXqt0WpqrSO8NbJtCFm6aS3TaS0fnQWnwVqlxgSPG30ZLxG2GXRTNbgp4HbbtZXZtmpyjeGbWJLhG/dgcfsS7xjVyjkzwhm4N88Kc598pYJqIeYkFhmJZLJJxki0LU5WqvgQPEFtjdYGZdBHZmeMEM/0uohm6oMrLeZuV3ORiC0xn5sJHery1qyyYLX3Kpc+mywQuZTMKqe4QmRrHkiloktioWLyTsGgT2ejJnACahRXZym88N5bc+qwXl9Fge+eRU68+wVjuQzEfZRnWnW2a0+/5K7fWM6J+2mlxCw/UjmyZL9X6IynHn2ARWiy2hNvFseqtuk+SwjyqKyQxU3QQTOG4LicRqYScImoNPxyNHjYAQCdsmrySEisHC9xV3707I/SJ+r6A+z7EPlX4aTEOKWb+sVNdwr/F7+bdGau8ZDeECpiU5inXHBRL2BPuWX6zKNG6vwAJO1BYSLCwd5TbKhztJHZxnFA9zxFpW/pDUfsKrcYW+FaqAutX7Ss6yNe2b53WERCakmtHxQ21Dt+/f/f+4qe35+9/Ojs/Prp4/+7d+WOXbEnwK23B9Jxx857ESRE3zMqeVPmvjKxU0bzlQ49dPOXJp/bInUb5IORVtK5qFt5De9Dd0gwPOfDHf/nxb7/svtk9+PmxU4s3VAmtfYaz5wjPEwLylMbr420OvhTwILAjvpTC5Hxb1q+IQW/Q7/bwz3l/sN/v7W/2fnnslUHncyVX1h033voZRpoWUiDB8oiGc4/qfFIBJkti1qXs67e9p2Uy1Nvo4kBjAc0s7DW/do8HQ+TBc6OYAWPR1bZQdEPYMmJBLIAwg1t/0ruZmOJnTnOzZEFxw4jbALeFJ2Owqxo30xRjMUpP5SD7zQ0Xg0tL34JSZ+uRtxb38OyHztMcrv74YpashJFynczicWRY2JOE5fmz/MNyNtNUYbyVIDiQuoCczDK7aqyl1vGc6ArS9So6Hm9ZhI03yoYz/5QLWNNCPkMLdFXAoEsFLvPAaH4rY/POJuEX8A68iaCL1A+60ezg+PUPt3gHdne6qzsIcCTodrvI8ri1vNXvb1AyU6C5UqWFye3Ev07KEn03oFtG6UPGMF4sL1p0C6Kfxs1+vXUAaHObPYhwtLsiYKJzYT7mXj3+hKZaypGgSAodPWBqWCK564V1g+n4EFFMrVBREkvD+JaSA6XJLBiHFCWH1ZoFF3MSfeQLREzDXKIhszUiVhq/+kQ2+RVGPgEGDB+ruBFhVpKJuTmgSzGmHOfG8bVI6cUPoe2SvJFhNGo7U/Rn3/OCu+vA0Qa/5+vJ4qe9OPj+5OVjhkKIh20FL1AXDKp42zl5CK24S9uKBcOMIPYNO4RKv48gVSGmq4t+/GQhEjKptoOnmFmG3mw7KnOt96m/Rgq3duMYoq08+SCyC+gzaStZ+97zpmf+NUadB+/OHjPzLV5RslPuuqUeQukXZ3iPOH3iz32qu4Obe6K7QxeQXIG4VDlaZ60AFzkHtJtLcgn4ADMsGmLfFX7BcbvunpUQFkr0PJtUIaZXcUkRQC02zbgbGqkD7vhiOepWKs1SiGSqUKofuiMOvxs+7HibF0HKHTyOgXqwK5VA5qRb/LoUS8oIGMgMrXFoos+T0dKdNqHjUctMV0C2uHlq8s8uozTFklbcPAhgs7HUwrWi3WcRDkejNbcgbsQz2Zt0yqizx9HZove/RqabF/gwKjXS/kU2mRSq/FIEc2+fSfLjQzwpcHMlQk0lAjdR5mH0tniH1sjFph5H5VWSl0uYVME7fWq5sEap9KfxVT+P6DaCfW+l+BE7YUrmoC90tVJnv/fVyiN+xNUqLz5AHHnswZGZ0xNmNtWj6G35kFdoffgxv4RFRDPtKKfAv5ZI1ZIpdxeY7tiNRCECSeEM6EFjKOGvFmMvdfNBcTMfZRKiiMfq4VpJ+lnZRbpGh2OrDuj1JmdSf6fb2+4ONs97u/u97f3NrXB3e3N1hxKjl7TofrwdCaTJ56gqxRWYi7EnksrXkkdk5MCtcNYbFwMvXCA+F/X6mkCcvbxsSrihukaakK713/3008lRB6MD50CCBPkFf4YPi47rUY9MsC71vKShzm6Mr5RrlZlireQmrY/6EHhOmS/H5EWLJHYO60/UZo5QqdG3kWHFV/R9jSm1b56UydR1y5+eHAW5wvzPCEOn0FdReE7cSM/m2OywjBANE0wFi9C5XVRBZgJdVgRnLyvKBh/beDDe2t6O9yZ7e5uvtldODbXOlW8W8eGgEiDoH14nQPAO905lTpKm4noPC+E7J89VUrK32o/ks2W4aFuVCsPynHLEYVPJdissRCOWFahNg5ZpO9OnXJDJKLvQjaClVFN0yjWkBPY3X61cPwgOYDiPt9tyaB1tcxf1TuGK67fU69mPB/07urXBcS10DI3f0fV2f9Be19D4rV3//+y9e3PiSNIv/P9+CgV74rG9B2TAd78xsYc2eNqxbrfHuGd2Z72BBQistZAYSfgyJ853f/NSNyHhxoDcdrfn2WfGgFRVmVWVlZmV+cu477rjorpuN1utc6PrOREM36ywWpNHGrsNUpfXFDKDXhtKnedcchEVhWARI8/PS+ibll5jB2M97fcA7ucFcM+TgaE5+x7i/ZIh3oLx75He3yzSO38G3lDAdz4B73HfxcV9z+D4e/j3qw//njFz308UeD6B78HgqwsGn8Hh7y0mfAaZ76HhBYWG5/P7PUL8a+x6DxR/A4HiYra+n3hxg6C3HjZukPImo8fN8f/AQeQGG15rLLkxxO8kpDxL0auPLM8O+bUHmGdH/BbizLOjfkvh5jmjf6NR51lKXnnweXbArz0G3Rjxaw1FN4b4HpG+KMfeWmB6HglvKT49b/yvOEw9b7ivNlo9b7BvI2j9yZG/3tj1vGG/2hD2vMG+lUj2p8b+egPaU6N+j2tfjGNvIbw9b9ivOMrdHO4bD3Y3SHkzMe9yzG8n9F2N+D0C/j0C/htHwMu1+FoD4YuJdX8OY96j4efn1osGxT9zWC8XNv/8gb1gYP3zB/eCoffPHdxrC84Xg3uFMfovFIY/P49Awf4OqsZoYn6Q+jGa4O+3koym8XuvKaMpfa8u815dZp518t3XmVGU/ogVZ7J8GM7lnniWN/hEW9WCXirGYmTUicBfadl1XWwfrejnKmLj6dFngvWfV4VRlbXJzMF2fbv+3MGNV8/bc2pacm7NGucPtfbMoZKFuGr3mgh4Lc7BRrK0b6+ey5fUsHXSXMUyEKMsUJSK4ZqXn2rA3Hul+txBYxmqN2Hi0EinnCi4Aun7MnsP2eZRqYVOrNYolx09MsIwVZbhoc4OMEqfO1Y3AuELsi12ExK8XiIGIR1W926XK9eSYhEk/qMVjjHM5dkXO5MxjnwJz1QbdKOgn5a2NxiN6oL6NBnbWY9Q/bm6JUw/6i2dPmg6vSSMHl/zqsHFIQZsqQFPZylnvIs3sIY2HSw1PTdvvg/j98exer9rc/cHsHPfDdx3A/crC+S7t2x/eJP2NdqyanAvb6mqrr+1HSoH8pqsTKVHfkMbcmoMr8FCVEN6hfbfAjBUb884lFz5dqafHMFrN+zmXw4rsPrk6CJ3iFkJj2at7gvzu9nFuo+52DYX1ybFT5x6qgFcCWy3iOHMU8qakk/NyO2Vq76fZRIZ9WLdRx5m7TA+TNeJ3d1tyw1AG0UtTm+1Y9gXksAoSyBsvgkoo7Ag227yK+qbrQdK6gFm/IKgX+K7chqogcp9x2Ne2aEOVaNEPQ5fu/bHmPzrXNsKXSQcC+0WI9mEjmKgTsFeFWo22C4y+4RQNXSkr8I0wP1+0fq58+HkrHHxL6YcvhYqc0aB/f2XD5PGUbXx6y8fLhvwD33mf36aV7GhKeaT5mtwVFPoBumJPGLIBs5oxmnEDcHtylwqHeamCHYw2UoEUue9SfwXcyEn2qbpj+FnI8RNPK8WA3VprSMz27+Xiamtf543zprwcYPn3QyEUmOAdacjBQNXtCu6FDnyFBEoOqSFiq1/+nJ6eUJ9UduyOR/WhR7lHdhflK3qE4QdNxtMRi4WqEda9crFNpu/fb5o8sKFT7/gp9TQjVVmLCKFrdR3ex4YRMAUEUvORh7GcVnXpVrpeiMX/e/o8CpKnCuMRQZb7AqsxqvRozMeY5zfMyD/aGFlI69Xg3iUAFNgJabnm49LIS0khkg8TSEvibmRWr27IghodLuRe+fRfJGlI91q2F/muPj4j9NP8w4YRlPAeD/CsCp0uiCiB0VQwh7AtIXMYNufjy9/a1y0rrQVJkX12eXVEWsmIin06mSE6soxAvi1KAQTF+hn6jS+uvcCHCiuu/n9xslNAeQT6gq2bYKq4FSVsTnaoSSj8ybuammGqG2ew5irptudDIdG1uBXOGSOc5UsOjPsdQZuFGd5ZoHMN2KtFJFUS+tE+qvZKtGagf0JGjMe1SNXoHYNnB4exIgaNPbuQo7ghpYQ+Qa+cQkHRY4P5Zg8uwj/hh6gQ8BEyhOOtxhVYIKGCh6tse/gk+jJC6zWUVtE5VqX5hBE0+zSwpEIWTBCRFjyisnTCQPRYSFSF6wTiLPRiwzlRduMArcwsK4FF+1rRUkDBWQvchMVeY8cOjmX+WBuLH160qOISUUUQF62wi7YIaDPlGUYv14RiQhALls93wNi4RHxKO6SwE1QWbaBxHuQ33DEeGPbOhlYj+EEERpdgT10ci7lNhCoRu+Nr8v0JA4pQXWBmUYcc6yhh35NIAGOZpCtvv9YxnjmkUMq2D2GOP9F4aZgZw55LkGtU0iwRleHtYO6XbXrdm1HZk3NozIX6CduAGMSgagKU0/LABYeMCSSC0toVoz5JJd/mXils8NiNh4JPU/zT7SKrL1x/TEum9hLJsLbS9zDrtYiXAoxIcXdOGn0LvLvapxX6H+d0dDg3UFIb+CCQpFJh54awPyIKJTAUCB/sX0GAVC+cPzKyObIZ3yL95BG5jGe5yMDpPovzTPYVf1whNhx1EuZbMdYaGbiK1zMvgfGz/xZb948gczqoQzVQm7DrssjLu07iAsDopLrmzDApiaBvsubBJWfYM95nkzELMnDRH5+4iTBZ8Rmp9Qjebki4e7IvyPycjhdCGS/lJFAgxgZnOxw/OAARLKnTFyC6XbxxkdRG4QMakOEactJLDLqwkiiEq3x3Yu0A9hkMgYuVuGhlMFyUP2RF9OdGirMUejjoZXgsQYrUTyKA6NdcNJsb56ct/UPeENzD5IWF7LblU0aaCvGA5PIF0hx8AFWDOPf9N1EJPeiqOAjLHat9VbzYsOKyUmvkrfcpPcMSexMkpuwqLWK6g7u1KETeH+KAw9hxGN30g+Dx5HcUjwI2tL0F0rSkNG33H5KeNJcyZWlVgZJ8dT6Nk01sIOiyimM/Rl2GcKIDVfqcUtvYtmBYItQBkVTRpKpK6r4iPNIsiDl+9SLQ+YT5rOiASf8aIzG1ImhkZ26zu3c1mrhN/SXZJFnLudp2sV0Sz7kE/nBD3u3sENAWYkT0vzGky5sNKt51uYswI+Xl+dta9O6PG0TDmPYC/147iOkX9iKIBpPmiymEJCXMyTRUcHZ7VbcCxnSCHXdIamNSsc0MzdZPOYunGctmFp17ihGdNIGsVugHJFLQfQkNG3WlZ6WDIo1nKGHJo4DioZz53h+bjJjY+z0oEVQbL9NyZDMjjCveYlOcu1JuNj59sXp56N/dGATdHATdGDxz0sbaKV44dIrisC1C9mB9eXiFGfP+RrKujnXanZzT4PUvRM2j5o7n6nCYcrQ1mtrMaicvYnOzU73RuYX7kx4ULUJCoheRWU0Fkw0SQeRZ2+JHo7b4AH6fPnELOhKGyR138ImLCg7Wc+jDPxwA/veu/XGbt9zbDhgN/HT5kLTi5pWYTg7Z1MrF3oqA/9hE4PZSZoJawR8mS1PXTSraGc/6+zn9N6Ri/BQWYebcIZ2zoXI7xyzljUvnyaTVyL7yU8DPJNhEIpHdCTE+kxgI8k4DBi/4uvHQVpgZo+FWrXK/z+3O63QuLdL2sUc8rZpocc4nlYdui5STWuHvCCiak2WNLs2n4HEHDZNpLb+5gkjqSGew0mW8DROLG5uyDGFv2FMmzQewOAIxPQMlKLOJg869mFJ002QS+YJTLl+nue/6/GFK8vTgR/e0z1b1NcWE96vXB6di1bLAiZNDpPH1nO9Ox2C4wWwmqC59r/OYO9iJaD1WKJDikaxQT0WvsThtaiUrumehID0HzP8+IuWApIvFGnniMbJ4yjsIESHnzDGROyKKgbRyCqp9kooP+hUM5qVowimBh5zeQPxs7AShfBGKZ6A+I/1YSHdlDQUwqlHp2k81YVJh3CNtFMdsP1MVIgWDUh3MkL/Owl4UdBFFnsRxdt5jWnWwqGVaXJAIhinkcMZp03qI25+U5KQvitjdxgc2vDzyAFB0+NrpQc6Y+En94FjHcspoQ4Tji40rIQCj8GmBXK9P119o4yEwiHopHxs0g8aqT4GaDjLNgMWofIgYUeouMKME7xodNktxzhS5Bkgm9pwyhLDwAb3lWwCWz0KxxHeRKnyFXMZ13OjlC2kONGq56NPTIxyS6fwr5xR1xtOwkkMg6fVTO/oSi/IllglpvsIfeSgi7iMJZ/YD0euYziVHuBBXCe2Zf1LcxbjSR9jdsSnj2znXo5JrvtrW3xxzSxL62gBalH6yrk/kSUjyMWNvm0cyrXNw7ouA43oDqf6ZEJnwBtpE/vbi6cCe5zYTmFILhLbI4B/uB10xodqlMKhEQbhCMuTsShgvuuv9ZU8SwrR0HqjfbaRgdqhaGTESVOeJmYlh4O6OSf0Tm33YJpm0w1jv+kah58NSvIj6n4OwyEc+6enRyku5ATpzBMEOhOV8QOF4xA8jIEvSjtRLAQWzNkJ2t9OO1ZoORcRA8Cj4fbTPuqhG9o9hMcqqODSEXofcmfnE/pQXcfPDgd+8BBOKgcPaCVjurwP4YSjmy7C7lEHmYyFoN7X4vxxnzXmVXjTxBTE4LNUJSvRWXbQYQQKQoOiZ5ycQU4QZb3jxWFRPD/iLqyT9mdiemaER42ZwypqaYoh5c7yEVgH/SynSMJnjJjMcODRjgmKn0YVBNkCmkyfVQ68gsQPWZ/1/7VKIIZKh1Zlb8verW3vb1XL8JWTwFfbO/ZOdeegtm/9v7XMIAv0Q619AclWkSrFlI/WsSR7yph4QH46UiThtyEo6qBnRmbNOngA4Znx2h415xRenDj6k7Tfy4tYKey5Ad+NUMqDH3JoWBevziXKl9TO9SHLw/M1aC77RsFYkjLKDLw7CwmeEh9kI4J0bjy7R3TGA6MltVkHTTcELSio9HuZuRnDL45f1C5bO6fmWaw5MWhpXjrOTQ05VcYLoyMM7VZEVaiQECxdJP1Zt0F4H1AsItZiSRhoLbJ+Pzm3DJosWtqkUt5hBYF70GGAi3Q8il1Nl4L8Z5Z/B9vV7epzxCwGt4VBkQLsgnp4Sn5VfjmaNa6CJJgYU64A+2Xidt3s+kPt/s8wKORYlWkh2L5y1CuJIKM1TxpnDeO53MGLg2qzEQ3pWHY2P0zcIIw7DS9y40Vv+jNU5t/y68AejXZP+tP6yfndNq52+O/uRlqPGjm9Ivbzp8ZR/mCmnNp4Cy69QyCfeKddHB9Ze9XtOiGLYhwZIjQfWi00IsJe4ibWunA1lq39StdLjALAibvB9RuFaiSuIu9D69+T8diNek7s/gdM9wdHhs5SBbsYI4ukb9GMn7Pk8LljDmaeBKIOMQaND4Eoqz3pYRaAdyceZBM2dsdOJCsAOqrFm8cx2Io5yYrVCvxvp0X/3qrUt6ZuaRJ7iRiQtUt0OQknDCWgmU4DjMPvg4p4qXxxAgvSE1aaPvyw/pt3h+K2+en3DWM604cOiW4/dIB2x3eCHh17RqgALMcIDk/4esrARToxs3PVCVUmAyit9vWygL1Zz7D1pnLr6O2FLLupzL/MNCyZdSjYboqD9GUdhnpjmcFOnk254qLl3vAGq4DrTiWPuO8yEQIyo6+GPOlKUzSVpiLYVzZSGKg54X1CraQ0CENbPIe5ziUUUiXzi9mV0dlTS7ivBLkIcitGrUSUNSePl+/dirRGjheIJ4OB96BapGfW8frtcHOTH+En0K+yYVuXHBqJDk9Upx68kbqcQvh5BH4GIerc6nllD5nvYFkEEK6+03X9mDUnvFgkRw+hGyP1l6fNWJ2jpV5oT25LWfFncCOd9inZXuRqUJ3QoleGwWCCu/kPdO4SvLUR2CejrQxFXYfZcWib+9Bzx2xQUGwVvcZX/+mlIpa7bVkneHsCp0biGe5zKzMCEh6iBCv+v/hdRGQp64XMDAxsp557WGAmMTNK9LoqGxwQ5Q7iLEGYnXyfv8zz90R635i8LWH2vwvrxx49ihZ4YfDOgB9KukL7iSg2y61gGqIEwGZaOV1HdqN1thJ8V8fa3LXU5iunFrEeXgo8WXDBaKNU5j0XhHh/4/m4ZUCl8MKcUrRIwLz6XhKOO0TGC0g9dzBwqf4w9ioWiqB+3YV9ulFmk0nZS5rv2utLoqMsr9dICOCSlWvF2CR2VkBO95uXCYuzROvgbUtGkoqzhKKeifnEI32fidO1i10yppdOp76qCF4jZgGemnUswkydNhvnKLIaTHFTNWWulbUsdS786hdEHLqELOogXSAnNQCUnp03fd+AZK7F+hggV9MT4WM+TGZitbButysWVoojdGn4zZYdx40Uvu6YyMJiZmZX3hBxMSJshq7XNmW0tj1rnAU6Ts2Z4M6ygygSN0lwiqQN5Z6Q+y3iHJ5UgB0nCLFYwkhzDNH2/jQisJmF6iPsC7y9h81wTVR4fb6dpQ9I3bVSAeC/A56r6aC+oJ+jVaHxl7eovgrhspqlJGaLuswEklVqlZ1KvVapQ3P17YNafW9/r1LfPYBP6Lys1Ld2agc7u3v7u5VatVrNErE6l+ALy8H2DVqfEqneD4de8CSrHNudKQOj0C8ML6Eh8wBpKVNP8laCvI9izDMTBm69rhM4HUz6CEBjLUUuad3BsIMNfjWLwIwRk4iFRpCY/OrJpExXvp0JOUrM3zhpgjwUOhLaKCWNdc97oe+7PQLo+YtyG8aqYUpjwyiZgYdphbgdlXCAKY6FVFAVdWTflM7LUXevJyKmIRymnIhHF0YyNAs9oWLoKa4Q96YVZAP0wPpycaJTeaRHdt0b320fshMssv4Nn3b/Qx83OFQGHqLYItUsZYOvc/BMnFcJZq9u13cxlfJwZ3trboRbN7jzojAYuUFREbxrJzoIhfNRVI+CzeaKRMfxJAjSQCTC9iRwH/kgPEUhQQrPx2wY+CsQzvj+IHFA1AzL1i+NsiG0gM5wPKI0CMx52ihnxocWjqoKxcGHKJAcCU8hR6XtMh6dATnE2oT4noseG5lbseEdROqMzrNTrEmae3bHN+4I4/YKLGHWkn1kDkBjx6zDGY9XNu4DGHuwfKe2i9cH6wxOdPT0cKhfLMtswUZAeLCYa5hdSwajqtAPXSyllMOpfWd7sFOtDp5MvVnNNcvMw18sY70kTgbTKx3x9SKwl/vmTQeltAdh3xV31CmStVxRS4bEK5m3fTfOYax4JVN+zRyMwNkZObeYR5/g/WzsdRnySklzbbCjVMeFPHKTCHMx6RgJ3IyMTyeG4/FCbjWsqRzReHWg+AhRDPrmsWpep4twS48z2AOXA+Vi19UviJ2UGgZ5/MIU2/X+NwI7OV+SVX7o5hrfE/onqqP0EblPyoiT42rqb+25O2534FYdd7e3fbBX73fdg0G1trft1Ha39rrd/fr23mD3Kzk/q1mRpr0gFxvHwz55aqWzhIxVqnYmaUOU/i/WC4Yt3vP0q0rfxmJWQo+4CvsBOay8hpRjnrYl2Kspo5SlnKVbIQMuSDlDzROCv8WrRaSghb4fWJmcd5/aRdKsMP2LfB0zQWeqzs1RrrMPrpPEeY2wA0oIYao9OFaYZOpRnMhrbQAxVsQANwZ7sI3KjTkuTJOOithu6UWEAQVFRu3I1eSoJUFdTskZYyXgXQTJIvOANI/tQATbqt9omxqJWCZKHwWhUPArgxuUjUmQpCuxqINuurLepL4Z4ONEjUwCWcjW5ltLUyLZGEJ2RU0NAJ/lOTeyctILVaxBG4eA3cc5ChIeesHamja+CCFYhPDRXQcRp3orT919hJEcpEj/N7GMzftV3NEwUxMPLH45a3pT0pbG88IC7cY86sU5F8Y4VMs0ywVqm+BLQFow3e4qkZDVrXJXjRYwcvVswH4gqaB4LIiCw5RTObA2e2Z7yf4qVfFPbXca7TQpJHyQ0Yi4/Sla086dgpC9yECUmYLPPifoxSkEbFZzc6y/lJ6gTmjDjJWUGJ205ATBUqJGYNHKNjCoIz266R06Q/TeS83pOiVVr78idb+eh7aaGfk1jbkuJ0Qls3zd5hQyGCbDD8NbdFg4Ar8C8/0CDKJLW+IGzLuS7llubNl1e9v0Smi7VDol9DdP+CT4Kek1kKAemQQojJnosxatc9hkAtAmh2XYeX4IXBhGRhIuDCedJFUWUR1mWiMlv+ji8mpqeFSpQZgJYxqpyiTKyLr6Sr6VGfUikq40TbPSioxeoMEYZGkkVGZUkXyQ6CbkJefU/EUdqZyQRDcPQZrueFaHkg1pZv7FQKqX+XMnhkeM7V7VtrSMeo4JyCASzmi8OvHRYmcbQynlPye5oKjk4FLF7teZXSX4+55d9Z5d9Z5d9U2zq3gnSuBhLey+YYoVD+k9xeo9xeo9xeo9xeo9xeo9xeo9xeo9xeo9xWreFCvWn15JihUN5j3F6tWkWInV8ZXUIpTK5GkwPeAy6yg3vchA+EFXCPnSgLOvPd1qJjvsJfnxCtOt5jf1XjDnSsiHV5VzZRqg7zlX7zlX7zlX7zlX7zlX7zlX7zlX7zlX7zlX7zlX7zlX7zlX7zlX7zlXP0DOFZXSTszopkv9zezoppIoDYzbFHSgGOPrRVg6ZXxw5SGnh7jZUrESfYEa9YBhB49XYoRXSilCgj+dXF60rMbl5f8c/ePqodGyBhEMlMq7XQWZACiUBkhvaiS6YTEOjudRVo4XqRJH7AM6abbL1tnPx7+JxBgZsYohzqMRSmkxZFs3Tf5lIshOMCqoZ/+NRqSqAZplnNAJIbRhBdkfSrMO29Dt8oiuSqByQrNXpQ071ZXbuyFJAL0ZbMh0SqEnutFbTFVDSxeVW3S4ImS+qplD91MJB9pxP2WasB7ma/gYD0vHQghSkUan270qGRWXAhSbaKBxZB0OvTR3bJIbRDDikVFa9akNF5DzOL80AKn6SKu+cALq0WbGOklh979AaCz6S5UAoVqCKe1eImaHXMpCNQk9beoB2/PnnikabTWkOajlMeeGntAvRjzfDKpzqF1u1PaPFLW0DJNee5jTcpFMC3NGqlqFFckp6SzMqw7M81VHd3nV4eo/Vx08L/DK56rTfRSPiaj96Kpzla5tedVpX57802zHintwwMRYpnHM0pPLpx5eBZb1N7qzb8tcFzhfwsAt6zavrNPwvmx9cvveZFS2PsIJwa9VQSPjvxpwtkcOlXZvY0/Weq2yu8G/Nc9O5Jc7lYMd+NZsGh74rXUuHzgZqfy2inXEFQI2SpmqwjCC5Sa1+GpnTRlGwUenLP3DgcYwMZguqivBiTNzmtCTc+vBpv+jIHNR/QXjR3ws2SGlZAMrwUdW6x9LSkpyPdjFxt4aKcdKzVIjENebKReIte5RYV92TZm3oqr4yUaGcWPQFG7+z7T/fmHGDDxoxaEbVnceC7yvk1PSxuMpXU+g0kyOcKxyjRMrm85NraRSVD6VjnRlHZRb1x2jy7d3y6sKX6d27bVVUJqIhMHikvmlSUEs0P0ZDGioL4WrX0fFdB9VjU7b+uhGLpb4wsSqoOI+3DiTmFwrUrtiSWdoS6geRZjwLEQhxVfLoB0ws7AKNVY2pxxgmpey9vlg5c5e9Ii1FrXT3qVK5fDKjdeH9V22YFn3+d94ipXFsV+mkuA5iRxgJMln0UDip59R0vKJucSYm44qKGf3PYxm6KgaxYXeZzoxKXK6ILJMScLxsAs8Cnvk5pzlUDdT3RQVlHyHNg+tHgzS4GgIdQVD8fhGUIaR7IhPRpSwLnzyGqWAeaOHm52n+Map7+yuflY49/4rToxuCJa3E+SGU/NPplnlUeaQKiQITBIBKVkLPYkmbgFLDf9CI784fANcZIabad7VJS1KjJswM+7Nsr2YnTjAalORCJ4BO3yQ3KPc4KqCdiqMSCQPY9wPUCks3MYY1+LfPrcpxSvv/mRkY5+u/TDu2XiX81jALCTw3yJBWZ4u54hVnvga2LV4KPmzgY7PAXvBhhRNjiI2HEYOnOQ9y40iqhwsozHNVkFWeH0zOhb91hGcAbI/LIN7h7fcRt7XQMZZ0av6FRkPrtvXyT4O1u7r3bi927x88NbFxeeLzpezy4sv7ctWs3Px+fNlAbM5ITO6qLjINjefghugSFUWm5lMBA8zt2FfWEdhNA4j51kAKHMTnbjOqGApgl2sUpRQe7CqWFaINE4pQARYjK0lh866ep4Eaf3y8Z+/73/ab/xaANfxDE2goyX03SbVdg/6rPLeq8xBuaT4bMKdJWt+MkwVn+fZk6perdcqVfzfZa1+WKseblV/L+DkIlkwl6L/xJm81sakPmH+GfIoR8YgsI43FVru9XXZ31myid+jMPOua7ELlPxSZWa6We8zFUlCKcdmaibpSECLQA9wyNYixB8Qd6w9sTBdeyntgWTzkjOQrxbxFe7Qw3j/lIKEFjVFNDlDDDZKUg7Wrheg6UF+1hQGTu7p4qSm6StHx+pYiNb0cgbqMWrU1A6e42RRzmuTUhIrL9HU+yuhDFtaseGtrU4BYuBwigyls+eb4hIAS7h9qRmxItg8nYxxBNb1CLu6Flg8GJKD/o5rouI6nT6MOHT9/5K8kZdqZKjgo2A3eui8E81RkEGgx63i6ZBDq+By3zWgH1bvEBOoCVwG2QgDNsnIWFx9ZxWESeu9KNpUFIdGhZG2qbgqsK0TlZ8t4jRZEkWmV4uzK8vCvtWZRRm+bN6EI3cTZGTPXQV/cBAd7nxZFuVuv2ZEHhlX4I3N5NGlTp6CP+iYkvqQCTP1G4w/vI+ncjnYa6Bz4FW+BR+HKLNMzvdCPy8F7WgF/HT9gU1uJLyqnERFbalPDt6UuZbZldxNrdPj/B31sL9b2d1eEZGY79KBYS+/bGaQiIniVuwiKhFLipl0nXpJAqupBYN0ghWR1xtPOlmcn5URd3T+JQX1M5M2vPnzV0WTOJw7/QyC0rOO1NYD+p8pSRKFlbq3UUke2ONarA5WhM6iwQj3nYk0gQpSd+L5CWNgjcbwKkvMngTrRJgU55Y11RHCZkRCzwij2F4Ra9wHDEyZx1ky8BE6Mci/2D8VjmduDoYMEowwS9mPTao5tLKyYd+4DmxA2+l6nVwUpJWt1ykgJFyuDcNn9oFVZLqmHjiY99f4cLKxYiopcqYg+j5SFxycM2tProgMA8h55coIarEJhtKaNIh+V0uFi1nIZr7+yhLkxFToDgqeDw5cKXj/rJWqD7WSgPYGCxTdppKeeCUavElRDMPxigoJ/Oq2l/N16gWTB+tze8XzVeCpLJbeUwfzioh4cWm9WiEAdu0qj0turvjjMha5wUsF7601QC+hS2wzoi0QAXCcToMhXKKz7EJKWS4wvcLzpq0XcV0n0L8Q8FTEA5oAZZjxM+lWOLJKBwFhQl/gIiz9tUmx/bfrlUkZ1SaYHvXFpH8qKi+tGN94lfiPiXCWd0GOUWiRQjJOoTH2zMThlZGFJ084flw1Ze0bDM4PLNE86Lc+Ym5zvoCuRlkMTbANh0XpTriy22Kx046mzlZOQoGJJRkKzLDClREgQXQ64WAQu8lL0cK9FUdN7P3pLrZXZmLEZWhQ+EPY2cpJKVCjyFDCoLorJuDOi5IJTMX88XvP0sgzRIj+0qmKBdCz+NJagJjVLq3h8lkC8ysa1Nm3VjSY4tUqGqLNZ+hti25SwVTJS7UUV01KwbJmioyVSpsbB9EhfK8bOZFXWICotAm4O0t1l04F0rSuirwE/lVg8qhs3oofR93Q5/xR3MIrNTBxtEV6MeiOWPVSRlARf9KXwQo+2Jj4dz/MrxSjokDo8ocijVLwGaphTHkTAdyJE9nDPzfKdCOURTUg3Holz8RVf99aLw3/LJXp3qfELZRyqnONjRNicabDJN52isSPbOA6uaUrYB1zx1XQpuAKHeNWXm0PxbTPAbtkykYlM9W0Cnuiq/zpjjKt6ys57sVqiJt1RrXGaYUJ9105R9SNzCunJgX8Cl/Q6f7MDOnbmAJcKEQ2TcfZ5XG7zJKAbsYdPxxiChYmBAZWwye0LFh25BBtI2buyFpvNNsbMjzGlVOuU3cJlJ8f5fRteUWJV/RorSFQ+/9qNi4btvU70GSf6KAxLhs1olvLVMkOkFfixpIiByhsX4QNxLBP7gPE5ZLI+KlMLwtOfxgxXZhLPK9pYDjUdg6t66PDKyxEd5WEVzhmMl7UXjpElPKOWqTXzIHrqW/NMDSFQ5dSF2SQi3Wt37LhQ6ZDWfhFlxjRb+J+MUeReWn6Yf0Axczp1YEIEkh0WTyIf1+XObDCvOqlhWcgKxjb35xEw2RfXBIMC4tUPI+8EXpaGZHhpGmt/3zS3HgyfGKtVq3WVqGJ6ZTYoukyY09zaVpV0AMevvaov1PU5Xxzh853e1VDBV2oVtBY2x8btZUPVmdKFDBcaHzlA96p1YsbMDS+4gHHfdctaku2281W63xlA/aCDLbw6hIKgwwUvQ4t0pqmyMvOhhTv7G7tb61CRI48OEwLtOw+nXxq8a2UDCMzI84Ffq0hODGWQqgy8K3pZhNpwhaCjMWHm5sIfOeB/oQp4JucaEwUbo7cvudU6PbF/Nt+uElG/r8RkNhQRgZeD2EV6In/lEWUlwwLsa3fUO8fcYgo+i8CkRXrxTLxpisA2VSbI4QoVDWRTNIlps0qpq24pfmJQL4HqfUY9jDuWC3XfNTTterudnUla3LJuNmcsFkV74pGW9jn8ogrGOoLQUaJeTAtVsOURRNHpi6poFF7Fi7oakxH0P8LC58jU506WCPrLsqFSliVRoXq92sGjTiW9oIZwF2eWg3KLZETsZtyPfSNmNbnRexurnYBjd2XCDbFYMVUoGniREM3UfZ8frDpSiJNx5TCP3aCx8J0CImszd1kjJCyLJFJQfoiQrHC6REvlBQ3dl8AtUIxYqpCyQJ8OHfMMi5L0Y3/LTg0SxF+l46aeSbRu/aWfbBbrdq1ve3azmqo90bjIvEMG+yFFvSKmB4CLLTOW7yr0QslRmFVKgT0TI9Zxrgs/GWq8ugAZKobgTwEdYHypwjZCEv7OgNMBIDzlZgpyg/Kyo+YCVYhOrW8Rbh8lV8cc2XdsNfDaq39sihfx2VEOUdHaJqRo1yYNHrGgkhnb0VCLYW5NRVixMZ3H0nybHb9cLjJYCUV1NtQDm7Wq7XtzWptk/x5QGlFhCVXmDkVAX9go66cU2uht7tf3eptuwf1eg3/6PecnYPdLcfpb+32+4PVrB0ZZtihLVSgiqX2zzKSs33eODm7tFv/bK2GepFoWzTJoptlSC+pU4OABIW3mP7+PHYZA8pqMyzICnjz/Cv1nBsgbATlBFmWKc+8kbXDehF7Z6noL4Fo48ccQOkamOKr0BdYM+m8dnX0khUoUkhRi4ofR74X3K7kurlAPwRNPhnj67zKwVAi9H8x/iwaEz62ApomhXnWLyV8ODrVv5BTPcLb/wmWXTNxAdbbUx53Nq4K8rtHcdKJXTdYwoC/5Ju26Sx8rBfj+96QseFkpeSIio+IKNzYG94kaRB5vGhTw8tLzq9WarVKdeeytndY3znc3rOr1aUT9F9Joc3lxv+9VOach8RXVq9zSapeTYHPldDxjSuCLkXDqy0huhRV7zVHX1fN0aUm871I6cqLlM5m9msqXboKQr5trdOlKPiRiqPmc2GJwpjzIOIapVVTcLjAEB27NhsOt2bX7S17SdMaQy1f0ByhyM5XZ42MnAh9fXbiFxV6UbqMHLxLt06RZOs8CpOwF/oUCo3avBhBbMPOxnBD6lU4NjFk/zCLr/3x5LLFWNw/X7RaZwKy+9OH1gX/edFqZjC2f8OLniV5JW6HO07ygktG9moCUqYqun2jlfP8MrLPlBJcSFZWFlhASmxvby1JogCkL9BLY/pYVcdrsfS0ZqfTj/7sTCIfUaGXIy5yCSa4MHysC9m+9eXi1EJHmqxG8zTG6pOLWV5oiOgnAxB9U720CYu7Vt/aXpY9WH4T1D3CejGjjVZu1nyW2AnUC2FqQ9OsBmP15N1txOgO8UZ/qhCbjMKWg7VCdq6HQaxVibabkNbceiCRA/oTqEnRo/iunIZVpMpf8TgM+goVTgS2e7L297U/RqwdR8HBxdCtmFDEoRZL18CrZkcO3o7dwfBE8jPhYuqkLYU2iCLtovVz58PJWePiX0y5OhBy4p1/+TBpHFUbv/7y4bIB/9Bn/uenVa4Azr37GqY1GBF9MCrzM9+OZKQRynucZdwQ3K5EBFA8O1f8YIWJU+by3qTpEVOlhkyrA2PifG3kiOfVWqEurXXkdfv3MvG89c/zxlkTPm6IPAQ9QXoMXnJjYJe5ol3RpUC24nKx3CGtY2z905fTyxPqi9qWzRFooGoRLDCPsF58NxgmN9wsHAKo6hKtemFjm83fPl80eV3Dp1/wU2roZtU4vcaUhSlr1qfj16jWknVdqpWuN3LB7Y8Or6LEucLMMRBIV10vuBo9gk0KAsldGuY+ve6yFx+rQUZOgGewUNPLgdMvhaxRKR3TDOAVsyIibzRc4Crpa3S7kXvH/gQ6Y2VYD/aXOVY+/uP004rogcEWQM5HGHWFSjKi+kM3bLDBMPs1W4Dw8/Hlb42L1pW+gpXHxNnl1RFGGASJwFK5Ohk5Q5dvwFpU8wFX/2fqNL669wIcKC7qFTEne4O5Eu4c67wZHfvGNS+gOZIOdHzkTfvV0vzSF8VZvl013e5kOFw2EUcx0CSjqMsIzpISWkhmea2GoBhrerpRB1GY4yWsCHLU48Abv262mhcCn1ymhE+ojgxXmFdl9kYYsuhhXp1xjUso+6CnZm2IJekUZv4yNJ5NRBVeLgMypSKnCymJQkwc+0k2FUFfg76Voay+pMuywBv50qXAqkoZhOqMZjX06BG58pkoJ4XBCywsE2bV7aptegyybgVRJUyXs6uwPSG+RqBrLABJn1TUdMYNwQWOK8gjfpTKPFXQr8WfcX3xX974btv4AT7uio9TbY6cnvHcaJK4D/wn2sLiL0bu5w8Sg58/gV0If5hN/o3CACpYTxI2Cj91z9KtIoVKBaaIf3nYqR5UDKDvjBNF0bHc0oFxFlzeusnFp2X12cjXWmMJg+FcdK14PTAcS4bSfRJYmEFqIcYuOiDQcMXrBDKVRRouei6D/iYsSXJP8PLAkkgy1N6xUqRZMvZaFGVi/F5KitU+UI70G4ZSj7/mJq75SsMcIROEAxPwGThOsKfArvKhxbtd1SaocX4o0AWu/33Nqan/ubbWT1qXx9bF8ZFqtL63Vd/gMZkP9m4cAkSNlBkgr1VURrrIR5LDTYPzZzXnNOeXX0OFIwPoUjiK2ypQRnWu05wVCGKEZpABuE8LcB5Ygcts6xI3OnYTvF/0Ek4dBwMfFjNiAQDRoL5DF5ygPvX+VOOy2zHYUyFWmIoZ/L4rk0gQpzZV412rBPQwPFgaB8OSLhZGeAQY+l/6MfARcOUNIoewSIpaeOeMI2AIMBFiTNvtr9eGOEvCcWlqkuEBXDbIiDGcZiq+Swx6WSgbYgAoVXMQP+UPWX3MIQhEypoHicgAPxysLOrNPIYTqn2jpe6jsXAIFcKoVRNY15K0a0qAoejtJAXhjk6sAE5NrnaJp0AKbYgL/GqXC/nxZ3ox0/LwcHt7a5Njsf/+x0/ie/78V5jk5edMiqfXMG9rXwJ1saHEJi1zOG1cug/R/FR8zBEv8JwsKzwKAw9oBfHGUktpxfIcRzeWWi4io9OJzQUgKk/64VDEd+CrKIEH6JElzAxTDeVrCjA8p+soqfWiyoOr11Sz8LKACpcD5RKNvptQlAJs36z0WmjpYGszfl5+VY2dODYE3MrBFETzUoiJo9VexcDnCqd+yqV7LhwxxsAMySxYXlrFWJ992fZ1zDg8Y2YOHhbU6u/TkJI/8O6hSH2NOhAbsJ8qWsy/CK90HuE6Hghmb2qjZM7Yv9MZy0qdiXVj9mLj+eSk1fUgxHdJukSuiVADPxhjt4Wuz6WcHOoPb1fkU2WjMyY2VXTTEuhGWHx4nOjx0ND5yWvx9lSSk6ofSsFVXZCtrlHcgMp33YdsIq1CkWAr1EVXerEmIVVw9YY3Lslw2SmdOdxxmZg0HrtK1sSTLv80de2W0o2NtvhhcjWXBmFoXk+WKE/E/GJ2jdiuS96qaERxjnBs9LwYA9IEXhXVAfa9Ww5QHE+6vteDoQ4G3oOuu4PPrONhAWcFP8JPICTCBiyD6FHexmIk3YOHcYWs6iAyIlZ4erQS5zYdoSLUb5x/3+m6vqhhjHomHcD3rs9VLy9Pm7GWg73QntzmpMesrJw1rqO4d+MWF3naptZni3o6lqftH77hvz7MVcZ5vDMO+BWwQy7dIreT6kTinnEgLjtc/5g4Put34hky/YSRaQTG+r5kCeOjg0R1x6wl3YQCtZiBOae2m5AXNvltHGKul8ITmx4BJfWIgnksPOl3AfuvooXJVEIlkXpGR3WYmNeIem+WDQ5od8w0QbBRwvt8UZEvV9Kyx+Qt+65gD9qjR9ECby6WLvBDyZ72FYlWUjY30cp3tLIbYzHDd3VcQLWUACunBIEeXqoQo+CC0UaJPWN4biWR4/na+ZAjEJw4WX7pg8LbIQJf4EBx4azkkCRUs3kJCb6suyAFEZoPnWYqEFnPiDYSSTCXJUgdiVhTUhjbJ8c1M91vKjTMmD9aIW/73KEzZ9aRo2divsOHvl9+sUkMkoIW2RfR/GqtH/Tz247Ak8RKeSSoioa+FbWYjX6FgFxvN85gbdAdJAelUBg+LIi8utXOJLkJIxlBZJYlpQgG1JEVaqUUR9rV7oJd3zxrWybFlrUuEgDI0IsFniZd5+j3soGVfzMs8LUVzIgXxxOsCEWjLzK5XU4J98Tn3PrRGSWC4iAo+MNgrGJ5hgctcWIhgp31EbRrqwHvRw6G/bXRpxEtne6VZg2lYBTOFs75WD/aoDyJTADMl/bqSMIaIMDviRffgEpT4KQ3zY7EnDcXmfOjn760y9bnn+TcnwQ9+PjlJ9JN9IFTto7OfnpifajNV8A6oVwrne1V1EKR3Ug5drqRTbmdsAT61XPvV0cfHKZO4P3pFIicI2k0uwISPy8hJGChFMMCx+9MAi95QU6gsxp6RIZ8WYAjU1tldVzBeBosYNqhINzigrvVoY798TUI96eO9Muy1SaD63wjm0ALZlcYBZ6zAsJBE+zQ1dgyKRmYhUFXlQi7M12Unl1z6FqlbPQgxrh8jiXx+jlhNfVqpbpXqe1a1a3D2s7h1sH/rlYPq9UV0dp1B2HkFkks4ybMQWjtoFLdJ0Jrh9vVw/rOyghly6EDK7Pj+EPcQzejoir/yfaVA3ToBhhEnzJzoOUMAy7ajdWS2ptEd26BuSbUvlFxAsxX38cHeuInTayl2M5B/qafWv2koEwzrAlAPIx36rXV8gfrzwZusEzWUUs0ob3dLqEeTk21SmyYg9bdnZ2tPTkXMJAHk0DoPux1RNRZ+vvV8GRJ5CPy4iHukbAvjRUQj7H2JeIhwTmXs/u391dACZi3HpzeqfpyK7ehA+8PrKNOXcl8Mjov1T7IP7XJ2UJyMIah9B61B0zm+bPvhNbJ+MYRWQplvNbVUQZ8ESezDkIyT33UpdDGVCXddQKOirrK8Hxn5/jDh4OjvWbrw3H1YL960KzVj44aq5BCCJHsYBRN4fLWqC+BbhuT82oQpvT5zWVYPQzgZJjnVGAdHFegYlMk2s+hdeqACnYUPY5hZ3ONHZjFtuuqa/MhNDrpUr7YMPThafgPouF14b81u7a9GUe9zR41sImMoX/Zw/Cvp1tbe5XTrZ3srSiDt1dWcxoIJ8m38QHEygkghzFNK5cvsofAMMdXGmzgJisl/VvY+IWY+JKk12DjT4s56Y5jHJIZRn778ietrJet05/aTmAdo/nuxb3QcAKU0bizyeR/iTXyauz7FD9WSOC3NvBnCYDUdBdE7yuw5qfIXwWFP55lLpCHi1XujKLg2KnQtjLrdqHAJQpjBFEGJI28XtH6EN/+cV9G4otRs0qMR2FJucGdF4XByIw4hUFRqjYFEIq4SIqhzLCk6/Qr6g55tAx/6OGXYQ9fNovjLMOrPC4ZnFHcegaXZlQAWohP3gsxqeP1M5ASaIdOBO04MlUrIp/qwcGW47o7lf1dp1bZdnZrlW7V3a1Ue/3eVm2rX611d5biBNrJL8UM7GtZfsAq6CJY9r5dtauVerVes6s7dn2rUkWwidoyvCgwj26KFYnIqqOeebP0HHaEqEjrhBJ8eJxGbLxKmB96dxScLdiYSRCTP3Q4BjyazI+UO4jgP7gVX6BqikiOVF1qiPdJxJjtcHqiDfon4/T0fCwjNEjnoiSYW9ljHB+3d8NuBnVRL4CeuCcbtUTRlWjL66WhfEQEhoLY7sqcVcJ6oPzEuAyzBOcB40Jg7PvQFfVs6ECP3CQKJUiOmTjjDIdMHk1y1rPw6eTyomU1Li//5+gfqTmhEnY2aAVOXFiuJ0py7GDdjZWlwqXzuGIlJj7B15T9TjlnSTShM1+mtpoJj7SqHYbK790yG53URbdILFBoyPgban+P9lXw2w3lKIWJ2STGXHgEy/8YTmiaMKPESTGNkM150IoWu5RFjrBKn5yh28Ponp/p6d2SNT9eBM9GYccITYU+O541B8R4k2vLz4FmvNnuXHPg9bPM/7laVX7aOTldoKVemgZ/esMrniLTMvw+PjnbfR67i0akIp5HKViqZzA/tbjNVb8483WTOYt+Pt6nyMlOgvR7OgkcVrf2yEsil6ri0dvxJm2KzXmnSRt4TmzPbdbNunsQfnnhkHd8AsHkY4q0e5WdL04/Tl7QX+sASi4NLZHiGu2zDbTnoXHfALuPLdcBtUeq+2GQrkycgZjcqe0eLMQY07OwYhGy+jy8z8Zg81FGfw7DIazN09Oj53OjFwYDnIDitvSUiqs7tESRES7/qK96JLAe3yWYm5rAI4wGYrAe3Ri26UwACYRpbvO9IG7YszAAPew0vE8dWJ/cvjcZlSn6iV+rVmpVgVnZH3kRuxjb2Ju1XqvsbvBvzbMT+eVO5WBnIwuD2TqXD5yMZF1KmIQjN8KQo42MMMARPH8Oiy/S1UxlaUkrxSFUR4looidQKNTTxJ2cWw82/V8aEaXv+qAMR9K+ayAkWmS1/mE/nxMUEGqLgNDiTbaZ4LmpwNQ0MqZZS/0JZMzxjRff/J8FIpCmKphwuqzbXyIcZS2nJCdOoGw6N+kXD0rMs9CFcGPgtjsWtehp9VAiLxUnWluUuiSBZTkpEPmxQRB4MFwiW/dnEN1QXwoHrsYphw0xBiUFXZ629RHUgLU1XDVBGFTcBwQIpRhrXziEWXIZBqzQXNyHMQMjeYGR637ItRERQgtzkikEul/WaDhlxBfBe1L4VrvYCBILXrnx+rCOy6CdOH3+Nx5CZXFSlwlcys1F1pPPlspWiZ9+Bp7e1PzhvV5HXSrbfQ+t5cIvuDnRkGulGfEj0sGA45EHES7R2ekYxq2hvhqPRWFOUfc1tsTpplJ7CCNKpyuxzIxRE6UnIwqQFxkdKtXTYt4Y9+5rOVe/z3B/Pj0TqUzVWTBm3TD0XSfIY/EH/olA+Rn8AyMyHCOAwIslMEM2bB/0eXdFSwr/ginqFGaSr7FJrkIm5l1FXqweTVXVFpWp6WQdOcEEca4IO4XsFokmJWDU7ClYGgWBIUqhQeONMa65v31uE1JLXpbNyMY+Xfth3EM85YfHFXE+gf8WJ5efhiieukmnoeTPwGDC2Ul+OBxSeDCFlgwjB07gnuVGEYLVKeeq2SoFXZoFS/B+I0IACtEflnrEcL5Aw5p6CsCPXtWvZO//tWcXztpJ0Ltx0XzNTmDr4uLzRefL2eXFl/Zlq9m5+Pz5ckUzyBejRZWJaIv4hiBVZqkvxeBKq9w+TWjiOqOCJQR2sUoxQe3h5SPJAc7qVcJBlIe0tVQwo0OfIx1av3z85+/7n/Ybv66I03j2JdD4EvpoMwvNn1o6fL7grpFxwn2GsqZzOBd9v1ap4v8ua/XDWvVw6xnI+18hF/f2XMr3E2fpWhtRkITpZciXvJih3k06Z/lXFDROIm2rWbKG35MIPARxQ9/3Oc8uhT+eyiamHL4UcDPqM0CLAPh0xEWHReKLNR0WjmtFnvokX5fker4KQ+m+3tDDqkgpZQYtWMpkd4aYZJ6k7qGoFPGjhGtOFwPOnhBOamq+Iv6XYxtar8sZh4S0S+3g+culZue0Bwndi5di6v2FqcG3V2zoaosPpe3ITRyuMEYln/NNX/5NgfNTMz0JLI+m4WRM1UWuR9iVwujHPQdvXRMV18a9LqGvW07/vyRLZOk0Mhj4XjGm4CHRHMU6B3rcZunQhTnbd++8wjyETWpcYIWxmBPVVcyhZ6yd+Qv8TRMjLeTCQjxF+wxfpheBF1vCg25bJyoAXeBtsGSJTA8Rl9csC3tS11PL8GLzJhy5m4ia7C7KE+y4wx0uy5Z8uFdK4hAFQ5/gSxqbLTQLOxslDWRZjSnEJ7bMdWC/it3nYwxlkMltivLPStSjBXno+gObXDIYEjCJitounxyMhXEtsyu5U1qnx/m75WF/t7K7vQRhWPikA0MtLEzwwyMaaqI4BdIzk5ZTL0kQ3R0G6QRLkNQbTzoFhvYcnX9RnvIn5wYvuPxl6BCHZ8c41xY5/loPCeW19FngqPsKhZuGPa7F6hBExGQajHB5aRuDMRO7E8+n+D1Rh56lHvrNJErxwBEXsiPHJ8OFSAmj2F6CHZiDFs0FWT/wMesncPtPRelyczBMkEIUjcY+XlKLuSLM4kO9cR0sIOV0vY4IxS0+BlcuxYbhZ/rA6indtA4wp2y98eFkYwWUUSBVQTR9pC44VmvWHlti6LjCi1IQqB4QIeIb4xb9Lj9yF9NmzHrEK8OjFCzXHRTA95B9TsXuh7VS9aFWIr+fgraVNMQLa8kmFTEMwSsqIfqrW1fOy6kXTB6sz+0VzEuBJ6VYVk8dlksM/MUl6/IbmRGyV3aEcXPFHGExX2DPM9bANXxhU958WbpMwEHRxRwwlR3EBB6GkUGis+wiSVkBWHNJlJfRxetSGOCxK5pmQHJZrxzxzSbdCgfv6MoACGwYuIgjfG1SbP/teilJodoBNb6+mKROBXillc8brxL/MRHO4K6s6IcXluQWMLgoxrGKRUBHTzh+XDU17RuEeAws0TzokH4P3WWp0g6rpAO21bAovQVXbVssZNqh1NlKhl1gNGxm1GYk2lKDHt88xvCj3wkHg9hNXmr83NtqKXg+1INc+2b10qfHLTsjUIiVDL/Akz0zemxqJYO+86JkAiyfP9brWZpuZuCiPxnTtVIaFl82CxCw/LKhCiUvdeBTZ9/6wGeKlz/wRTvP0JkW3XSCkZJ/asmtYvgFy4upoS8tMQjsoMNYI17xGKrUnaW6M8CcvNigbxmSEvgXhq8VRIps3oofR92Qalbd0JZc2igruhjYcaqu1nz1vL6LMlhTjIbJui0SxGStgevhlq4adRwWXYw6cRz2PF3C2TFufNXS1/VYA3ZRlOE5ENh86yebViEydE083VGmdX09xL1YDXGD6987cD5Q5bUEF4GYF+pG1hwWFdQJxp0vi3R/Jl73bUxBEhQemabj7PK4Lepa0w2s44dDzIzhmoQNAanssvOvnUQYv7PeaLY3ZIiFK6dZtUqDivlRznyS12VU5giUQB849L+ajcuGbf0ONNkGdBMWMpQgy5PYSBQGWSSr5yahCMeWdaRhb9wHfuikyqWoBBwLTm0YMV3SPo5RCTXWvLyrBc3k0Lo+OrzC4j1XSXiFYyZjQe2fQyzh2FGL9Jo5cD31rRmypCvcmce8DJqwrvVbNnzIdHg9XWrceBP3izmKzEvTD+sHKL5Krw5d4pgfxL+xShle4JvXjrTwaICZLW9O4vwFfKd2/7CwSLbzyBuht5ET206a1vrPJ82NJ6/p12rVam1RrYn6eRFazBjEXDqWuVwncIpRf6eoC+HmDp3R9jLDA72lVtD42h8btZUMUEe3FzBEaHwlg9yp1YsbJDS+gkHGfdctalu1281W63ypQXqBRntbefIWtq0r/UnVkM9Vrf2JdNZsqOjO7tb+1qLibOTBAVeg9fTp5FOLb1FkaJEZMcw+A1PI4d28UC/gW9MFZXHuZaqWoQc6DSUmc74m2YKbI7fvORW6RTD/th9ukpH/75PGWcNQEAZeD/E96Yn/iOKOKszAtn5D/XvEIYHoCwhEpiGqU3zj1RUlVVSbIyw/JHFfU6SLcmmLrsFRcUvwE65AcxawCnYvoZrEYlk6piNbr77q7nZ14bW3ZGxkTmikimlEI0lUCl2U3wUaK2dTR7vQxRSckDYXJforx/iKID57Vk20xc0z0LELC6MiE5g6WCMLKsrNGF9Gm5mzKOc3y5c/lnq4GYBbnpp1ZeLnRGKmzPi+Ebf4vEjMzeUXyth9iYBCDFRLBRMmTjR0E2Ub5wcULhxNOKZ05rETPBZ2xpOt7Vuim4xyX9YQTk4io9MqHLpeYNLR2H2BTH1FvPHtgrSfOzqEaAFa8b8Fh/EoYu/SURjPJHTX3rIPdqtVu7a3XdtZnGJvNC7QLbvWYE+sxMnjGBEqiW2dt3iXoodGjMKqVKiYIj1mGeOy8JepirIDxCmLQKbBcU65KgTGgjhyXNOe8L2BgXzljlFTDMEGqkyF6NQyM3KCWOVjotsNxGfY600iQszhkhb3XImQ8iSExhc5yr1Ho+dc+HSmTCTUQ5hPUzEdwFHtPpJUQaDw4SaDMlRQl0K5tlmv1rY3q7VN8nUBpRURblph5lREKriNOmvWJ1Pt7e5Xt3rb7kG9XsM/+j1n52B3y3H6W7v9/mDx9SJDzzq0VQpUgdQ+WUYSts8bJ2eXduufrcUpFomJRZMpulmG3JKS/ATlJDym9PfnsSuQa9oMf7AgP5YsDMG3HVQaAktAPKZBKT6bmROst7BXEhulJKESfswpu1gDE3fRs501h85rVxEvWcEhJRG1nPhx5HvB7cLXowXa9DTJZNiu8woGw4SQJMWYN3KRUBekY1KY5xiv6dEaIafxF3IaRxpxzciLXm9PeZTZmFmhXzmKk06sC6YvVKCJb4yms5A9TOTwvSFjU4nLLCrVpLBGY294k6RLp+KFkRpeXnJytVKrVao7l7W9w/rO4faeXa0ulKA8dEO7B+wuKp//SOLwZ8+OMEA3k7/gmEN01MEK6fQyXpmVDPzyPqyIzLRexj2oel+L84k7ayxLVkHzcZbCVRCdZYcPC/PGalBdHGdRSqhSR8eLw6Km6EgUAzlpf6Y5ylbDaCw39qL2hBh37so5cgKnvyDPKWEyY1llxgyPdsxUkLRPDzQLL8FrSZRjqNzjh6wM+r9WCZSQ0qFV2duyd2vb+1vVMnzlJPDV9o69U905qO1b/29BkVRknAIWZ67IaK8pi5jR58WFfyIiRESB+SFYMRPfiUx0F3gAI4/R/kCQGUPXOpIe5ySNGOtFfIXfc1Exjbka+MAPEdKeYp3LqkxVXwLs6SLKooSAivfkMjBlq6fKnaSjWPSdPJt1aBhOknBEsRjAaEltVuPrhnESBpV+b7EJHMPrYMQUtPPXzql5lszT0SU0aZKuFOrFxI1NR51wZqncYgzikFmIqvI7RoDECXUET/9+cm7WHbIsUYFaQLvdg9ICrKYoCVmqCKEi+M8skw+2q9vVxRgcuUNM7yxQvF5QD09J18ovR0sNviD5KgaeK15/mbhdd8E1jUrdn2FQiLZxw7gNFrYvz2ctiuJJ7wYXI96dGc/lUihO7c0GmDN4vjubHyZuEMadhhcZ4eRzU+7NcyusHno2Kqau8Z6GxAQm6Jin2ZCYNbtub9kLmKYYiveCKj9F/r0KjX/kROjvshN/XBhgd+Tgva51imRa51GYhL3QpzBY1J7FCPIxejEW+zALmvvx5LLFALs/X7RaZwKH99OH1gX/edFqZoBz6aUFGCSuLTtO8oJrQ/ZqIteZQYYvuURwlS7hhPr6tqeoSoX8vcC2397eWoAsgSFdoDvDdC6qjtdiBV+dIcSP/uxMIh9hX59PUNHI+xcp0H30LNFVaWjG3eYBLT65UKWXXoTTGGjGm+qlTSzPU9/aXoQlQ0xnfKRUfdsMX1m5HfFZJolTLwSOC02zDtp1Ynd3G8F2Q7xeNtRQLE4rQ23lYK2QvcdhEOuzvu0mpLK2HkiEgFIDukv0KL4rp/HXQAnBPRQGfQUxJaKXUeBQrsG1P0YwEOdal0kOx2ISEVxWLFEDhJa9H3jNcwfDE9miBJqnM2oURBmKqIvWz50PJ2eNi38x5Uqo5wS1/vJh0jiqNn795cNlA/6hz/zPT8vOOidAfQ2oFrT2Plhu+alIRzKMBWU2zixV80kYj5zToxWfzhUPWKPhHKa8N2lKxPSoIdOKwMAqX1sV4nm1PqhLax352/69THxu/fO8cdaEjxsiwFxPih6Dl9wYAEmuaFd0KWB1Yi47SB3S2sXWP305vTyhvqht2RyhjqkWweTxCLDCd4NhcsPNimrKRKtezNhm87fPF01ey/DpF/yUGrqx8Ix1pUy6vtvzRpmAKGvdtYfWdalWut7IRaY+OryKEucK031A8Fx1veBq9AhGIAgedyGM6vRay3r5VwODmgCfYHGmlwDnwAmZouLzMwXuaZUsQdiNd1cETY1uN3Lv2FCnc1LGkmB/mWPi4z9OPy1BAwywABI+wkgrVGIGVRW6KoLNg6mG2frSn48vf2tctK70naEU+2eXV0d49R0kAiji6mTkDF2+1mkRGDuu7M/UaXx17wU4UFywSzAke/22Eo4c6wQHHUzFAPRYjR53Ox0BedN7tTSP9M1mlldXTbc7GQ4XyZhQTDOHXpQXnlNYhPaQWUaLExH3nCBwow5Cq8ZLaPPkrcbBNn7dbDUvBLiwzK2dUMEGTHORde8Q1R5j3TxMdDLuHQkKG6sdZXT5BWgT9vMydJ2x9YGzgPjDU2pruhqJqGbCgYJkz8hSUhlq6gv48wq8Ki5dCgCdlAGmzlBWDY8ekROfiVo60L2Ay+rU7Wqq3tXMkjq6vFOF9XrxNSLWAqv4kwqfzZj3XNC2gnzhR6lWSgUdQ/wZ1xH/5Y3vto0f4OOu+DjV5sjpGc+NJon7wH+i7Sn+Ynht/iCBsvkT2GTwh9nk3+iuuuL0yJvLT92ztKpIgVGBKeJfsKpxxUDszTgnFB3PXy4wNps5VpRp06TWpYSC/rQmV8LoKhddFl4PjLaSoQifBBam61kIrolGPhqN6FMnM1XkPKK7L+hvYoFrdAHwksAaIzLO2rFSpFkyIFdUOWHgTspA1I5DDh0bhlK3vuYmrtmvb46QCcKBCYwBHCfYNWDf+NDi3a5qE9QsPxRp2tf/vuY8wP9cW+snrctj6+L4SDVa39uqb/CYzAd7Nw4hJ0ZKNZd3CyrlVySXyOGm0bSz2mya84utm8LTrXXNCcVhFbWhOtd5pAppDQvouQZCNi26eXK1L7OtS5BYrAnoDRB6kkuXlHEBY4I1EA0qNXTBGcBT7081LrsFG90LsWRLzMjVXZk1gCCWaPqIiSobxzo9DA+WxsGwpCvuUJI3xoCXvt+kc1xtg8gZGnXOV77Yzjk52xBUIjaVttVfrw2xlYTj0tTEwgO4VJD4MZZ2lQFGYtCL4HoQ0aAMzUHwlP9h9QFtIOwo/RikHSOccGSrKPLwGE6o4ISWqI/GAqH0eqNARGBdS9KuKeOBQn2TFC4zOooCOAW57BtK+BTcChcx1i4O8n3P9A6mZd3h9vbWJgfu/v2Pn8T3/PmvMLGLzZMUPa9hrta+BOoCQIlEWs5werh0b2BU+5a8yxEd8JwslTkKAw9oBdHFEklps/JcRleRWiIi9c6JzUkX5dj8cCgCFfBVlK4D9HQS4ICpSrJrH4zB6YIlao2MXLH81GuqWXhZYATLgXINM99N6OodtmlWMi20XLC1GT8vtpLGThwbwmvlWemieV2knI5Ke9HBzhWH+5R79Fw4PozBGJJWsLa06Pieffn0deArPCdmDhgWy2rul3D0f6CPvkjdijoQG6qfqsDJvwhPbh6xOmgFZmlq4WfOxr/T2cgKmAn8YfZi4xnjpNXpIMR3SVpErgnXAT8YY7eFLs51UhzqD28h5FNlozMmNlV9zhJQL1hVc5zo8dDQ+clr8fZUVosqnkcRQF2Qla6BUk71cO5DNmEWVQDYGnTR5VysmUYlC73hjUtyWHZK5wZ3XCbGjMeukh3xpMs/TV1JpXRXoy1+mFy1pUEYmtd1JUoYML+YXRSx65J3KBpR0B2I/p4XY6SUAOyhYpe+d8vRcuNJ1/d6MNTBwHvQhS/wmXUU+CDv+RF+AvPPN2Dqo0d5O4khXg8eBrmxioKQblhK5dFKnNt02IVQj3HOfafr+qJQJ+qEdIjeuz6Xgrs8bcZaxvVCe3KbkyexVG1WXDtx78YtLvSxTa3PFt10nE7bJHyzfX2YqyzzeGcczAuyQC7RIreN6kQCPHH0Jzsy/5g4Puti4hlREp5koRGN6fuSDQyeDNLSHbNGcxMKOFRGDpzaVkIu2OQzcYihXgo4aXoElN0hKk2xYKTfBd63ClEl8wUVOuoZHcBhYl6r6T1YNjigXSHTBMGGCO/zRUK+/EjLGJO37DeCvWaPHkULvIlYisAPJXvaTyNaSdm+RCvfWcpujAUM39VxAdVSgqqc2vB6eKmqZYILRhsl9krhmZREjudrJ0DOxnfiZLHlDgpph4h6gcPChbOPw2xQDeZlI3ix7oKEQ9wxdFKp6Fc9C9pwI6FblghcJD5NiWBsmRy3yHS/qRAnY85oVbztM4XOk1nHiZ6J+Q4W+n6xBSYBHwpaWF9E88tbJ+gztx0Bioelp0gIFY3NKQqOGv0K4bfebpzBGqC7Og7AkOW78wqyOpPkJoxkhIxZq49u7lG3VdB7UtRoF7YL9nXzrG2ZFFvWuoguJ0MsFqCAqTLybvbiee1vhiW8tuAseHE8wVItNOIis5DlNHBPfG6tH51Rth8OggIdDGYqNmfobokTCGG7rI+gFVsNeD9yMHytjf6EaKF8oDQ7KI6/cFZw4sD60QYF3mcCPL60lyPDqFAOqkiBk9s0OxJz21xkbo9++tIuW59/knN8EvTg45efSKfQh0bZOjr76Yl1oDbWitYDJePodKCiFoTsRsql041sLuWEJcqvnnu/HE1w8DmB96dTIByJpMvsCsj6vMSmhwWxOrIdvzMJvOQFqUdnL/SITPiyABemtsFynMB4Eazq16GA0OKCi9UBjP3xdQH3p47fy7LVJsPnfCObJQnmTxgFnrMgsaCRdeiqaJkQf4zqp+s6xC+Zrp7MLi90U1IKcRBj/DfHTXj9nLCRerVS3avUdq3q1mFt53Dr4H9Xq4fV6hL0dd1BGLlFEsiJ7HMQVzuoVPeJuNrhdvWwvrMUcaypd2DVdRx/iHviZlRUWS3ZvnIgyvrhplkBLWeIvmg3lievN4nu3ALzFah9A44eTETfxwd64idNoKVYzQHkpm9X/aRwFzPsCGC7j3fqteV5gsUXAzdYJkOlJZrQXmGXIN2mplQFys9B3+7Oztae5D8M5MEkCroPex0RMZX+fnE+LAkVQ14wBIoRdpsx0/EYi8YhgAycSTm7eXt/wdGDqejB6Zoq6rRyezTw/sCCv9SVzDGis02t8fxTlZwVJMtiGErvUXuNZEI2+x5oPYxvHBHpXsZrS31zzpdRMnI9JLPPR/0GbTdVe1gnbqgooQyfd3aOP3w4ONprtj4cVw/2qwfNWv3oqLGoVFGF6QuXmQbgPLo6TG6rQZjS5DeXscQwsJAxZlPBX3DMgHpL0VI/h9apA2rRUfQ4hl3LBTVg5tquq66Ch9DopEv5RMPQh6fhPwgB1oX/1uza9mYc9TZ71MAmMob+ZQ/Dv55ube1VTrd2sreBjARdWVyiCyfDt7GnY2VQy2FkyqZTfRJ7CExyfKVJBm6yNLnfwl5embksyXgN9vK02JJuKwaAmGEwty9/0opy2Tr9qe0E1jGawl7cCw2DuowGlE3mc1Fr4dXYyikeLEnUtzaWZ23o1LSukMZXYBlPkbwoVT+GlSsgUItVuIxqt9ip0IAya3LugBoZiG8D9U5cWF7EDeog0MG6GysJK7sWlXUwfhh+oYQvCtdOogmRKrM/zPwACr9zGLa0J0rYOym/tojhUwh3+Bsu7kf7KvjthkJ9w8RsEq9SPIJIfQwndOeFAZuO9enk8qJlNS4v/+foH4Q+aUDgKBIMcu1SNjHSKv2z0kCEkJI1fxakmprCMAFpXrx+ZkJSFdNEDg4h61BiDijeN86dF0Ym99R1C+L2Y1EkLl8wzTyT+fkcNxvNYb4ao9fPMrpd3dmpP5u9BeoYpWmYgjfFYrpVzjC50T8GwfdsLo9hW6OwKlTGqE5elt9mptavZqZW47d2JlWq8edE5j3Rn1ajKbBWjs75DyrIJjKpnN7nNv95xpHG9MFs8jOiv7jW1u4OP9d2HPGGhMwNvroi5DIw281bEYq/+UJOdLiQkCsaXoPWR5TC2HjeGjF5I5fL8nsyteBmnzlqjCkKsrMg7XInSeBctEdeErlUMkY2sEkycvPZ01No1uSNuLdH1e2ZG1bNgMnIZ21YdhTyjvnEsOT84TIM/dTuDaw5t1HuzFEZ1cyEYSfzTkaCmlDvZdQB6ipvEmzrX08SnNLSqJVyKtwIk6js2euUX4k3LxvVarW+aW1kOUa/5DGmyIPcTCKXa3VuJpk8ySyQ5ZmU5VE6Z3+KTS8saTFA+hUxy2x+Y375OdVKmq9u74b84C+zNWVvS+9O2dDz2CnfAl7UqjsHOauPvp/BodXu0ZXkhj0heZ9U5589DzO0q8Lm4QjOOCqkC//fZirgOKQaDePIldfx2Tn6RgJibn5+xX4pjJ/zvzuDsfGk+1KyggLTWWCYvS4rf822lmNvtVqbJTps+G1unSifua9QzMyWJM+coKdNtYIn6Dy8d6P2jev7S87QtxEyc7PaZO8szb5gVj/v/aenQ02Gz/6XxEtou53ydR2VYD60WKueqn6Ci155ZTE4H99COyxgvFDKA4wFDgVeH/cmiAhBmrJs30JPPOPSekns+gM6kzyCVKN7B7CgnbvQ6yMoUKXvjind0PEfYy/Woe48hAd7p3ogWjUv6ahqLDvCBfK+ql1t7lzJiZ43vinMS9/mvFBxQSAhNbhLXnb9SaS+Zugtk6UZsXja7rSOmh9bnYt2o/PbyeXHTqPV7tTq+52jD0cdvjKfd0P2fA8BF7Jx9StPpW59qkhoyhgx9iqOj9AY5qyFlCCqg0V4bJmYp0k8oUUymiT0R4VyZWPGsLWusyR1ejcEShPT9Y8OKDHyyeJQpLnyXYGTUIZKtnTKyYlt24szl0dSVMQcYUUCC0xeG50L5LCRcwvibjx9sW0GxhAC0cy5WGgONMaOnAUYCof16BAeRl6hyEYz3JHlB43LzgGa5EkplS351/weRzHOgkvCH6Ukk67txy4ppA2Lxve9octXls3WhZq/dMlGi8Iuvr5lpgKqODOLqwQa9fzKaRT06dnQAVUMr6piqFL1xHVlvuO93aO94/rRzs6H4+Zec7+1/2H/ePvD8Yfj6tFB62iROYlvnNo3mxSQpLU3PysHra2DrebBVm1rH/5p1vf367u7R/XmQW2nXttu1pq1o6PWh3pjwdnRR803mR/oPn+GNFSuviNffoYMAF6aqdXsm939vePd3d1GdWe7dVzba1T3W/Xjem233mp82IYjvdqs7+60as29/b2dD6092FFbR3u1+lHjoN5sHFefOXOcbPBycVRGpp+EZ6cRyE+kwuUeRCmkRGOWMq4NnXp0EYaJddSgFKWTYBA5jIqEN2uXrjMqW82jn1RWLPz9jJwN0fl/na2ijm8WAgwmpIH8ud+YgM37qEvfcGL4I2ZE41LDJdZun25q/dqCloI+7M7bLMxTf9vd6db2+7vdnZ0erKK9+v7BVr1e6x3sdp369nNX0yqyOZrw/SZlPBg6MiGxcSfzJHeYOzMnDwJrNNQqVfzfJeU/wP+eV6PBoHfp7I7nEjyd7PE1YmsHe9VVEEtgUFGRcZcNVLyxShYKS1jGZydCpmKtjlgE7VDGIGfCILgDSRWwPembVPK34B6GiYP4HbGLk+8J0ZiCP23rN0b4S8WU3zmej+irRkC5ahcrk/bCscf27jWMHiv7kGEmwCPt1aBFSp6zrPyW8jkjkbUk1pAWX5PIo0f+jURxM+xNRgo4fkWSOIZzlYr6dNiWjos2q0Q3+bpDyojnb9AxFuYZLDMseFBmOj8ffUILfmt/G+0Z/SD8+6lH1byUFrJ/3vP/v13+vzkFP3ryfy4v3ljmfw4N72n/q1gJbzvnP4eg7z7h/ys0/yjZ/jls+E6TIExKv7s8/2nivp8kf5Oy7y3DfwZtP256/wyG/Fi5/TOY8BYS+82hv2f1v2BWf4rx7yn9L5fSn2L8d57Pn0/r20rmz6PhPZN/2VXwttP48yj6rnL4v0bgG0zgzyPpBzBc32TqfpH2zIwARm3hyHKyQ9D2A3FNUuYLTWc8BhUYL9eyZ6XbG4MmEM1tubiwGEChRsE+B6XdMPRdJ8gj6AP/ZA18J0WWgHnHkNbAHYZYqALnCCvj6HKbqHjqW0eQgzEVZBfxsAGWr0N9CD9PgsD1595uAZDRkaGxLzqVKh6369JXNG6srXYu8PPZxrK8dLmOk8ZZQ5dJXjcrAnlO4FB4shOjlop3f/Fm4scVVUANaahwuzN/sB9ukpH/V7AOgoocY8XD+5h0iJSovKKNBh8D06mUSG6Zq01QXeedlciNwS4pcsEBAekgalpwol8q/6KvugKOUsV3p1bp3MuM79NfZ8SvGNtzI36zJH2riN9ZI3mDEb/mXCw0B68z4leM87uJ+JXT9JYjfs05+T4ifr/lrKw64ndqdr6TiN85Z+hNR/wKGguN+G0/K7Y3E9Orzwge67eJ7RWd/9fZil82uJc7Xllw79bB9vZ2zenu7uztbLv1enWvW3Nr3e2dve7W7nat/0x+rOoKF7S/0TgT6yoCO19DcK9B70pudZ9D8IsH9wpiiw00bc8dUjolkHMEQCboqDAB8B4H+e3iIM0p+NHjIHN58cbiIHNoeI+DXMVKeNtxkDkEffdxkF+h+UeJg8xhw3d6nWRS+t3FQU4T9/3EQZqUfW9xkDNo+3HjIGcw5MeKg5zBhLcQB2kO/T0O8gXjIFOMf4+DfLk4yBTjv/M4yHxa31YcZB4N73GQy66Ctx0HmUfRdxUH+TUC32AcZB5JP4Dh+ibjINPX9Kse7RmrZtbYidTVhrxuhu9iEa9F34MiMvRw8XF0Ws5Fjl1feyZZRYcHniH3fdDc+xxCR1fYKjqQDhGTzK+RKAFGv4pEClZBIDGQ82jKUjSDnhQ1a0Jl97TqKKt8QH+kR8vCUL2QUfxRTGAVKdf+ixh5gx+OXHFhRff78HQkYkO5EYcjQR2K3ytjtOgNhQJQaQiMVaDYUAor+Iu+7/Z6Lu1cB10jDt4BW/A06J68LvTqHwwOnP2D/Vp3r9fr7zh/mQfclah4QZ5Os40+M+5qzKDJ6DWFgSAPfe/WNVkmAtW6LpqUwKyhi6xi00le6YmWHTSrI8VYvAz32QRTnSCWbFQRAZUYfci8jqf5ut0dHNQHWzt7e92t7b6z62z13IP6Qb/qVt3tva3dNDvlWF+YqbLbuder+Y7HGEo33vAGmUVDxveweJA1cp14EgmLkhaxWpRiASuWm8tYHhJTzKxWB9XdPcepdp2Dar27ZzBvEvkm0PCXi9OvAA1jQR4BIUznXR+VVEL7YeMvxC7FeQiiGA1yeCXm60nxpBw80t+NXOcW/dv98D7A5RECC2/cEVDKIE5laCe5Ee+HlgynnQc7mBsoSCCvNal1uRyAkVq4lNI4UyW1BCxQ2IAKENYov1EKIT9HziNDYIs4dbz6DfqbyELka98DcznxH8vKv+CkSWO72ca2yWmBbZc5DlxdIsO6QnfFMMQ+8KdrgZHFnDNHyAThwMRdNI4TFG4QrD60eLer2nSDnh8KB+L1v69pjq7/c22tn7Quj62L4yPVaH1vq77BYzIf1L4Q6U+h6N8u8mec0A4Q+0oOV7XIw16bA+FLJSnIOPaiVgQB+uOwNOM4iBalq+w8Rw0RW1iRRmuJYnj7MrzOd50+75LEmKrLbOsIxI1hBFim0UMpJEKpy7gusSISEA0mNOKs39AxmH5/qnHZLRyqXtgHMQ6CBhvpoiTH8WEqgnkS6JwEfhgeLI2DoQGDha+XbPzO6OssTEQU8j2DwAm6SK/BcepTSo40RvR2NlsTJ7KHf8LyQspTeRC4ECyMbNfeOrWw1kvDP0tlHg+3UNrIrqex8E7JRTSInOFoPif0QmvoHHa80LqFWLHoioo3wV+vDSGThOPS1HzBA3znlKQUYTloQZ6iZeLPo67KIJNvU8wFxBEVycBThEqJeiOUik5AR95jOCGkdi3zHo25jpPQDNsCUXINDLWxvWvKgqJgUpKZvG+9mFyTAYcvYdxfJMOhpCAiNUnHRkL/vfxUFplwo6XR4fb21mbsOlHv5u9//CS+589/hclMzY0UDq9+fkBDGoV9VI36WqLRssUYSjdI8U3xK2fnw3MB1060RmHgwdSB5GGBEnZJsemr07KLlR7ksqCZBDUiNifaoaQwsJWGcVmdZ1SlADho/RdlkzIcRHAwKRqpDWWuC1DJeMmp11Sz8DLIWUwNkgMtpxQh2I5ZwbLQEsHWZvycWj1jJ44N2bPy/CHRvK75QAeYPTWG5Ob5q3eqH4z4TvVhyD/BiNJUtyAcnndBxo6NQ2Eq544j1LI0Mw6Yscz+h+9SgyLbsUi1gzoQi5V/7bqsffAvIg8vjwYdpQg8nVpUmfPl73S+sG5iulTMXmyU2U5agQxCfJd2YuSa18fwgzF2W2ifEd+5UX8YHSmfKhudMbGs3agWKUcAc/FG40SPh4bOT16Lt7FMT9fV98Ae5SAEmLIHRgnIIRBdKVUCJBMr7VOHKGdZYuxDp1h749KwFnWnJGqlpYT0jseurhQ96fJPxjRmtDWjLX6YDLnSIAzNSKISTkjJ/GJaUrLWJ/jax7plIw9zb0Fa9rzY9UUCh0PJfMLNoG+g48lg4D2oFukZylsFEcmP8BPosd6AGY0eBYKwMwY79MEbcUwGjANskRh0BOgvIcsyqxDiVPpO1/VjlD4+qUt07ty78AmpvzxtxlrQ9EJ7clvKivDpQCvlMyMDtqh10KbWZ4tFOlimlWu++b8+zFUPebwzjqg0ZXJBFbnIVScky4UyzMf9I+xe9Jx7erGKKvIkkGIjidSX1LE3HkSWO+YjG5Gq+bUJDDqa2gRiF9tkqjvSiWHYFdMjID+hyE9n6US/99gLqfw6iaz2Rj3DNoFZ0JjN5o4pGxzQFvg0QeiQu8/fwPm7PS0RTN6yuwJ2hj16FC3wkuc9Dz+U7Gn3gGglZZsRrbG4x1EySa5L+K6OlzS1lFgpp7anHh5Ld6HKy5h43UaJnSF4MCSR4/naSM3Zpk4897Um6FgdIuMFhLkLR06PcgpQs+OFIqhfd0ECYUw/ekNuA3SNObHBd21/kFAsS28iiTdzaxubJMdQn+5XO1eMKmkwS7QO3rbMJ3k/S9zrmZhP8NP3qXWDju8CQwa+iOZzFG5zBClXrvw825dLq5Bc9sKjKzVHkKesFKOAgKFNWHDSo2yrUak5985RprDwKpKVp1aJqEqH6+PGwWz0wMVQBlC/gOnapQPfeKBXsdpInZBYCSOyDAN6zeuXdYk6cjvDDw4l2QvrkU8AQ1COxMTNVWYO+hnCGIrd9WZ1avbqhtGjZi2pvCOXwtfCwSydDeg+bTbOkYUNXrRN1ZS53eeHORe0U/JQgQs4nZ1kP3d4eHh2XnvBUKRzLdYHfRk1A1XFImMnNnyY68RqwQmfuF7wXJbQmv5ma5Z6/9aLlllQWJHe7PWfQlQi6kUBzfgR5m+0OfadBAWnvRgVBR4g5ixyZ88dopFyv/I19kUVehXgC1QZJuJSoqnDaEB38iwtA7y3Dx5HGBRhai4jYxHCfkRgKNiE1/gSLJRrXIP8AQm8Vsol/HfA8+z46QMQczYz+jp6Dp6/XKcXak/nZ6xykcrbgzgnbbddqVV2KvVapQ7N1bcPavW9/b1KffcAPh1sV7cr9a2d2sHO7t7+bqVWfQZUtSAxu4oXJXL14rl9g5481qQidA4bF7h5vHJsd0HRHIW+W3jZGw63wJ4wSYc0ek/vc6GLZTF6br2uEzgdpw/KOiL1RC4Zg8Gwgw0+A7Hnu9OW1FWxNAh+SIVQU/9KVUI9wHelMIcpP7BaOM2Et6oYTtPxKlVDPch35XAZ5VDz8TtWDzWRP7aCqPnwQ6iI30KDMOObXqNyMH9wzQo0Bzm671UpSNP3Ks/79BBf/iiX/b+f0jNPacmit3oAK6Ty13W2zi/pljx4VTTOj3CmJk40dJMf0jUhSH+lfgkxunenxDRHfmCPRIoDb9UdkSLiVfoixAjfVZxlHBGCid+xF0JQ+GO7IAQTvmNdyQyK6jhDmbFjhEZZ+ts5AqS4DRkmFVAuPmH1jlyOgXesbhTeG9nPandfYkoSZ53ECCGOJ1Fg3btdmdJLOSrYFAa3qYB6kcw/UUOVwezzxzT1XWz+pcS16G16Lr3zmzBwv2KjFDIgzbqsdHEGTuQtmm/17VS2wFgVndSqyALA/un5vrO5Y1etdZ6D/886Ov8i5sP63LZq9U6NQzI/OT384p8bVmMMb//mdv/hJZu71R27Ztd2NOL0Pz5efjot8zs/u73bcEOChGzW6tDRp7Dr+e5mbadV294XTIZmtkVJJ8Xq2B44I88vKiEGSOH2rXUZyRm5/RsnKcNzXc8JyrDNXLcb9zGIOOjDvt3IMJCfzIx7vozJ12lif2ZoDCzHRWqgVPsDM9FYleiICGKLld3M6uIF8yn8r3PnTvPo1o0CtyjjLEMD96aGzcgezv2sfbFtb9vVSq1WrxCQp9ebHv2bdhfPmGEJG2DM76wp/ec0P6Sp8FLzKfsTe7cHMi6My9akOwmSyVP71Ynuvcx+xYEVZg7EHMx+LfoRSApkFYBBgHCHf/IT4TSRCHOhyxUF6sgCHcLpE5yfG/VQwSc5hrHR2lb4rB6PEXrS98N7bFnUAdS5z5TZtq6wgjYOQW4Hk4cyLLIecTTwHspG3hzxNQsDAWviMZysrUV4wjuUZ0Eh/SKNSCT3Yuh+WaTtG3kbDBWgmhyH4wnaSli+0HcRU8d3EYqVMhwwhh8YFWAPTsDwndxV66hdRq6Oo3Acwkueke/n9PtU4zEbo09kzqsRwxQWC0+VWefzCqxa1a5NH6DFDtXA/fqKGoWHvqFs3/niwBRq9q+njbN5FGx8TqrWmOAvczKFqfho7Vfrdu0PMFuG6/EGJ4ONnd6tmyjgoZhzOTAROxgSNAlVw+A/qX0njsOeJ9D1sIlAJmuTjU5GPFKtNqajIH9FZ3wkykqQaqeccc66jdTnUYF4AlEfm4Oh+YJaIIXSxkg6TAjegcpPGsA4BPAFA/2j4gWVPxBqxRnHEx4lbF92MeSNzErlocM68HpG/prIniDIFkcl3MduEEMj6649tK3fXfe2bP3mAfNunOh2g7LJvTvM5lFmGDmUImdAiMhTnPBgJNHMWeUmLH5IEKcnOLbWZV6IaFX8lqZ/YwaRT5PH9Il2n0vlE+SxtPuLFOf+o5K/6P0REgppD3LWCi50rkbkSnYAH4YkC0STn7uyXJixuOXqtc1VLk6BnPUnH5cYZ3Jtmy4kwl5Ru0LggUnHU9+DfeuSo2t6h4k2aQRGe7PmZQA8v4dvYRVHtPjjMns64OzrOj5W5YjiZ9i5hTlViaCTJhsQuCQ0nrTiflZez11UuDgz+PNYoGsSBeRKeg4NMCkI5z+fi+Vu4iMiPlh+EvlViv/MD7PPATwGUg3NkZHm5HRtZdLTZOln7WiaKx2MFbhCIRuo9BPmyrJCgPI86t14ict1s4iQJMMXh8KHYtuEZYCDQoCiSO25ovb3+sC83WiSVYt9tb+0Wxv4Bxc08OlB1ah+QaIfwpPHYt9upDJJdXVpTHx+jIcTJ+rb/Dehcv9x73ZvXH+8OQg7hNzjb6K+57v9oYtNb6YI7EjdGVNOktG/f6GGdOW1FDP0s//ZyMVtkRhUMlcwqyau/bsk6XrGDWzPx8NCJnkXWaQh1ZECNk1xIe6FkdYsU5OjfTcm3AwV7aAa4L27ON7MgtP+2p4bSdsY8Wt2nV2Sh1J9kc9I2nLipIrVwQ17A89As9u8t2dsit6da4+8JHK55jpKrs2B8wctbv+v8GuHEmI7xuDiDhy0aCb9+4iA3VW3pkTFEpV4AmNFC4SKhElrmRT+JzOrJwHaRGDPcVUYC9Squr1bNuFW0uwQtt3F+dEzymy7VCOh6G0hZadxm0T6Dl96YmL6zKnJbom8KcrZE615WVCYPoKUS4qFQFg/aW7I5H9R+CIFmpF3RFqcg21bJ2baNDoEzAs60YFoVN4mZ/k6fWbMu/TvYbN1PFjrsAW8/oZY69NrXBv602v9pPmfnDmqcKUh+GfuajOEvOkWhxPegB4Y9my2gElpzULaMOQpMNAbstGTQmdIjLWAZZdS8zILayw9I72hV+l6AX5LXl74/Hf84yfFx91a7RlsxIXXKXTxC9sRyI8RUyB3qebWnqpVa/v2cxYFtg8MtYE1/TAqkCQT1WH6WKchWDyEDFmXMGldf/5yQnjBbXd1IZqniBmALZbMAB+CZhjRIcJER76wrNpV1LNr8F+By4J/wkEjbxVGCLkTI/Soif33ARXLWLQYos2JehoWLo1HdN1KUnvsh14imTJyk8jrxda6kyRO7xaYg6E52o/JsHsP8GgZNpp35/nu0BXowyIeA3FsyVGyUUYsSfhbt2pGV2AbBqIgaJoRNYtNiTgpGpMovUrAzzOUgBylSyrotHQr/bA3QZI3Mvrpjr3zvCl2gzsvCgNsba7bzBea65Y5rK9NuhPATEtQSVolYobK1iIzRHfyoORj+/ErmCLQ0kGOvabZuRQj+trE0JXgCCs6EaORpX3PALoqp85rOVe91e2LOTlcrIeczPczWcEk5efQBvP62a+gmqnDnlDBEiohrcGbcBpofToBohaRY7p0Gt5jxMsn4OxkVOLVXMKitSWaAjTOrDu8bu8q8alapJUQT7sduYa36iuhrnRbW9AWo0s9kucQeIwgTiaaKragH07NkbGK6AnEWL1HDCjSXpzAGbLH6fjkon1pf46GXLTGWqcvUHhaX9oVpAXvi4IKcGzgGaaWUS4GgV7xAmjkxRLrGqhD3wLJffKjx26PFidqtiQnUPsag/akmZS4zgi0r14Uxqw4wwLw+zOWaHDXt7F+oT0M78hTURGiiJZrVhjwlcicFV14SgrULtSs52oYhMuE3CNBIQ9Bh2qvUcFzX1/dRR6WbxMTgZBzTkQxA4YIWIyDGSUeu+mprr/ifcTKNKbTkSrVHE2VYH/y/gkLPWLPdDjwzQtbIrixpBsSNws1bRTnilO1ME3/pMdVNPxHDO0aiioO1uVp20Jhyvc3fW/o0UkoK+TpsneKI7CUE9TxLNDSHfRyla325qeTT610b4GIVu/CiemyLx22nf8YE9wxAanH6nIC/fi3as/+JtHWzaJjHNAac0UJfLtMCNvqdpci+a7xB6pGdG1TM6JFDIx1Y7nemq2LCsj/ED3/qRKMWBFQxpqLsgD45jWVWyHw+tSlCro25eWxuu3j2yoeCL5sxzdOfWf3ekOR17oTk+okOoDWLGCbcSrLGyN9nQYMTQ1FsoJrIjE/TBxJ4XbG2RYOLOs68WPbqN90Lco7iBbp557voYeaGTr/3Yfj00bFY4UyDuKii12JgnRGv6rcVeNsw+YIPOwHwQGjR5T8vantSOqBrMHJioIxJ+Ta6VIRTdyGFGXJM6eLWeAqb561LZNiC2yCoK/gpGOhlqcSOdxsyc21vxno23NrGaJG9jcp8agqPC5WHB2LW1qoTlgNeB+L5P3/3R3ZcttG8n2/AqU8xMmSlGRZPlSV3bIp2eFGihlLcvJGgwQoYgUCKADU4af9jf29/ZKdPubCRYCiYlX8YJsk0MdMT093T0+3+I5O/IZvO/P/Ldo+Zpv3fWS6n0Krx26zR50eVSdHMKHEx8ufCv3esbdjw0yrtbLpjD+ZFo9nIBSgFT4H/m1HJr51V8fNFq6Y8gfw+QSaO3ZjuyDZHVn/izaBjOJ8gu1gWrDj6f3W9gvg/gV22glmi5LxRyX5oTuCz62xPawsfSNo9Spiq8/3+nuv+vsvnb2Do/3Do4M3f9/bO2p/HwcYovOox+QIYwxtuIGzg9fIzf7Ri72j54fduDH6tD920+23qjO9TPShg/y81My+yGWHttYGP8KrvvEf86Ab4BMvnJ4iTHx4YMY/GZ3qjV7ihgfmUJt2OSwySFHiH3zR5PD5/gaD4N8lcdSu+ZPRX8Ti9YRB6M4TwkG/KU0aJTG0Y+jl4eHBK+WGev5dIUM8nk0oL6yYOd6e8Sz46j+AaQxFQDY0u4XGXGYJ9KIU+/g0yLOKFfnidYcGuYHY5R61Jy5fYiRU8mwUtxwlttW7G4ZGUAFlgpSZGbee84k1llLHGU8WbkTtbHvQWEjndJO3mnNEIUYnKQTDAo83koRSvfVhiOquVxrYw8P37969Gb46Pnn3fu/N6703x/vPh8O37Rvey7DFoyu6kX2l2eqOLokwNcLvPqY8Lpc+HvmYxeBpS5ZhFudD7Jy6wh4ZpveJWIhhME1dOBY/9311YnolgK6mmMd0FYfiafHP7jSMp+Lf/cH+i90sne3OEMAu+O741+Aq/u704OBV//TgsNwbCMzyw5f9DmpYdrb+Ju5mpvzNumbkD+8lr/j7Fu7k5t6kpPspuJNF1SMDNbB4av3J84uftA3ac05/Oncj5z14ikE2iw1/k2L26F1ubbafjCtpMd2Vi2/tS9YtSmviHsLUE3AcCzy2ZuMv6gTKjvOPaukYt4Ao0o2mR0nMDpqI7gPmI2G44BG2WGDCGKKP/ZnMbORzm3f0jEXCPxD2UHZA4j0JXlfnEPIIAU88w5CbTGL4GUitjJjjVSboAWUo6qpxqqAF/hxD+8cZHkT08TCAAMp6DfQpsC8wwUG6eYPKcX5m/FJ1888WpcDpAKolfJU36CWhQmRUt0tos3jE+e2Fh5fBFWViQpP0lW9Dp7GxwMa4bORREX6YdBgZNVOYaIOH/1erFKeHkFXxV5qEMm8wV+ZzjWwh0MrZLQOuFIVG6DDAYPxDJf9ILHetU9aOEwYp6F1HvguporxIZmG88vR6GMJHmT2QwtmsCydj1UvkjH+lFJCZ9SqmGWrvRHyY4AMTCRKehFaplGJmrphCxrt4aRAsxZjp+jC6Bsky6LvTmbf//OBFs5CMAAJcqpFJjESuHBEWke+ctzBb+FAsfB9DWCVBQP+AqJK8rpnuyocbp9vAIQnUCY7NaBRD6vnOmFpIcAFXWzE2sC3d2SKI/IlxU7oZGb9gXq1ui8vMyZq0UGrNb7XFKiYcNVnLiePHu88btOFTNmAzDuvRSvhSLXjx7BpllfXCsfxcsbzoN7RCYLcMQx9bWKNSoN9ghWdQXmhC2llbF3JzJnx9pRNqNlFFVtU5tf2KpURoh8AKV+rHqsEyBqz6lcpBq0EFGqc7NtR0xoLqiLXwZjukm6PjdqZCcV58PP54JOyLW7BAlm5ClQL+WaLF2uzXbPgN+lzrdCJhICUXtlUttz/Tpwogo2gem9LK2wI26ZS6xhBQ+L5SPHnfOBmem7eOZSfJbODPssH9Mhzwc3Rtzk0p8gqJZ/rNQtXcWLWPrJf0+qmx6r5JENM4Dn03ajm8cz0ieE1HT3sZb5wNpqsgLKMsz6javXf2Xx/v773ZaUcO3CACDGb2TDUhEF2pXAdNtGR56uezRXtiJBYqYBndKwm8Xk2hOEyOCQMsh7+Y31XA1b8rm8s2oDRQx5TCZq2qX1qrWS2iu2nXJPYGLYe7YUSNERAA6ZZyJapV4G0N01hguhwdVyMKkhIe66v2KEbjMgZ00eEQYmvMaIhlZEJWtztHkSzSVIOs4N08HKEEWHWjHDD+7z//zbgqU5kk3iN+fPBuZPw8EZtcApUB6dmdH3c688S7pwBUJhlLalLM7cnRbdBWTXzmh3hB5umRriirJlxIVhjAYVa+3eWi4dYsGk88EN8vZUhna4g13BrEGDycr8Kts2wArkG9xmrdFLECyycVXjDHm5Y5dfWVrcx1zct0FUGA5Yc/yZrvygWhZuOC93FtWYzVFxVw+UdtU6iARpUNoGF3MwD8u7YjwxgGOse8we1gjv8dh/F14PbdVR5DMZj4xnRM/0W/whEV/nLvmM85RtRlbQCrApRpgTEdCmRdoJefG1CUz74R1CGqKQPhfNgfzxUBRki3GmfgdUd34kLFSiztuXCta9mc3sTN1v0A7u3rujreimpA5G6aQ71vI6yMThCU1MMb4Soui1nviZsKwuHijPC76JYYzpufoztGPbnxC/jY42vHSBreLXFDbDefUY7HaExPsHhB0268EIDXxiyS8JIJpOaLkakeQs6XF6C81SzvPpCYVKTWLoMBF0Hx1oR2Y3Gx0H6fqTpvzwzMP6xBbVw57oiZ3lXnwYp9QxYyULNYZS+IqulYpeFm2C8/nTpQ4hjzWQgdSytS0jTos1VaOLSyXeQarL8vfFwGmr9bN1MizuEEyMKBgxhZQyWFrEPlJRZPolTNjUqUFDbgAIMqjYVe4lSW2DRu4GKpZ5mVKNlL3SijG8hAyzwUI4ZUuwnksnh1+swIuDbMgayMgZW2DUx2HaCfLy7EUj27P//ttOd88oWaxtPJT5dnUBNIAdwB4naACVmNDr5Q2UR8rdarCozq5YsbjbH1NhBvnohJHYKXd6jQW5GpQSNKN73K2qzV5VIoo34YRNtDXdpWawh4O83icJX7uCvrm5op75hIhIbVjBOuLYFJrlpfrOedXzG6ZfAA2CQ048Udp4VcwvFXBXv4Ogh94bQJb0NtSXqCKMgDPLFtnsUC1i0J0IbYHyRDBGu9DBVwblOGbBKa8XaVoQJ71TKUga6DMopLneTVcUOTIKAYgZrcLEI/RvcvQGto6vuRUuGYnqlTPHWVTlCfA+cj3GoDd0zYBPQbBasRXT0fqU5/b063rWECk8NtJnrOPlY/dVF+iBKrmCjkIBt3+t3w/itYhWdUo/OekukyaZpT+nns+JmYKpmKnse5GxYFIBc2iB8OSqeOrafG2ucQGLdNYCaUBSSroi7cGx9nyaiqLUuK8ilZT9ZivrkLoUo8ZNTfiN3yKhV/CTsugS+DJEh6zssgemHulFd5Us1h4G0wZcLCtlgb4Leffx3B15//wHq2woD+gPTxMxmZ3tfYbUJB+/DpRP6Or5AGpuvzo2MAd/LpfCzgyZvJA+fDxbh/KV/SlQWo9A68f3EyOsYRnsZC49DCJ1GBNiSuVz0QVAJJB3MLgdyG0dD3PgsTLozdJA7EApTdjQJjlRK6SlI8kM+IDi7+LHpMnE3jk/sbSQzMiURFEyhXQgYaitdn20F5VCLWjgQI2iaLBq4+qOkXGlhY3UpmJSFKCzjLIE1j0NXQF8ItCjqFG2ht1IyXlPvN9Jd63TDWoc5wawb+psM1BiOyOC8Y+/i/XjFOz+sd9edoNCowJ7aKTIoiRcU3mAkOpzMnZ+PTc/oKNurZtcVOWTqRQ6OFFW6raRpgNeUe7fWqCr2qn41lbRDJwLlMYA86cCz6Hdxh5UBUcW3K5Z/NuoFbgdOiuhn/ChDT1MB/kiSxP+AtYLLR2tcrfzwexydqk+m6KWte7VQwPytUm3PDSUF3l7NtZL1t4fveuqnne/qVYpCzOWnNcovYoJcVlUwvG4/nhVl6d9+jKAg68kZ1Ms6QxHnjjkPSmtXbysBqTUi+uuW+5/Yu5N/leM/Hk72ozNxLnXIq4DgLsT+D2SfkjlN2nS9/9N/L8YH/fTEb00Uh+TGqoq74jxdkANjDy0lueOveZxyrxDtMPQ7uUVlWoz4DVvkgZsU8fEGWIohBwnjxKJTsRBjc0oZQP9PF5ztN84WcTdS6gqw8nsWhWVnU9t9ALqDFBd/M4/ioluV8Rl0ns2y1xDXHIScMokzQd9Bxp533EAjCiiU71dGnNrEnjCYFeeaH87o4Evogc6PXVYd424gr1WfgupDzA8VuXKF8+FPEPs4ICs9gacuM0iF62mEq7LUZ9vuAikNUSoi54Gx64SsVZAIRBN46SqmCEcES2okqTGFdH6V+4DiGRxBKxsnVV8amWlu0V4pUDd+4vCZPubihwRwC7wOoyitjay7VPe5D+X9cy+ZxlbEEb4MwNItaF7YCaB/wfVbCDy1azA6UWHatScUC4+uTJu3JWJNxXpS5GnDmg40QS9NSA9B4rhEeGz6YQQlXmibTeyHrE/Rg1+DhV/GFjVDxJtkFGb/SBh0YGBvxZVpFrZkrYOvCmolvDX8ygE9BlwniM/TpkL53ziFDAgr8ZF30Ku736k1anipogveX8Q5e4qdB7BkdVoLIJki20QB9iQVbvYEzhKAJpOYvILcdj9SiGZenhDYxWCmYln6S+jdBvMp0A4PqdgWI8QHhFBXUAregwLthiFpJxcWYHhMh/Bo/uPG7GpG/qvNVaS5KQDV4vDROki2gYTjCJvJnrqzuJcdjpvpYXft+4qySGmKC+WTL9KicKq5IhhvkHC7LC0skwOeCG5UCWqTHB58w25gWeD3yQe7aTkbiXbe8S7SejjGYX/biQydJ4NBr0G5bmEAzoZW/8iki6ad25XA0PfhqnAm5mMDWOKbufHLnJVvj8rzE4B/HY6HaKVbMh+WIUjJdQxfyLdzXJF88WPhu3SCns2H2S/HoHjFk1B/IVg66gRw0EVD6oZHSRXC1mNy6eeECwXpyz9y7YLlaGqkYFWS7FtFancpBNPxrjtrXHEHZo/uYysYiGI6Coc1ovRASLCJsAp1Mv/qbr3S80UZh3fHb4S8nF5PPBw6mkqjxEbr+qx9pkgM8ZdeWZBti7a38IXuC6xkJY0CnjB41rhGDEiGj2xguuUMAPD7b47WLfq1/K2nWfl8uPP4rqD80YbIMa+VC/uaw3dLGWFGtC7hjHBk8UBDTuU2DHHpOq2t22uvB7ncIjHw29NOVIkWi6eppkVwAwu0W6zLXxJMDwN/dChnD8aU8PxVgoBk2MAI2kk1cVSDpWmg/XTpVGIv+Mk7v6dwEwQjvSQ4J67apAAZZdeD5zfPigZ4dgVQGHwc3qPmlAghhgHrirAgkEGpKhBuVIwEXRkTpoQEBKzrVGBcApb5qceAN6puum/MrFekmNPC3wjeFbqhUmxldWN3ICcvdiE1L7MmZPDCRIZceFgCleqoZxKgovLT03cis5wIRf3gfpkyfvCOEmhQV2Yc0ywJhisuySEYi5cdfjA8nYEQZn7loYvHrIcXv6Ovi7cBF7LXOIdgV0Ke79FLloOpwYM7loXRakcPYONn2w8lFzxl/hMoD48sLblEQO9j1AHTC+W+nJhDIU5vqSqrnJ6cnQ/HW5fj47cVJzzkWn+FfDaWgZ2WLkxYR8vgK3KVCUxQkxZRVVG1Qg7iCayuiCElnmPqwSmT2A8ajMvH3wnm2a3cr61G9JXrNgPRlFy6tZ7v7X3pS7og6gZB/+0KAPC7wnpUeNLtScdtDnEG8AY8dVlgdcRwVVIjQTaFMUwtDcwSsHlfFoBQw3CThDeNPaSIFzdA02nK47Kg0yI81FPpZk2F4VGi6Pi33LIfuAj1bE/BbWKrOYlIYOCoDpaM7i6+SNbhYLaFFgrAbqBIB1bEw2ARzBiJqetaMlsVZDKsK9h/sev9FLCuHRWWS+dCwj1rm5H6Ws4BwVl2QmyJRhEMLDA760ZhBiGBfpzRwDK846am7tE2XunZn9miAiAh4Otk3s6fZvAkJKgM8TGDUeN6a+4tFGszz/qfxsPi2fsMw0gh7gRkh/AWrtaKoBWjUgTC4Mp3zX+ca0EOMdqyMGLnnmU0BV9kKp1mdxviWQl8qUDG3i0lSX532QKtmkHtZoc+oIM65rlBRX9jEdrcmYWcI6csWcZxTZ1c2AAQ4vfF/wg/FynnVsSmgw1zBSFPNzs4z0FFyYKbhKbWnFpa5lCpMBYfGsUTYbWD0o3jmJnjfhjqEuPdgREfhPetkLGt/r+Er8EKjmmcEQmdA2xjztLxaqAqtHrbHKYH91qxaRqMwhqAXGgYNDdvxzPjaeWZYktkPXaxIEzo3BPDk9mqlMNoSV32SQNHmIOoeQm/K9lQ+Gb5QHC3n1zg3bQssP1kwHcozxmtfmLfRVb7oqTKLaFXgdxLPaGymf10M5dFqqcgN8i5gbBhn32QESFq/5RD8H1iPNYo="
 }
@@ -18,15 +18,14 @@
 package main
 
 import (
-	"github.com/njcx/packetbeat7_dpdk/cmd"
-	"github.com/njcx/packetbeat7_dpdk/dpdkinit"
 	"os"
+
+	"github.com/njcx/packetbeat7_dpdk/cmd"
 )
 
 var Name = "packetbeat"
 
 func main() {
-	dpdkinit.DpdkInit()
 	if err := cmd.RootCmd.Execute(); err != nil {
 		os.Exit(1)
 	}
@@ -26,6 +26,7 @@ import (
 	"github.com/njcx/libbeat_v7/logp"
 	"github.com/njcx/libbeat_v7/monitoring"
 
+	"github.com/njcx/packetbeat7_dpdk/clock"
 	"github.com/njcx/packetbeat7_dpdk/pb"
 	"github.com/njcx/packetbeat7_dpdk/procs"
 	"github.com/njcx/packetbeat7_dpdk/protos"
@@ -45,7 +46,7 @@ type amqpPlugin struct {
 	parseHeaders              bool
 	parseArguments            bool
 	hideConnectionInformation bool
-	transactions              *common.Cache
+	transactions              *clock.Cache
 	transactionTimeout        time.Duration
 	results                   protos.Reporter
 	watcher                   procs.ProcessesWatcher
@@ -90,7 +91,7 @@ func (amqp *amqpPlugin) init(results protos.Reporter, watcher procs.ProcessesWat
 	if amqp.hideConnectionInformation == false {
 		amqp.addConnectionMethods()
 	}
-	amqp.transactions = common.NewCache(
+	amqp.transactions = clock.NewCache(
 		amqp.transactionTimeout,
 		protos.DefaultTransactionHashSize)
 	amqp.transactions.StartJanitor(amqp.transactionTimeout)
@@ -305,7 +306,7 @@ func (amqp *amqpPlugin) handleAmqpRequest(msg *amqpMessage) {
 	if trans.timer != nil {
 		trans.timer.Stop()
 	}
-	trans.timer = time.AfterFunc(transactionTimeout, func() { amqp.expireTransaction(trans) })
+	trans.timer = clock.AfterFunc(transactionTimeout, func() { amqp.expireTransaction(trans) })
 }
 
 func (amqp *amqpPlugin) handleAmqpResponse(msg *amqpMessage) {
@@ -21,6 +21,8 @@ import (
 	"time"
 
 	"github.com/njcx/libbeat_v7/common"
+
+	"github.com/njcx/packetbeat7_dpdk/clock"
 )
 
 type amqpMethod func(*amqpMessage, []byte) (bool, bool)
@@ -238,5 +240,5 @@ type amqpTransaction struct {
 
 	amqp common.MapStr
 
-	timer *time.Timer
+	timer *clock.Timer
 }
@@ -37,6 +37,7 @@ import (
 	"github.com/njcx/libbeat_v7/common"
 	"github.com/njcx/libbeat_v7/logp"
 	"github.com/njcx/libbeat_v7/monitoring"
+	"github.com/njcx/packetbeat7_dpdk/clock"
 	"github.com/njcx/packetbeat7_dpdk/pb"
 	"github.com/njcx/packetbeat7_dpdk/procs"
 	"github.com/njcx/packetbeat7_dpdk/protos"
@@ -52,7 +53,7 @@ type dnsPlugin struct {
 
 	// Cache of active DNS transactions. The map key is the HashableDnsTuple
 	// associated with the request.
-	transactions       *common.Cache
+	transactions       *clock.Cache
 	transactionTimeout time.Duration
 
 	results protos.Reporter // Channel where results are pushed.
@@ -241,7 +242,7 @@ func New(
 
 func (dns *dnsPlugin) init(results protos.Reporter, watcher procs.ProcessesWatcher, config *dnsConfig) error {
 	dns.setFromConfig(config)
-	dns.transactions = common.NewCacheWithRemovalListener(
+	dns.transactions = clock.NewCacheWithRemovalListener(
 		dns.transactionTimeout,
 		protos.DefaultTransactionHashSize,
 		func(k common.Key, v common.Value) {
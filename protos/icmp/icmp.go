@@ -26,6 +26,7 @@ import (
 	"github.com/njcx/libbeat_v7/logp"
 	"github.com/njcx/libbeat_v7/monitoring"
 
+	"github.com/njcx/packetbeat7_dpdk/clock"
 	"github.com/njcx/packetbeat7_dpdk/flows"
 	"github.com/njcx/packetbeat7_dpdk/pb"
 	"github.com/njcx/packetbeat7_dpdk/procs"
@@ -42,7 +43,7 @@ type icmpPlugin struct {
 
 	// Active ICMP transactions.
 	// The map key is the hashableIcmpTuple associated with the request.
-	transactions       *common.Cache
+	transactions       *clock.Cache
 	transactionTimeout time.Duration
 
 	results protos.Reporter
@@ -106,7 +107,7 @@ func (icmp *icmpPlugin) init(results protos.Reporter, watcher procs.ProcessesWat
 		icmp.expireTransaction(k.(hashableIcmpTuple), v.(*icmpTransaction))
 	}
 
-	icmp.transactions = common.NewCacheWithRemovalListener(
+	icmp.transactions = clock.NewCacheWithRemovalListener(
 		icmp.transactionTimeout,
 		protos.DefaultTransactionHashSize,
 		removalListener)
@@ -25,6 +25,7 @@ import (
 	"github.com/njcx/libbeat_v7/common"
 	"github.com/njcx/libbeat_v7/logp"
 
+	"github.com/njcx/packetbeat7_dpdk/clock"
 	"github.com/njcx/packetbeat7_dpdk/protos"
 	"github.com/njcx/packetbeat7_dpdk/protos/applayer"
 	"github.com/njcx/packetbeat7_dpdk/protos/tcp"
@@ -50,7 +51,7 @@ type stream struct {
 }
 
 type connection struct {
-	timer     *time.Timer
+	timer     *clock.Timer
 	requests  messageList
 	responses messageList
 }
@@ -174,7 +175,7 @@ func (mc *memcache) memcacheParseTCP(
 		}
 	}
 
-	conn.timer = time.AfterFunc(mc.tcpTransTimeout, func() {
+	conn.timer = clock.AfterFunc(mc.tcpTransTimeout, func() {
 		debug("connection=%p timed out", conn)
 		mc.pushAllTCPTrans(conn)
 	})
@@ -27,6 +27,7 @@ import (
 	"github.com/njcx/libbeat_v7/common/streambuf"
 	"github.com/njcx/libbeat_v7/logp"
 
+	"github.com/njcx/packetbeat7_dpdk/clock"
 	"github.com/njcx/packetbeat7_dpdk/protos"
 	"github.com/njcx/packetbeat7_dpdk/protos/applayer"
 )
@@ -55,7 +56,7 @@ type udpConnection struct {
 
 type udpTransaction struct {
 	requestID uint16
-	timer     *time.Timer
+	timer     *clock.Timer
 	next      *udpTransaction
 
 	connection *udpConnection
@@ -143,7 +144,7 @@ func (mc *memcache) ParseUDP(pkt *protos.Packet) {
 		}
 	}
 	if !done {
-		trans.timer = time.AfterFunc(mc.udpConfig.transTimeout, func() {
+		trans.timer = clock.AfterFunc(mc.udpConfig.transTimeout, func() {
 			defer logp.Recover("ParseMemcache(UDP) panic during forward")
 			debug("transaction timeout -> forward")
 			mc.onUDPTrans(trans)
@@ -26,6 +26,7 @@ import (
 	"github.com/njcx/libbeat_v7/logp"
 	"github.com/njcx/libbeat_v7/monitoring"
 
+	"github.com/njcx/packetbeat7_dpdk/clock"
 	"github.com/njcx/packetbeat7_dpdk/pb"
 	"github.com/njcx/packetbeat7_dpdk/procs"
 	"github.com/njcx/packetbeat7_dpdk/protos"
@@ -42,8 +43,8 @@ type mongodbPlugin struct {
 	maxDocs      int
 	maxDocLength int
 
-	requests           *common.Cache
-	responses          *common.Cache
+	requests           *clock.Cache
+	responses          *clock.Cache
 	transactionTimeout time.Duration
 
 	results protos.Reporter
@@ -87,11 +88,11 @@ func (mongodb *mongodbPlugin) init(results protos.Reporter, watcher procs.Proces
 	debugf("Init a MongoDB protocol parser")
 	mongodb.setFromConfig(config)
 
-	mongodb.requests = common.NewCache(
+	mongodb.requests = clock.NewCache(
 		mongodb.transactionTimeout,
 		protos.DefaultTransactionHashSize)
 	mongodb.requests.StartJanitor(mongodb.transactionTimeout)
-	mongodb.responses = common.NewCache(
+	mongodb.responses = clock.NewCache(
 		mongodb.transactionTimeout,
 		protos.DefaultTransactionHashSize)
 	mongodb.responses.StartJanitor(mongodb.transactionTimeout)
@@ -29,6 +29,7 @@ import (
 	"github.com/njcx/libbeat_v7/logp"
 	"github.com/njcx/libbeat_v7/monitoring"
 
+	"github.com/njcx/packetbeat7_dpdk/clock"
 	"github.com/njcx/packetbeat7_dpdk/pb"
 	"github.com/njcx/packetbeat7_dpdk/procs"
 	"github.com/njcx/packetbeat7_dpdk/protos"
@@ -150,11 +151,11 @@ type mysqlPlugin struct {
 	sendRequest  bool
 	sendResponse bool
 
-	transactions       *common.Cache
+	transactions       *clock.Cache
 	transactionTimeout time.Duration
 
 	// prepare statements cache
-	prepareStatements       *common.Cache
+	prepareStatements       *clock.Cache
 	prepareStatementTimeout time.Duration
 
 	results protos.Reporter
@@ -192,13 +193,13 @@ func New(
 func (mysql *mysqlPlugin) init(results protos.Reporter, watcher procs.ProcessesWatcher, config *mysqlConfig) error {
 	mysql.setFromConfig(config)
 
-	mysql.transactions = common.NewCache(
+	mysql.transactions = clock.NewCache(
 		mysql.transactionTimeout,
 		protos.DefaultTransactionHashSize)
 	mysql.transactions.StartJanitor(mysql.transactionTimeout)
 
 	// prepare statements cache
-	mysql.prepareStatements = common.NewCache(
+	mysql.prepareStatements = clock.NewCache(
 		mysql.prepareStatementTimeout,
 		protos.DefaultTransactionHashSize)
 	mysql.prepareStatements.StartJanitor(mysql.prepareStatementTimeout)
@@ -29,6 +29,7 @@ import (
 	"github.com/njcx/libbeat_v7/common"
 	"github.com/njcx/libbeat_v7/logp"
 
+	"github.com/njcx/packetbeat7_dpdk/clock"
 	"github.com/njcx/packetbeat7_dpdk/procs"
 	"github.com/njcx/packetbeat7_dpdk/protos"
 	"github.com/njcx/packetbeat7_dpdk/protos/tcp"
@@ -58,7 +59,7 @@ type rpcConnectionData struct {
 type rpc struct {
 	// Configuration data.
 	ports              []int
-	callsSeen          *common.Cache
+	callsSeen          *clock.Cache
 	transactionTimeout time.Duration
 
 	results protos.Reporter // Channel where results are pushed.
@@ -93,7 +94,7 @@ func New(
 func (r *rpc) init(results protos.Reporter, config *rpcConfig) error {
 	r.setFromConfig(config)
 	r.results = results
-	r.callsSeen = common.NewCacheWithRemovalListener(
+	r.callsSeen = clock.NewCacheWithRemovalListener(
 		r.transactionTimeout,
 		protos.DefaultTransactionHashSize,
 		func(k common.Key, v common.Value) {
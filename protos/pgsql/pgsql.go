@@ -26,6 +26,7 @@ import (
 	"github.com/njcx/libbeat_v7/logp"
 	"github.com/njcx/libbeat_v7/monitoring"
 
+	"github.com/njcx/packetbeat7_dpdk/clock"
 	"github.com/njcx/packetbeat7_dpdk/pb"
 	"github.com/njcx/packetbeat7_dpdk/procs"
 	"github.com/njcx/packetbeat7_dpdk/protos"
@@ -45,7 +46,7 @@ type pgsqlPlugin struct {
 	sendRequest  bool
 	sendResponse bool
 
-	transactions       *common.Cache
+	transactions       *clock.Cache
 	transactionTimeout time.Duration
 
 	results protos.Reporter
@@ -166,7 +167,7 @@ func (pgsql *pgsqlPlugin) init(results protos.Reporter, watcher procs.ProcessesW
 	pgsql.detail = logp.NewLogger("pgsqldetailed", zap.AddCallerSkip(1))
 	pgsql.isDebug, pgsql.isDetail = logp.IsDebug("pgsql"), logp.IsDebug("pgsqldetailed")
 
-	pgsql.transactions = common.NewCache(
+	pgsql.transactions = clock.NewCache(
 		pgsql.transactionTimeout,
 		protos.DefaultTransactionHashSize)
 	pgsql.transactions.StartJanitor(pgsql.transactionTimeout)
@@ -45,6 +45,10 @@ type Packet struct {
 	Ts      time.Time
 	Tuple   common.IPPortTuple
 	Payload []byte
+
+	// InterfaceIndex is the index of the interface the packet has been
+	// captured on, if reported by the link layer, or 0.
+	InterfaceIndex uint32
 }
 
 var ErrInvalidPort = errors.New("port number out of range")
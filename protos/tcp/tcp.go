@@ -26,6 +26,7 @@ import (
 	"github.com/njcx/libbeat_v7/logp"
 	"github.com/njcx/libbeat_v7/monitoring"
 
+	"github.com/njcx/packetbeat7_dpdk/clock"
 	"github.com/njcx/packetbeat7_dpdk/flows"
 	"github.com/njcx/packetbeat7_dpdk/protos"
 
@@ -41,7 +42,7 @@ const (
 
 type TCP struct {
 	id           uint32
-	streams      *common.Cache
+	streams      *clock.Cache
 	portMap      map[uint16]protos.Protocol
 	protocols    protos.Protocols
 	expiredConns expirationQueue
@@ -330,7 +331,7 @@ func NewTCP(p protos.Protocols) (*TCP, error) {
 		protocols: p,
 		portMap:   portMap,
 	}
-	tcp.streams = common.NewCacheWithRemovalListener(
+	tcp.streams = clock.NewCacheWithRemovalListener(
 		protos.DefaultTransactionExpiration,
 		protos.DefaultTransactionHashSize,
 		tcp.removalListener)
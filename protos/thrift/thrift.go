@@ -31,6 +31,7 @@ import (
 	"github.com/njcx/libbeat_v7/logp"
 	"github.com/njcx/libbeat_v7/monitoring"
 
+	"github.com/njcx/packetbeat7_dpdk/clock"
 	"github.com/njcx/packetbeat7_dpdk/pb"
 	"github.com/njcx/packetbeat7_dpdk/procs"
 	"github.com/njcx/packetbeat7_dpdk/protos"
@@ -52,7 +53,7 @@ type thriftPlugin struct {
 	TransportType byte
 	ProtocolType  byte
 
-	transactions       *common.Cache
+	transactions       *clock.Cache
 	transactionTimeout time.Duration
 
 	publishQueue chan *thriftTransaction
@@ -213,7 +214,7 @@ func (thrift *thriftPlugin) init(
 		return err
 	}
 
-	thrift.transactions = common.NewCache(
+	thrift.transactions = clock.NewCache(
 		thrift.transactionTimeout,
 		protos.DefaultTransactionHashSize)
 	thrift.transactions.StartJanitor(thrift.transactionTimeout)
@@ -43,6 +43,10 @@ type transProcessor struct {
 	name             string
 }
 
+// EventHook is called with every event of a reporter, once the Packetbeat
+// fields have been added to the event, before it is published.
+type EventHook func(event *beat.Event)
+
 var debugf = logp.MakeDebug("publish")
 
 func NewTransactionPublisher(
@@ -84,6 +88,26 @@ func (p *TransactionPublisher) Stop() {
 func (p *TransactionPublisher) CreateReporter(
 	config *common.Config,
 ) (func(beat.Event), error) {
+	return p.CreateReporterWithFields(config, nil)
+}
+
+// CreateReporterWithFields creates a reporter adding fields to every event
+// reported.
+func (p *TransactionPublisher) CreateReporterWithFields(
+	config *common.Config,
+	fields common.MapStr,
+) (func(beat.Event), error) {
+	return p.CreateReporterWithHook(config, fields, nil)
+}
+
+// CreateReporterWithHook creates a reporter adding fields to every event
+// reported. If hook is not nil, it is called with every event before it is
+// published.
+func (p *TransactionPublisher) CreateReporterWithHook(
+	config *common.Config,
+	fields common.MapStr,
+	hook EventHook,
+) (func(beat.Event), error) {
 
 	// load and register the module it's fields, tags and processors settings
 	meta := struct {
@@ -104,6 +128,7 @@ func (p *TransactionPublisher) CreateReporter(
 	clientConfig := beat.ClientConfig{
 		Processing: beat.ProcessingConfig{
 			EventMetadata: meta.Event,
+			Fields:        fields,
 			Processor:     processors,
 			KeepNull:      meta.KeepNull,
 		},
@@ -123,7 +148,7 @@ func (p *TransactionPublisher) CreateReporter(
 	// start worker, so post-processing and processor-pipeline
 	// can work concurrently to sniffer acquiring new events
 	ch := make(chan beat.Event, 3)
-	go p.worker(ch, client)
+	go p.worker(ch, client, hook)
 	return func(event beat.Event) {
 		select {
 		case ch <- event:
@@ -133,7 +158,7 @@ func (p *TransactionPublisher) CreateReporter(
 	}, nil
 }
 
-func (p *TransactionPublisher) worker(ch chan beat.Event, client beat.Client) {
+func (p *TransactionPublisher) worker(ch chan beat.Event, client beat.Client, hook EventHook) {
 	for {
 		select {
 		case <-p.done:
@@ -141,6 +166,9 @@ func (p *TransactionPublisher) worker(ch chan beat.Event, client beat.Client) {
 		case event := <-ch:
 			pub, _ := p.processor.Run(&event)
 			if pub != nil {
+				if hook != nil {
+					hook(pub)
+				}
 				client.Publish(*pub)
 			}
 		}
@@ -22,6 +22,11 @@ package sniffer
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 	"unsafe"
@@ -31,6 +36,9 @@ import (
 	"github.com/njcx/gopacket_dpdk"
 	"github.com/njcx/gopacket_dpdk/afpacket"
 	"github.com/njcx/gopacket_dpdk/layers"
+
+	"github.com/njcx/packetbeat7_dpdk/config"
+	"github.com/njcx/packetbeat7_dpdk/decoder"
 )
 
 type afpacketHandle struct {
@@ -38,10 +46,24 @@ type afpacketHandle struct {
 	promiscPreviousState         bool
 	promiscPreviousStateDetected bool
 	device                       string
+	linkType                     layers.LinkType
+
+	// sockets of all in-process fanout workers, TPacket being the first
+	sockets []*afpacket.TPacket
+
+	// interface drop counter when the handle has been opened
+	ifDroppedStart uint64
+}
+
+// afpacketSocket reads from one of the sockets of a fanout group. It is
+// closed by the afpacketHandle it belongs to.
+type afpacketSocket struct {
+	*afpacket.TPacket
+	linkType layers.LinkType
 }
 
 func newAfpacketHandle(device string, snaplen int, block_size int, num_blocks int,
-	timeout time.Duration, autoPromiscMode bool) (*afpacketHandle, error) {
+	timeout time.Duration, autoPromiscMode bool, fanout config.FanoutConfig) (*afpacketHandle, error) {
 
 	var err error
 	var promiscEnabled bool
@@ -63,40 +85,158 @@ func newAfpacketHandle(device string, snaplen int, block_size int, num_blocks in
 		promiscPreviousState:         promiscEnabled,
 		device:                       device,
 		promiscPreviousStateDetected: autoPromiscMode && err == nil,
+		linkType:                     afpacketLinkType(device),
 	}
 
-	if device == "any" {
-		h.TPacket, err = afpacket.NewTPacket(
-			afpacket.OptFrameSize(snaplen),
-			afpacket.OptBlockSize(block_size),
-			afpacket.OptNumBlocks(num_blocks),
-			afpacket.OptPollTimeout(timeout))
-	} else {
-		h.TPacket, err = afpacket.NewTPacket(
-			afpacket.OptInterface(device),
-			afpacket.OptFrameSize(snaplen),
-			afpacket.OptBlockSize(block_size),
-			afpacket.OptNumBlocks(num_blocks),
-			afpacket.OptPollTimeout(timeout))
-	}
-
-	return h, err
+	opts := []interface{}{
+		afpacket.OptFrameSize(snaplen),
+		afpacket.OptBlockSize(block_size),
+		afpacket.OptNumBlocks(num_blocks),
+		afpacket.OptPollTimeout(timeout),
+	}
+	if device != "any" {
+		opts = append(opts, afpacket.OptInterface(device))
+	}
+
+	workers := 1
+	if fanout.Workers > 1 {
+		workers = fanout.Workers
+	}
+	for i := 0; i < workers; i++ {
+		tp, err := afpacket.NewTPacket(opts...)
+		if err != nil {
+			h.Close()
+			return nil, err
+		}
+		h.sockets = append(h.sockets, tp)
+
+		if fanout.Enabled() {
+			if err := joinFanoutGroup(tp, fanout); err != nil {
+				h.Close()
+				return nil, err
+			}
+		}
+	}
+	h.TPacket = h.sockets[0]
+
+	h.ifDroppedStart, _ = interfaceDrops(device)
+
+	return h, nil
+}
+
+// joinFanoutGroup adds the socket to the configured fanout group. Without a
+// group id, in-process workers share a group derived from the process id.
+func joinFanoutGroup(tp *afpacket.TPacket, fanout config.FanoutConfig) error {
+	group := uint16(os.Getpid())
+	if fanout.Group != nil {
+		group = *fanout.Group
+	}
+
+	var typ afpacket.FanoutType
+	switch fanout.Mode {
+	case "", "hash":
+		// fragments are reassembled before hashing, such that all
+		// fragments of a packet end up on the same socket
+		typ = afpacket.FanoutHashWithDefrag
+	case "cpu":
+		typ = afpacket.FanoutCPU
+	case "lb":
+		typ = afpacket.FanoutLoadBalance
+	case "rollover":
+		typ = afpacket.FanoutRollover
+	default:
+		return fmt.Errorf("unknown fanout mode '%s'", fanout.Mode)
+	}
+
+	if err := tp.SetFanout(typ, group); err != nil {
+		return fmt.Errorf("failed to join fanout group %d: %v", group, err)
+	}
+	return nil
 }
 
 func (h *afpacketHandle) ReadPacketData() (data []byte, ci gopacket_dpdk.CaptureInfo, err error) {
 	return h.TPacket.ReadPacketData()
 }
 
+// Queues returns one handle per socket of the in-process fanout workers.
+func (h *afpacketHandle) Queues() []snifferHandle {
+	if len(h.sockets) == 1 {
+		return []snifferHandle{h}
+	}
+
+	queues := make([]snifferHandle, len(h.sockets))
+	for i, tp := range h.sockets {
+		queues[i] = afpacketSocket{tp, h.linkType}
+	}
+	return queues
+}
+
 func (h *afpacketHandle) SetBPFFilter(expr string) (_ error) {
-	return h.TPacket.SetBPFFilter(expr)
+	if h.linkType != layers.LinkTypeEthernet && expr != "" {
+		// the sockets compile filters for ethernet frames
+		raw, err := compileBPFRaw(h.linkType, 65535, expr)
+		if err != nil {
+			return err
+		}
+		for _, tp := range h.sockets {
+			if err := tp.SetBPF(raw); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, tp := range h.sockets {
+		if err := tp.SetBPFFilter(expr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Stats reports the TPACKET_V3 socket statistics, summed over all sockets
+// of the handle. The kernel counts all packets passed to a socket as
+// received, including the ones dropped because the ring was full.
+func (h *afpacketHandle) Stats() (captureStats, error) {
+	stats := captureStats{
+		counters: map[string]uint64{},
+	}
+	for i, tp := range h.sockets {
+		_, sockStats, err := tp.SocketStats()
+		if err != nil {
+			return captureStats{}, err
+		}
+		st, err := tp.Stats()
+		if err != nil {
+			return captureStats{}, err
+		}
+
+		stats.received += uint64(sockStats.Packets())
+		stats.dropped += uint64(sockStats.Drops())
+		stats.counters["queue_freezes"] += uint64(sockStats.QueueFreezes())
+		stats.counters["packets"] += uint64(st.Packets)
+		stats.counters["polls"] += uint64(st.Polls)
+		if len(h.sockets) > 1 {
+			stats.counters[fmt.Sprintf("workers.%d.received", i)] = uint64(sockStats.Packets())
+			stats.counters[fmt.Sprintf("workers.%d.dropped", i)] = uint64(sockStats.Drops())
+		}
+	}
+
+	if drops, err := interfaceDrops(h.device); err == nil && drops >= h.ifDroppedStart {
+		stats.ifDropped = drops - h.ifDroppedStart
+	}
+
+	return stats, nil
 }
 
 func (h *afpacketHandle) LinkType() layers.LinkType {
-	return layers.LinkTypeEthernet
+	return h.linkType
 }
 
 func (h *afpacketHandle) Close() {
-	h.TPacket.Close()
+	for _, tp := range h.sockets {
+		tp.Close()
+	}
 	// previous state detected only if auto mode was on
 	if h.promiscPreviousStateDetected {
 		if err := setPromiscMode(h.device, h.promiscPreviousState); err != nil {
@@ -105,6 +245,53 @@ func (h *afpacketHandle) Close() {
 	}
 }
 
+func (s afpacketSocket) LinkType() layers.LinkType {
+	return s.linkType
+}
+
+// afpacketLinkType returns the link type of the frames read from device.
+// Devices without a link layer header, e.g. tun, WireGuard and PPP devices,
+// deliver raw IP packets. Their link type is the DLT value, which the BPF
+// filter compiler expects for live captures.
+func afpacketLinkType(device string) layers.LinkType {
+	if device == "any" {
+		return layers.LinkTypeEthernet
+	}
+	b, err := ioutil.ReadFile(filepath.Join("/sys/class/net", device, "type"))
+	if err != nil {
+		return layers.LinkTypeEthernet
+	}
+	switch strings.TrimSpace(string(b)) {
+	case "512", "519", "65534": // ARPHRD_PPP, ARPHRD_RAWIP, ARPHRD_NONE
+		return decoder.DLTRaw
+	}
+	return layers.LinkTypeEthernet
+}
+
+func (s afpacketSocket) Close() {}
+
+// interfaceDrops returns the number of packets dropped by the network
+// interface or its driver, as libpcap does for ps_ifdrop.
+func interfaceDrops(device string) (uint64, error) {
+	if device == "any" {
+		return 0, nil
+	}
+
+	var total uint64
+	for _, name := range []string{"rx_dropped", "rx_missed_errors"} {
+		b, err := ioutil.ReadFile(filepath.Join("/sys/class/net", device, "statistics", name))
+		if err != nil {
+			return 0, err
+		}
+		v, err := strconv.ParseUint(strings.TrimSpace(string(b)), 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		total += v
+	}
+	return total, nil
+}
+
 func isPromiscEnabled(device string) (bool, error) {
 	if device == "any" {
 		return false, nil
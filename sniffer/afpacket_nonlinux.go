@@ -26,13 +26,15 @@ import (
 
 	"github.com/njcx/gopacket_dpdk"
 	"github.com/njcx/gopacket_dpdk/layers"
+
+	"github.com/njcx/packetbeat7_dpdk/config"
 )
 
 type afpacketHandle struct {
 }
 
 func newAfpacketHandle(device string, snaplen int, blockSize int, numBlocks int,
-	timeout time.Duration, enableAutoPromiscMode bool) (*afpacketHandle, error) {
+	timeout time.Duration, enableAutoPromiscMode bool, fanout config.FanoutConfig) (*afpacketHandle, error) {
 
 	return nil, fmt.Errorf("Afpacket MMAP sniffing is only available on Linux")
 }
@@ -45,6 +47,10 @@ func (h *afpacketHandle) SetBPFFilter(expr string) (_ error) {
 	return fmt.Errorf("Afpacket MMAP sniffing is only available on Linux")
 }
 
+func (h *afpacketHandle) Stats() (captureStats, error) {
+	return captureStats{}, fmt.Errorf("Afpacket MMAP sniffing is only available on Linux")
+}
+
 func (h *afpacketHandle) LinkType() layers.LinkType {
 	return layers.LinkTypeEthernet
 }
@@ -0,0 +1,76 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sniffer
+
+import (
+	"fmt"
+
+	"github.com/njcx/packetbeat7_dpdk/config"
+)
+
+const (
+	defaultAfXdpFrameSize = 4096
+	defaultAfXdpNumFrames = 4096
+	defaultAfXdpRingSize  = 2048
+)
+
+func setAfXdpDefaults(cfg *config.AfXdpConfig) {
+	if cfg.Mode == "" {
+		cfg.Mode = "auto"
+	}
+	if cfg.FrameSize == 0 {
+		cfg.FrameSize = defaultAfXdpFrameSize
+	}
+	if cfg.NumFrames == 0 {
+		cfg.NumFrames = defaultAfXdpNumFrames
+	}
+	if cfg.RingSize == 0 {
+		cfg.RingSize = defaultAfXdpRingSize
+	}
+}
+
+func validateAfXdpConfig(cfg *config.InterfacesConfig) error {
+	if cfg.Device == "" || cfg.Device == "any" {
+		return fmt.Errorf("af_xdp requires a network device, got '%s'", cfg.Device)
+	}
+
+	xdp := cfg.AfXdp
+	switch xdp.Mode {
+	case "auto", "native", "skb":
+	default:
+		return fmt.Errorf("unknown af_xdp mode '%s', expected one of auto, native or skb", xdp.Mode)
+	}
+	if xdp.Queue < 0 {
+		return fmt.Errorf("af_xdp queue must not be negative, got %d", xdp.Queue)
+	}
+	if xdp.FrameSize != 2048 && xdp.FrameSize != 4096 {
+		return fmt.Errorf("af_xdp frame_size must be 2048 or 4096, got %d", xdp.FrameSize)
+	}
+	if !isPowerOfTwo(xdp.RingSize) || xdp.RingSize < 64 {
+		return fmt.Errorf("af_xdp ring_size must be a power of 2 of at least 64, got %d", xdp.RingSize)
+	}
+	if !isPowerOfTwo(xdp.NumFrames) || xdp.NumFrames < xdp.RingSize {
+		return fmt.Errorf("af_xdp num_frames must be a power of 2 of at least ring_size (%d), got %d",
+			xdp.RingSize, xdp.NumFrames)
+	}
+	return nil
+}
+
+func isPowerOfTwo(n int) bool {
+	return n > 0 && n&(n-1) == 0
+}
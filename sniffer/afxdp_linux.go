@@ -0,0 +1,333 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build linux
+// +build linux
+
+package sniffer
+
+import (
+	"fmt"
+	"net"
+	"sync/atomic"
+	"time"
+	"unsafe"
+
+	"golang.org/x/net/bpf"
+	"golang.org/x/sys/unix"
+
+	"github.com/njcx/libbeat_v7/logp"
+
+	"github.com/njcx/gopacket_dpdk"
+	"github.com/njcx/gopacket_dpdk/layers"
+
+	"github.com/njcx/packetbeat7_dpdk/config"
+)
+
+// afxdpHandle reads packets from one queue of a device through an AF_XDP
+// socket. Packets are received into the UMEM, a memory area shared with the
+// kernel and split into frames. Free frames are handed to the kernel on the
+// fill ring, frames holding a packet are returned on the RX ring.
+type afxdpHandle struct {
+	fd      int
+	device  string
+	queue   int
+	snaplen int
+	timeout int // poll timeout in milliseconds
+
+	umem      []byte
+	frameSize uint64
+	rx        xdpRing
+	fill      xdpRing
+	prog      *xdpProgram
+
+	filter atomic.Pointer[bpf.VM] // swapped while reading
+	buf    []byte
+
+	received uint64 // accessed atomically
+}
+
+// xdpRing is a single producer, single consumer ring shared with the kernel.
+type xdpRing struct {
+	mem      []byte
+	producer *uint32
+	consumer *uint32
+	descs    unsafe.Pointer
+	mask     uint32
+}
+
+func newAfxdpHandle(device string, snaplen int, timeout time.Duration, cfg config.AfXdpConfig) (*afxdpHandle, error) {
+	iface, err := net.InterfaceByName(device)
+	if err != nil {
+		return nil, err
+	}
+
+	fd, err := unix.Socket(unix.AF_XDP, unix.SOCK_RAW|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open AF_XDP socket: %v", err)
+	}
+
+	h := &afxdpHandle{
+		fd:        fd,
+		device:    device,
+		queue:     cfg.Queue,
+		snaplen:   snaplen,
+		timeout:   int(timeout / time.Millisecond),
+		frameSize: uint64(cfg.FrameSize),
+		buf:       make([]byte, snaplen),
+	}
+	if err := h.setup(iface.Index, cfg); err != nil {
+		h.Close()
+		return nil, err
+	}
+	return h, nil
+}
+
+func (h *afxdpHandle) setup(ifindex int, cfg config.AfXdpConfig) error {
+	var err error
+
+	h.umem, err = unix.Mmap(-1, 0, cfg.NumFrames*cfg.FrameSize,
+		unix.PROT_READ|unix.PROT_WRITE, unix.MAP_PRIVATE|unix.MAP_ANONYMOUS|unix.MAP_POPULATE)
+	if err != nil {
+		return fmt.Errorf("failed to allocate UMEM: %v", err)
+	}
+
+	reg := unix.XDPUmemReg{
+		Addr: uint64(uintptr(unsafe.Pointer(&h.umem[0]))),
+		Len:  uint64(len(h.umem)),
+		Size: uint32(cfg.FrameSize),
+	}
+	if err := setsockopt(h.fd, unix.XDP_UMEM_REG, unsafe.Pointer(&reg), unsafe.Sizeof(reg)); err != nil {
+		return fmt.Errorf("failed to register UMEM: %v", err)
+	}
+
+	// The completion ring is only used for transmitting, but the kernel
+	// requires it to be configured.
+	for _, ring := range []struct {
+		opt  int
+		size int
+	}{
+		{unix.XDP_UMEM_FILL_RING, cfg.NumFrames},
+		{unix.XDP_UMEM_COMPLETION_RING, cfg.RingSize},
+		{unix.XDP_RX_RING, cfg.RingSize},
+	} {
+		size := uint32(ring.size)
+		if err := setsockopt(h.fd, ring.opt, unsafe.Pointer(&size), unsafe.Sizeof(size)); err != nil {
+			return fmt.Errorf("failed to set size of ring %d: %v", ring.opt, err)
+		}
+	}
+
+	off, err := mmapOffsets(h.fd)
+	if err != nil {
+		return err
+	}
+	if err := h.rx.mmap(h.fd, unix.XDP_PGOFF_RX_RING, off.Rx, cfg.RingSize, unsafe.Sizeof(unix.XDPDesc{})); err != nil {
+		return fmt.Errorf("failed to map RX ring: %v", err)
+	}
+	if err := h.fill.mmap(h.fd, unix.XDP_UMEM_PGOFF_FILL_RING, off.Fr, cfg.NumFrames, 8); err != nil {
+		return fmt.Errorf("failed to map fill ring: %v", err)
+	}
+
+	// hand all frames to the kernel
+	for i := 0; i < cfg.NumFrames; i++ {
+		*h.fill.addr(uint32(i)) = uint64(i) * h.frameSize
+	}
+	atomic.StoreUint32(h.fill.producer, uint32(cfg.NumFrames))
+
+	var bindFlags uint16
+	if cfg.ZeroCopy != nil {
+		if *cfg.ZeroCopy {
+			bindFlags = unix.XDP_ZEROCOPY
+		} else {
+			bindFlags = unix.XDP_COPY
+		}
+	}
+	sa := &unix.SockaddrXDP{
+		Flags:   bindFlags,
+		Ifindex: uint32(ifindex),
+		QueueID: uint32(cfg.Queue),
+	}
+	if err := unix.Bind(h.fd, sa); err != nil {
+		return fmt.Errorf("failed to bind AF_XDP socket to queue %d of %s: %v", cfg.Queue, h.device, err)
+	}
+
+	h.prog, err = loadXDPProgram(ifindex, cfg.Queue, cfg.Mode)
+	if err != nil {
+		return err
+	}
+	if err := h.prog.Register(cfg.Queue, h.fd); err != nil {
+		return err
+	}
+	if err := h.prog.Attach(); err != nil {
+		return fmt.Errorf("%v on %s", err, h.device)
+	}
+	return nil
+}
+
+// mmapOffsets returns the offsets of the producer, consumer and descriptors
+// within the mapped rings.
+func mmapOffsets(fd int) (unix.XDPMmapOffsets, error) {
+	var off unix.XDPMmapOffsets
+	size := uint32(unsafe.Sizeof(off))
+	_, _, errno := unix.Syscall6(unix.SYS_GETSOCKOPT, uintptr(fd), unix.SOL_XDP, unix.XDP_MMAP_OFFSETS,
+		uintptr(unsafe.Pointer(&off)), uintptr(unsafe.Pointer(&size)), 0)
+	if errno != 0 {
+		return off, fmt.Errorf("failed to get ring offsets: %v", errno)
+	}
+	if size != uint32(unsafe.Sizeof(off)) {
+		// Kernels before 5.4 do not report the flags offset.
+		return off, fmt.Errorf("af_xdp requires Linux 5.4 or later")
+	}
+	return off, nil
+}
+
+func setsockopt(fd int, opt int, value unsafe.Pointer, size uintptr) error {
+	_, _, errno := unix.Syscall6(unix.SYS_SETSOCKOPT, uintptr(fd), unix.SOL_XDP, uintptr(opt),
+		uintptr(value), size, 0)
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+func (r *xdpRing) mmap(fd int, pgoff int64, off unix.XDPRingOffset, n int, descSize uintptr) error {
+	mem, err := unix.Mmap(fd, pgoff, int(off.Desc)+n*int(descSize),
+		unix.PROT_READ|unix.PROT_WRITE, unix.MAP_SHARED|unix.MAP_POPULATE)
+	if err != nil {
+		return err
+	}
+
+	r.mem = mem
+	r.producer = (*uint32)(unsafe.Pointer(&mem[off.Producer]))
+	r.consumer = (*uint32)(unsafe.Pointer(&mem[off.Consumer]))
+	r.descs = unsafe.Pointer(&mem[off.Desc])
+	r.mask = uint32(n - 1)
+	return nil
+}
+
+func (r *xdpRing) desc(i uint32) *unix.XDPDesc {
+	return (*unix.XDPDesc)(unsafe.Pointer(uintptr(r.descs) + uintptr(i&r.mask)*unsafe.Sizeof(unix.XDPDesc{})))
+}
+
+func (r *xdpRing) addr(i uint32) *uint64 {
+	return (*uint64)(unsafe.Pointer(uintptr(r.descs) + uintptr(i&r.mask)*8))
+}
+
+func (r *xdpRing) unmap() {
+	if r.mem != nil {
+		unix.Munmap(r.mem)
+		r.mem = nil
+	}
+}
+
+func (h *afxdpHandle) ReadPacketData() (data []byte, ci gopacket_dpdk.CaptureInfo, err error) {
+	for {
+		cons := atomic.LoadUint32(h.rx.consumer)
+		if cons == atomic.LoadUint32(h.rx.producer) {
+			fds := []unix.PollFd{{Fd: int32(h.fd), Events: unix.POLLIN}}
+			if _, err := unix.Poll(fds, h.timeout); err != nil && err != unix.EINTR {
+				return nil, ci, err
+			}
+			if cons == atomic.LoadUint32(h.rx.producer) {
+				// timeout, empty packets are skipped by the sniffer
+				return nil, ci, nil
+			}
+		}
+
+		desc := h.rx.desc(cons)
+		length := int(desc.Len)
+		n := copy(h.buf, h.umem[desc.Addr:desc.Addr+uint64(desc.Len)])
+		frame := desc.Addr &^ (h.frameSize - 1)
+		atomic.StoreUint32(h.rx.consumer, cons+1)
+
+		// return the frame to the kernel
+		prod := atomic.LoadUint32(h.fill.producer)
+		*h.fill.addr(prod) = frame
+		atomic.StoreUint32(h.fill.producer, prod+1)
+
+		atomic.AddUint64(&h.received, 1)
+
+		if filter := h.filter.Load(); filter != nil {
+			if res, err := filter.Run(h.buf[:n]); err != nil || res == 0 {
+				continue
+			}
+		}
+
+		data = make([]byte, n)
+		copy(data, h.buf[:n])
+		return data, gopacket_dpdk.CaptureInfo{
+			Timestamp:     time.Now(),
+			CaptureLength: n,
+			Length:        length,
+		}, nil
+	}
+}
+
+// SetBPFFilter compiles the filter expression and applies it in user space.
+// The XDP program redirects all packets of the queue to the socket.
+func (h *afxdpHandle) SetBPFFilter(expr string) error {
+	vm, err := compileBPF(layers.LinkTypeEthernet, h.snaplen, expr)
+	if err != nil {
+		return err
+	}
+	h.filter.Store(vm)
+	return nil
+}
+
+// Stats reports the statistics of the XDP socket. The kernel does not count
+// the packets delivered to the socket, they are counted by the handle.
+func (h *afxdpHandle) Stats() (captureStats, error) {
+	var st unix.XDPStatistics
+	size := uint32(unsafe.Sizeof(st))
+	_, _, errno := unix.Syscall6(unix.SYS_GETSOCKOPT, uintptr(h.fd), unix.SOL_XDP, unix.XDP_STATISTICS,
+		uintptr(unsafe.Pointer(&st)), uintptr(unsafe.Pointer(&size)), 0)
+	if errno != 0 {
+		return captureStats{}, fmt.Errorf("failed to read XDP socket statistics: %v", errno)
+	}
+
+	return captureStats{
+		received: atomic.LoadUint64(&h.received),
+		dropped:  st.Rx_dropped + st.Rx_ring_full,
+		errors:   st.Rx_invalid_descs,
+		counters: map[string]uint64{
+			"rx_dropped":               st.Rx_dropped,
+			"rx_ring_full":             st.Rx_ring_full,
+			"rx_fill_ring_empty_descs": st.Rx_fill_ring_empty_descs,
+			"rx_invalid_descs":         st.Rx_invalid_descs,
+		},
+	}, nil
+}
+
+func (h *afxdpHandle) LinkType() layers.LinkType {
+	return layers.LinkTypeEthernet
+}
+
+func (h *afxdpHandle) Close() {
+	if h.prog != nil {
+		if err := h.prog.Detach(); err != nil {
+			logp.Warn("Failed to detach XDP program from device '%s': %v", h.device, err)
+		}
+		h.prog.Close()
+	}
+	h.rx.unmap()
+	h.fill.unmap()
+	unix.Close(h.fd)
+	if h.umem != nil {
+		unix.Munmap(h.umem)
+		h.umem = nil
+	}
+}
@@ -0,0 +1,129 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build integration && linux
+// +build integration,linux
+
+package sniffer
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"os"
+	"os/exec"
+	"testing"
+	"time"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/njcx/packetbeat7_dpdk/config"
+)
+
+// TestAfxdpVeth receives packets sent through a veth pair. It requires root
+// privileges and Linux 5.4 or later.
+func TestAfxdpVeth(t *testing.T) {
+	if os.Geteuid() != 0 {
+		t.Skip("af_xdp test requires root privileges")
+	}
+
+	const (
+		device    = "pbxdp0"
+		peer      = "pbxdp1"
+		etherType = 0x88b5 // local experimental
+		count     = 10
+	)
+
+	ip := func(args ...string) {
+		if out, err := exec.Command("ip", args...).CombinedOutput(); err != nil {
+			t.Fatalf("ip %v failed: %v: %s", args, err, out)
+		}
+	}
+	ip("link", "add", device, "type", "veth", "peer", "name", peer)
+	defer exec.Command("ip", "link", "del", device).Run()
+	ip("link", "set", device, "up")
+	ip("link", "set", peer, "up")
+
+	dev, err := net.InterfaceByName(device)
+	if err != nil {
+		t.Fatal(err)
+	}
+	src, err := net.InterfaceByName(peer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := config.InterfacesConfig{Type: "af_xdp", Device: device, AfXdp: config.AfXdpConfig{Mode: "skb"}}
+	setAfXdpDefaults(&cfg.AfXdp)
+	if err := validateAfXdpConfig(&cfg); err != nil {
+		t.Fatal(err)
+	}
+	h, err := newAfxdpHandle(device, 65535, 100*time.Millisecond, cfg.AfXdp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer h.Close()
+
+	fd, err := unix.Socket(unix.AF_PACKET, unix.SOCK_RAW, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unix.Close(fd)
+
+	payload := []byte("packetbeat af_xdp test")
+	frame := make([]byte, 0, 64)
+	frame = append(frame, dev.HardwareAddr...)
+	frame = append(frame, src.HardwareAddr...)
+	frame = append(frame, 0, 0)
+	binary.BigEndian.PutUint16(frame[12:], etherType)
+	frame = append(frame, payload...)
+
+	addr := &unix.SockaddrLinklayer{Ifindex: src.Index, Halen: 6}
+	copy(addr.Addr[:], dev.HardwareAddr)
+	for i := 0; i < count; i++ {
+		if err := unix.Sendto(fd, frame, 0, addr); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	received := 0
+	deadline := time.Now().Add(5 * time.Second)
+	for received < count && time.Now().Before(deadline) {
+		data, ci, err := h.ReadPacketData()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(data) < 14 || binary.BigEndian.Uint16(data[12:]) != etherType {
+			continue
+		}
+		if !bytes.Equal(data[14:], payload) || ci.Length != len(frame) {
+			t.Fatalf("unexpected packet %x", data)
+		}
+		received++
+	}
+	if received != count {
+		t.Fatalf("received %d of %d packets", received, count)
+	}
+
+	stats, err := h.Stats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.received < count {
+		t.Errorf("stats report %d received packets, expected at least %d", stats.received, count)
+	}
+}
@@ -0,0 +1,53 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !linux
+// +build !linux
+
+package sniffer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/njcx/gopacket_dpdk"
+	"github.com/njcx/gopacket_dpdk/layers"
+
+	"github.com/njcx/packetbeat7_dpdk/config"
+)
+
+type afxdpHandle struct {
+}
+
+func newAfxdpHandle(device string, snaplen int, timeout time.Duration, cfg config.AfXdpConfig) (*afxdpHandle, error) {
+	return nil, fmt.Errorf("AF_XDP sniffing is only available on Linux")
+}
+
+func (h *afxdpHandle) ReadPacketData() (data []byte, ci gopacket_dpdk.CaptureInfo, err error) {
+	return data, ci, fmt.Errorf("AF_XDP sniffing is only available on Linux")
+}
+
+func (h *afxdpHandle) SetBPFFilter(expr string) error {
+	return fmt.Errorf("AF_XDP sniffing is only available on Linux")
+}
+
+func (h *afxdpHandle) LinkType() layers.LinkType {
+	return layers.LinkTypeEthernet
+}
+
+func (h *afxdpHandle) Close() {
+}
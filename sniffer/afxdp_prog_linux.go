@@ -0,0 +1,288 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build linux
+// +build linux
+
+package sniffer
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// xdpProgram redirects packets received on a queue into the XDP socket
+// registered for that queue in an XSKMAP. Packets of queues without socket
+// are passed on to the kernel.
+type xdpProgram struct {
+	ifindex  int
+	flags    uint32
+	attached bool
+
+	mapFD  int
+	progFD int
+}
+
+// bpfInsn is the encoding of a single eBPF instruction.
+type bpfInsn struct {
+	code uint8
+	regs uint8 // dst in the lower, src in the upper 4 bits
+	off  int16
+	imm  int32
+}
+
+type bpfMapCreateAttr struct {
+	mapType    uint32
+	keySize    uint32
+	valueSize  uint32
+	maxEntries uint32
+	mapFlags   uint32
+}
+
+type bpfMapElemAttr struct {
+	mapFD uint32
+	_     uint32
+	key   uint64
+	value uint64
+	flags uint64
+}
+
+type bpfProgLoadAttr struct {
+	progType    uint32
+	insnCnt     uint32
+	insns       uint64
+	license     uint64
+	logLevel    uint32
+	logSize     uint32
+	logBuf      uint64
+	kernVersion uint32
+	progFlags   uint32
+	progName    [16]byte
+}
+
+const (
+	bpfPseudoMapFD        = 1
+	bpfFuncRedirectMap    = 51
+	xdpPass               = 2
+	xdpRxQueueIndexOffset = 16 // offsetof(struct xdp_md, rx_queue_index)
+)
+
+// xdpRedirectInsns returns the program:
+//
+//	return bpf_redirect_map(&xsks_map, ctx->rx_queue_index, XDP_PASS);
+//
+// Using the flags of bpf_redirect_map as fallback action requires Linux 5.3.
+func xdpRedirectInsns(mapFD int) []bpfInsn {
+	return []bpfInsn{
+		// r2 = *(u32 *)(r1 + rx_queue_index)
+		{code: unix.BPF_LDX | unix.BPF_MEM | unix.BPF_W, regs: 2 | 1<<4, off: xdpRxQueueIndexOffset},
+		// r1 = xsks_map
+		{code: unix.BPF_LD | unix.BPF_IMM | unix.BPF_DW, regs: 1 | bpfPseudoMapFD<<4, imm: int32(mapFD)},
+		{},
+		// r3 = XDP_PASS
+		{code: unix.BPF_ALU64 | unix.BPF_MOV | unix.BPF_K, regs: 3, imm: xdpPass},
+		{code: unix.BPF_JMP | unix.BPF_CALL, imm: bpfFuncRedirectMap},
+		{code: unix.BPF_JMP | unix.BPF_EXIT},
+	}
+}
+
+// loadXDPProgram creates the XSKMAP and loads the redirect program. The
+// program is not attached to the device yet.
+func loadXDPProgram(ifindex int, queue int, mode string) (*xdpProgram, error) {
+	p := &xdpProgram{ifindex: ifindex, mapFD: -1, progFD: -1}
+
+	// never replace a program attached by someone else
+	p.flags = unix.XDP_FLAGS_UPDATE_IF_NOEXIST
+	switch mode {
+	case "native":
+		p.flags |= unix.XDP_FLAGS_DRV_MODE
+	case "skb":
+		p.flags |= unix.XDP_FLAGS_SKB_MODE
+	}
+
+	mapAttr := bpfMapCreateAttr{
+		mapType:    unix.BPF_MAP_TYPE_XSKMAP,
+		keySize:    4,
+		valueSize:  4,
+		maxEntries: uint32(queue + 1),
+	}
+	fd, err := bpfCall(unix.BPF_MAP_CREATE, unsafe.Pointer(&mapAttr), unsafe.Sizeof(mapAttr))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create XSKMAP: %v", err)
+	}
+	p.mapFD = fd
+
+	insns := xdpRedirectInsns(p.mapFD)
+	license := []byte("Apache-2.0\x00")
+	logBuf := make([]byte, 4096)
+	progAttr := bpfProgLoadAttr{
+		progType: unix.BPF_PROG_TYPE_XDP,
+		insnCnt:  uint32(len(insns)),
+		insns:    uint64(uintptr(unsafe.Pointer(&insns[0]))),
+		license:  uint64(uintptr(unsafe.Pointer(&license[0]))),
+		logLevel: 1,
+		logSize:  uint32(len(logBuf)),
+		logBuf:   uint64(uintptr(unsafe.Pointer(&logBuf[0]))),
+	}
+	copy(progAttr.progName[:], "packetbeat_xsk")
+	fd, err = bpfCall(unix.BPF_PROG_LOAD, unsafe.Pointer(&progAttr), unsafe.Sizeof(progAttr))
+	if err != nil {
+		p.Close()
+		return nil, fmt.Errorf("failed to load XDP program: %v: %s", err, cString(logBuf))
+	}
+	p.progFD = fd
+
+	return p, nil
+}
+
+// Register adds the XDP socket to the XSKMAP, such that packets of queue are
+// redirected to it.
+func (p *xdpProgram) Register(queue int, xskFD int) error {
+	key, value := uint32(queue), uint32(xskFD)
+	attr := bpfMapElemAttr{
+		mapFD: uint32(p.mapFD),
+		key:   uint64(uintptr(unsafe.Pointer(&key))),
+		value: uint64(uintptr(unsafe.Pointer(&value))),
+	}
+	if _, err := bpfCall(unix.BPF_MAP_UPDATE_ELEM, unsafe.Pointer(&attr), unsafe.Sizeof(attr)); err != nil {
+		return fmt.Errorf("failed to register XDP socket for queue %d: %v", queue, err)
+	}
+	return nil
+}
+
+// Attach attaches the program to the device.
+func (p *xdpProgram) Attach() error {
+	if err := setLinkXDP(p.ifindex, p.progFD, p.flags); err != nil {
+		if err == unix.EBUSY || err == unix.EEXIST {
+			return fmt.Errorf("an XDP program is already attached to the device")
+		}
+		return fmt.Errorf("failed to attach XDP program: %v", err)
+	}
+	p.attached = true
+	return nil
+}
+
+// Detach removes the program from the device, if it has been attached.
+func (p *xdpProgram) Detach() error {
+	if !p.attached {
+		return nil
+	}
+	flags := p.flags &^ unix.XDP_FLAGS_UPDATE_IF_NOEXIST
+	if err := setLinkXDP(p.ifindex, -1, flags); err != nil {
+		return err
+	}
+	p.attached = false
+	return nil
+}
+
+// Close releases the program and map. The program stays attached to the
+// device until detached.
+func (p *xdpProgram) Close() {
+	if p.progFD >= 0 {
+		unix.Close(p.progFD)
+		p.progFD = -1
+	}
+	if p.mapFD >= 0 {
+		unix.Close(p.mapFD)
+		p.mapFD = -1
+	}
+}
+
+func bpfCall(cmd int, attr unsafe.Pointer, size uintptr) (int, error) {
+	r, _, errno := unix.Syscall(unix.SYS_BPF, uintptr(cmd), uintptr(attr), size)
+	if errno != 0 {
+		return -1, errno
+	}
+	return int(r), nil
+}
+
+// setLinkXDP sets the XDP program of a device through rtnetlink. A fd of -1
+// removes the program.
+func setLinkXDP(ifindex int, fd int, flags uint32) error {
+	sock, err := unix.Socket(unix.AF_NETLINK, unix.SOCK_RAW|unix.SOCK_CLOEXEC, unix.NETLINK_ROUTE)
+	if err != nil {
+		return err
+	}
+	defer unix.Close(sock)
+
+	if err := unix.Bind(sock, &unix.SockaddrNetlink{Family: unix.AF_NETLINK}); err != nil {
+		return err
+	}
+
+	// struct nlmsghdr, struct ifinfomsg, IFLA_XDP{IFLA_XDP_FD, IFLA_XDP_FLAGS}
+	const (
+		attrLen = unix.SizeofNlAttr + 4
+		xdpLen  = unix.SizeofNlAttr + 2*attrLen
+		msgLen  = unix.SizeofNlMsghdr + unix.SizeofIfInfomsg + xdpLen
+	)
+	buf := make([]byte, msgLen)
+	*(*unix.NlMsghdr)(unsafe.Pointer(&buf[0])) = unix.NlMsghdr{
+		Len:   msgLen,
+		Type:  unix.RTM_SETLINK,
+		Flags: unix.NLM_F_REQUEST | unix.NLM_F_ACK,
+		Seq:   1,
+		Pid:   uint32(os.Getpid()),
+	}
+	off := unix.SizeofNlMsghdr
+	*(*unix.IfInfomsg)(unsafe.Pointer(&buf[off])) = unix.IfInfomsg{
+		Family: unix.AF_UNSPEC,
+		Index:  int32(ifindex),
+	}
+	off += unix.SizeofIfInfomsg
+	*(*unix.NlAttr)(unsafe.Pointer(&buf[off])) = unix.NlAttr{Len: xdpLen, Type: unix.IFLA_XDP | unix.NLA_F_NESTED}
+	off += unix.SizeofNlAttr
+	*(*unix.NlAttr)(unsafe.Pointer(&buf[off])) = unix.NlAttr{Len: attrLen, Type: unix.IFLA_XDP_FD}
+	*(*int32)(unsafe.Pointer(&buf[off+unix.SizeofNlAttr])) = int32(fd)
+	off += attrLen
+	*(*unix.NlAttr)(unsafe.Pointer(&buf[off])) = unix.NlAttr{Len: attrLen, Type: unix.IFLA_XDP_FLAGS}
+	*(*uint32)(unsafe.Pointer(&buf[off+unix.SizeofNlAttr])) = flags
+
+	if err := unix.Sendto(sock, buf, 0, &unix.SockaddrNetlink{Family: unix.AF_NETLINK}); err != nil {
+		return err
+	}
+
+	resp := make([]byte, 4096)
+	n, _, err := unix.Recvfrom(sock, resp, 0)
+	if err != nil {
+		return err
+	}
+	msgs, err := syscall.ParseNetlinkMessage(resp[:n])
+	if err != nil {
+		return err
+	}
+	for _, m := range msgs {
+		if m.Header.Type == unix.NLMSG_ERROR && len(m.Data) >= 4 {
+			if errno := -*(*int32)(unsafe.Pointer(&m.Data[0])); errno != 0 {
+				return unix.Errno(errno)
+			}
+		}
+	}
+	return nil
+}
+
+func cString(b []byte) string {
+	for i, c := range b {
+		if c == 0 {
+			return string(b[:i])
+		}
+	}
+	return string(b)
+}
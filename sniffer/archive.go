@@ -0,0 +1,511 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sniffer
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/njcx/gopacket_dpdk"
+	"github.com/njcx/gopacket_dpdk/layers"
+
+	"github.com/njcx/libbeat_v7/common/flowhash"
+	"github.com/njcx/libbeat_v7/logp"
+	"github.com/njcx/libbeat_v7/paths"
+
+	"github.com/njcx/packetbeat7_dpdk/config"
+	"github.com/njcx/packetbeat7_dpdk/decoder"
+)
+
+const (
+	defaultArchivePath        = "archive"
+	defaultArchiveFilename    = "packetbeat"
+	defaultArchiveChunkSizeMb = 100
+	defaultArchiveMaxSizeMb   = 10 * 1024
+
+	archiveChunkExt = ".pcap"
+	archiveIndexExt = ".idx"
+)
+
+func setArchiveDefaults(cfg *config.ArchiveConfig) {
+	if cfg.Path == "" {
+		cfg.Path = defaultArchivePath
+	}
+	cfg.Path = paths.Resolve(paths.Data, cfg.Path)
+	if cfg.Filename == "" {
+		cfg.Filename = defaultArchiveFilename
+	}
+	if cfg.ChunkSizeMb == 0 {
+		cfg.ChunkSizeMb = defaultArchiveChunkSizeMb
+	}
+	if cfg.MaxSizeMb == 0 {
+		cfg.MaxSizeMb = defaultArchiveMaxSizeMb
+	}
+}
+
+func validateArchiveConfig(cfg *config.ArchiveConfig) error {
+	if cfg.ChunkSizeMb < 0 {
+		return fmt.Errorf("archive chunk_size_mb must not be negative")
+	}
+	if cfg.ChunkInterval < 0 {
+		return fmt.Errorf("archive chunk_interval must not be negative")
+	}
+	if cfg.MaxSizeMb < cfg.ChunkSizeMb {
+		return fmt.Errorf("archive max_size_mb must be at least chunk_size_mb")
+	}
+	return nil
+}
+
+// ArchiveDir returns the directory the packet archive configured by cfg is
+// written to.
+func ArchiveDir(cfg config.ArchiveConfig) string {
+	setArchiveDefaults(&cfg)
+	return cfg.Path
+}
+
+// archiveWriter writes packets to pcap chunks. Every chunk is accompanied by
+// an index file, mapping the community IDs of the connections in the chunk
+// to the time range and the offsets of their packets. The index is appended
+// to whenever the chunk is flushed, one JSON object per line and community
+// ID, such that recent packets can be looked up while the chunk is written.
+//
+// A new chunk is started once the current chunk exceeds the configured size
+// or age, or a packet of a different link type is written. The oldest chunks
+// are removed once the chunks exceed max_size_mb.
+type archiveWriter struct {
+	mu sync.Mutex
+
+	cfg     config.ArchiveConfig
+	ports   decoder.TunnelPorts
+	pattern *regexp.Regexp
+
+	f        *os.File
+	w        *bufio.Writer
+	idx      *os.File
+	enc      *pcapEncoder
+	size     int64
+	opened   time.Time
+	lastName time.Time // time in the name of the last chunk created
+	failed   bool
+	stop     chan struct{} // stops flushing the current chunk
+
+	pending map[string]*archiveIndexEntry // index entries not written yet
+}
+
+// archiveIndexEntry is a line of an index file.
+type archiveIndexEntry struct {
+	CommunityID string  `json:"community_id"`
+	First       int64   `json:"first"` // Unix time in nanoseconds
+	Last        int64   `json:"last"`
+	Offsets     []int64 `json:"offsets"`
+}
+
+func newArchiveWriter(cfg config.ArchiveConfig, ports decoder.TunnelPorts) *archiveWriter {
+	return &archiveWriter{
+		cfg:   cfg,
+		ports: ports,
+		pattern: regexp.MustCompile(`^` + regexp.QuoteMeta(cfg.Filename) +
+			`-\d{8}-\d{6}\.\d{3}` + regexp.QuoteMeta(archiveChunkExt) + `$`),
+		pending: map[string]*archiveIndexEntry{},
+	}
+}
+
+// WritePacket archives a packet captured on iface. Errors are logged,
+// archiving is retried with the next packet.
+func (a *archiveWriter) WritePacket(iface Interface, data []byte, ci *gopacket_dpdk.CaptureInfo) {
+	id := communityID(iface.LinkType, data, a.ports)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := time.Now()
+	if a.f != nil && (a.rotationDue(now) || a.enc.linkType != iface.LinkType) {
+		a.closeChunk()
+	}
+	if a.f == nil {
+		if err := a.openChunk(now); err != nil {
+			if !a.failed {
+				logp.Err("Failed to open archive chunk: %v", err)
+				a.failed = true
+			}
+			return
+		}
+		a.failed = false
+	}
+
+	n, err := a.enc.writePacket(a.w, iface, data, ci)
+	a.size += int64(n)
+	if err != nil {
+		logp.Err("Failed to write to archive chunk %s: %v", a.f.Name(), err)
+		a.closeChunk()
+		return
+	}
+
+	if id != "" {
+		ts := ci.Timestamp.UnixNano()
+		entry, exists := a.pending[id]
+		if !exists {
+			entry = &archiveIndexEntry{CommunityID: id, First: ts, Last: ts}
+			a.pending[id] = entry
+		}
+		if ts < entry.First {
+			entry.First = ts
+		}
+		if ts > entry.Last {
+			entry.Last = ts
+		}
+		entry.Offsets = append(entry.Offsets, a.size-int64(16+len(data)))
+	}
+}
+
+func (a *archiveWriter) rotationDue(now time.Time) bool {
+	if a.cfg.ChunkSizeMb > 0 && a.size >= int64(a.cfg.ChunkSizeMb)<<20 {
+		return true
+	}
+	return a.cfg.ChunkInterval > 0 && now.Sub(a.opened) >= a.cfg.ChunkInterval
+}
+
+// flush writes the buffered packets to the chunk, followed by the index
+// entries of the packets.
+func (a *archiveWriter) flush() {
+	if err := a.w.Flush(); err != nil {
+		logp.Err("Failed to write to archive chunk %s: %v", a.f.Name(), err)
+		return
+	}
+	if len(a.pending) == 0 {
+		return
+	}
+
+	var buf []byte
+	for id, entry := range a.pending {
+		line, err := json.Marshal(entry)
+		if err != nil {
+			continue
+		}
+		buf = append(append(buf, line...), '\n')
+		delete(a.pending, id)
+	}
+	if _, err := a.idx.Write(buf); err != nil {
+		logp.Err("Failed to write to archive index %s: %v", a.idx.Name(), err)
+	}
+}
+
+func (a *archiveWriter) openChunk(now time.Time) error {
+	if err := os.MkdirAll(a.cfg.Path, 0750); err != nil {
+		return err
+	}
+
+	ts := now.UTC().Truncate(time.Millisecond)
+	if !ts.After(a.lastName) {
+		ts = a.lastName.Add(time.Millisecond)
+	}
+	var (
+		f    *os.File
+		base string
+		err  error
+	)
+	for {
+		base = filepath.Join(a.cfg.Path, fmt.Sprintf("%s-%s", a.cfg.Filename, ts.Format(dumpTimeLayout)))
+		f, err = os.OpenFile(base+archiveChunkExt, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		if !os.IsExist(err) {
+			break
+		}
+		ts = ts.Add(time.Millisecond)
+	}
+	if err != nil {
+		return err
+	}
+	idx, err := os.OpenFile(base+archiveIndexExt, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	logp.Debug("sniffer", "Archiving packets to %s", f.Name())
+
+	a.f, a.idx = f, idx
+	a.w = bufio.NewWriterSize(f, 1<<16)
+	a.enc = &pcapEncoder{}
+	a.size = 0
+	a.opened = now
+	a.lastName = ts
+	a.stop = make(chan struct{})
+	flushPeriodically(&a.mu, a.stop, a.flush)
+
+	a.removeOldChunks()
+	return nil
+}
+
+// removeOldChunks removes the oldest chunks and their indexes until the
+// archive fits into max_size_mb. The current chunk is never removed.
+func (a *archiveWriter) removeOldChunks() {
+	entries, err := os.ReadDir(a.cfg.Path)
+	if err != nil {
+		logp.Warn("Failed to list archive chunks: %v", err)
+		return
+	}
+
+	var (
+		chunks []string
+		sizes  = map[string]int64{}
+		total  int64
+	)
+	for _, e := range entries {
+		name := e.Name()
+		chunk := name
+		if strings.HasSuffix(name, archiveIndexExt) {
+			chunk = strings.TrimSuffix(name, archiveIndexExt) + archiveChunkExt
+		}
+		if !e.Type().IsRegular() || !a.pattern.MatchString(chunk) {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		if name == chunk {
+			chunks = append(chunks, name)
+		}
+		sizes[chunk] += info.Size()
+		total += info.Size()
+	}
+
+	sort.Strings(chunks)
+	for len(chunks) > 1 && total > int64(a.cfg.MaxSizeMb)<<20 {
+		base := filepath.Join(a.cfg.Path, strings.TrimSuffix(chunks[0], archiveChunkExt))
+		for _, path := range []string{base + archiveChunkExt, base + archiveIndexExt} {
+			if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+				logp.Warn("Failed to remove archive file %s: %v", path, err)
+			}
+		}
+		total -= sizes[chunks[0]]
+		chunks = chunks[1:]
+	}
+}
+
+func (a *archiveWriter) closeChunk() {
+	close(a.stop)
+	a.flush()
+	a.f.Close()
+	a.idx.Close()
+	a.f, a.w, a.idx, a.enc, a.stop = nil, nil, nil, nil, nil
+}
+
+func (a *archiveWriter) Close() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.f != nil {
+		a.closeChunk()
+	}
+}
+
+// communityID returns the community ID of the connection a packet belongs
+// to, or an empty string if the packet is not an IP packet. Tunneled packets
+// are indexed by the connection they carry, decapsulated with the UDP
+// tunnel ports of the decoder, such that they are found by the community ID
+// of events.
+func communityID(linkType layers.LinkType, data []byte, ports decoder.TunnelPorts) string {
+	packet, ok := innerPacket(linkType, data, ports)
+	if !ok {
+		return ""
+	}
+
+	var flow flowhash.Flow
+	switch l := packet.NetworkLayer().(type) {
+	case *layers.IPv4:
+		flow.SourceIP, flow.DestinationIP, flow.Protocol = l.SrcIP, l.DstIP, uint8(l.Protocol)
+	case *layers.IPv6:
+		flow.SourceIP, flow.DestinationIP, flow.Protocol = l.SrcIP, l.DstIP, uint8(l.NextHeader)
+	default:
+		return ""
+	}
+
+	switch l := packet.TransportLayer().(type) {
+	case *layers.TCP:
+		flow.Protocol, flow.SourcePort, flow.DestinationPort = 6, uint16(l.SrcPort), uint16(l.DstPort)
+	case *layers.UDP:
+		flow.Protocol, flow.SourcePort, flow.DestinationPort = 17, uint16(l.SrcPort), uint16(l.DstPort)
+	case *layers.SCTP:
+		flow.Protocol, flow.SourcePort, flow.DestinationPort = 132, uint16(l.SrcPort), uint16(l.DstPort)
+	default:
+		if icmp, ok := packet.Layer(layers.LayerTypeICMPv4).(*layers.ICMPv4); ok {
+			flow.Protocol = 1
+			flow.ICMP.Type, flow.ICMP.Code = icmp.TypeCode.Type(), icmp.TypeCode.Code()
+		} else if icmp, ok := packet.Layer(layers.LayerTypeICMPv6).(*layers.ICMPv6); ok {
+			flow.Protocol = 58
+			flow.ICMP.Type, flow.ICMP.Code = icmp.TypeCode.Type(), icmp.TypeCode.Code()
+		}
+	}
+	return flowhash.CommunityID.Hash(flow)
+}
+
+// archivedPacket is a packet read back from the archive.
+type archivedPacket struct {
+	linkType layers.LinkType
+	ci       gopacket_dpdk.CaptureInfo
+	data     []byte
+}
+
+// ExtractArchive writes the packets of the connection with the given
+// community ID, archived in dir between from and to, to w as pcap file. A
+// zero from or to leaves the time range open. Packets are written in the
+// order of their timestamps. A pcap file has a single link type, packets of
+// other link types are skipped. ExtractArchive returns the number of packets
+// written.
+func ExtractArchive(dir, communityID string, from, to time.Time, w io.Writer) (int, error) {
+	indexes, err := filepath.Glob(filepath.Join(dir, "*"+archiveIndexExt))
+	if err != nil {
+		return 0, err
+	}
+	sort.Strings(indexes)
+
+	var packets []archivedPacket
+	for _, index := range indexes {
+		offsets, err := readArchiveIndex(index, communityID, from, to)
+		if err != nil {
+			return 0, err
+		}
+		if len(offsets) == 0 {
+			continue
+		}
+		chunk := strings.TrimSuffix(index, archiveIndexExt) + archiveChunkExt
+		packets, err = readArchiveChunk(chunk, offsets, from, to, packets)
+		if err != nil {
+			return 0, fmt.Errorf("failed to read archive chunk %s: %v", chunk, err)
+		}
+	}
+	sort.SliceStable(packets, func(i, j int) bool {
+		return packets[i].ci.Timestamp.Before(packets[j].ci.Timestamp)
+	})
+
+	bw := bufio.NewWriter(w)
+	enc := &pcapEncoder{}
+	written, skipped := 0, 0
+	for i := range packets {
+		p := &packets[i]
+		if enc.started && p.linkType != enc.linkType {
+			skipped++
+			continue
+		}
+		if _, err := enc.writePacket(bw, Interface{LinkType: p.linkType}, p.data, &p.ci); err != nil {
+			return written, err
+		}
+		written++
+	}
+	if skipped > 0 {
+		logp.Warn("Skipped %d packets of link types other than %s", skipped, enc.linkType)
+	}
+	return written, bw.Flush()
+}
+
+// readArchiveIndex returns the offsets of the packets of a connection in the
+// chunk of an index, if the connection has packets in the time range.
+// Incomplete lines, e.g. because the index has been written while it is
+// read, are ignored.
+func readArchiveIndex(path, communityID string, from, to time.Time) ([]int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil // removed by the archive writer
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	var offsets []int64
+	r := bufio.NewReader(f)
+	for {
+		line, err := r.ReadBytes('\n')
+		if err == io.EOF {
+			return offsets, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !strings.Contains(string(line), communityID) {
+			continue
+		}
+
+		var entry archiveIndexEntry
+		if json.Unmarshal(line, &entry) != nil || entry.CommunityID != communityID {
+			continue
+		}
+		if (!from.IsZero() && entry.Last < from.UnixNano()) || (!to.IsZero() && entry.First > to.UnixNano()) {
+			continue
+		}
+		offsets = append(offsets, entry.Offsets...)
+	}
+}
+
+// readArchiveChunk appends the packets at offsets within the time range to
+// packets.
+func readArchiveChunk(path string, offsets []int64, from, to time.Time, packets []archivedPacket) ([]archivedPacket, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return packets, err
+	}
+	defer f.Close()
+
+	var hdr [24]byte
+	if _, err := f.ReadAt(hdr[:], 0); err != nil {
+		return packets, err
+	}
+	if binary.LittleEndian.Uint32(hdr[0:4]) != pcapMagicMicros {
+		return packets, errPcapFormat
+	}
+	linkType := layers.LinkType(binary.LittleEndian.Uint32(hdr[20:24]) & 0x0fffffff)
+
+	var rec [16]byte
+	for _, offset := range offsets {
+		if _, err := f.ReadAt(rec[:], offset); err != nil {
+			return packets, err
+		}
+		ts := time.Unix(int64(binary.LittleEndian.Uint32(rec[0:4])), int64(binary.LittleEndian.Uint32(rec[4:8]))*int64(time.Microsecond))
+		if (!from.IsZero() && ts.Before(from)) || (!to.IsZero() && ts.After(to)) {
+			continue
+		}
+		capLen := binary.LittleEndian.Uint32(rec[8:12])
+		if capLen > pcapMaxPacketSize {
+			return packets, errPcapFormat
+		}
+		data := make([]byte, capLen)
+		if _, err := f.ReadAt(data, offset+16); err != nil {
+			return packets, err
+		}
+		packets = append(packets, archivedPacket{
+			linkType: linkType,
+			ci: gopacket_dpdk.CaptureInfo{
+				Timestamp:     ts,
+				CaptureLength: int(capLen),
+				Length:        int(binary.LittleEndian.Uint32(rec[12:16])),
+			},
+			data: data,
+		})
+	}
+	return packets, nil
+}
@@ -0,0 +1,179 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !integration
+// +build !integration
+
+package sniffer
+
+import (
+	"bytes"
+	"io"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/njcx/gopacket_dpdk"
+	"github.com/njcx/gopacket_dpdk/layers"
+
+	"github.com/njcx/packetbeat7_dpdk/config"
+	"github.com/njcx/packetbeat7_dpdk/decoder"
+)
+
+func TestCommunityID(t *testing.T) {
+	request := tcpPacket(t, "128.232.110.120", 34855, "66.35.250.204", 80)
+	response := tcpPacket(t, "66.35.250.204", 80, "128.232.110.120", 34855)
+
+	assert.Equal(t, "1:LQU9qZlK+B5F3KDmev6m5PMibrg=", communityID(layers.LinkTypeEthernet, request, nil))
+	assert.Equal(t, "1:LQU9qZlK+B5F3KDmev6m5PMibrg=", communityID(layers.LinkTypeEthernet, response, nil))
+	assert.Equal(t, "", communityID(layers.LinkTypeEthernet, []byte{1, 2, 3}, nil))
+
+	// tunneled packets are indexed by the inner connection
+	ports := decoder.NewTunnelPorts(config.TunnelsConfig{})
+	tunneled := vxlanPacket(t, 1, request)
+	assert.Equal(t, "1:LQU9qZlK+B5F3KDmev6m5PMibrg=", communityID(layers.LinkTypeEthernet, tunneled, ports))
+	assert.NotEqual(t, "1:LQU9qZlK+B5F3KDmev6m5PMibrg=", communityID(layers.LinkTypeEthernet, tunneled, nil))
+}
+
+func archivePacket(a *archiveWriter, data []byte, ts time.Time) {
+	a.WritePacket(ringIface, data, &gopacket_dpdk.CaptureInfo{Timestamp: ts, CaptureLength: len(data), Length: len(data)})
+}
+
+func extractArchive(t *testing.T, dir, id string, from, to time.Time) [][]byte {
+	var buf bytes.Buffer
+	n, err := ExtractArchive(dir, id, from, to, &buf)
+	require.NoError(t, err)
+	if n == 0 {
+		return nil
+	}
+
+	r, err := newStreamReader(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, layers.LinkTypeEthernet, r.LinkType())
+	var packets [][]byte
+	for {
+		data, _, err := r.ReadPacketData()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		packets = append(packets, data)
+	}
+	assert.Len(t, packets, n)
+	return packets
+}
+
+func TestArchive(t *testing.T) {
+	dir := t.TempDir()
+	cfg := config.ArchiveConfig{Enabled: true, Path: dir}
+	setArchiveDefaults(&cfg)
+	require.NoError(t, validateArchiveConfig(&cfg))
+
+	request := tcpPacket(t, "10.0.0.1", 40000, "10.0.0.2", 80)
+	response := tcpPacket(t, "10.0.0.2", 80, "10.0.0.1", 40000)
+	other := tcpPacket(t, "10.0.0.1", 40001, "10.0.0.2", 80)
+	id := communityID(layers.LinkTypeEthernet, request, nil)
+
+	ts := time.Unix(1600000000, 0)
+	a := newArchiveWriter(cfg, nil)
+	archivePacket(a, request, ts)
+	archivePacket(a, other, ts.Add(time.Second))
+	archivePacket(a, response, ts.Add(2*time.Second))
+	archivePacket(a, request, ts.Add(3*time.Second))
+	a.Close()
+
+	chunks, err := filepath.Glob(filepath.Join(dir, "packetbeat-*.pcap"))
+	require.NoError(t, err)
+	require.Len(t, chunks, 1)
+	assert.FileExists(t, chunks[0][:len(chunks[0])-len(archiveChunkExt)]+archiveIndexExt)
+
+	packets := extractArchive(t, dir, id, time.Time{}, time.Time{})
+	assert.Equal(t, [][]byte{request, response, request}, packets)
+
+	packets = extractArchive(t, dir, id, ts.Add(time.Second), ts.Add(2*time.Second))
+	assert.Equal(t, [][]byte{response}, packets)
+
+	assert.Empty(t, extractArchive(t, dir, id, ts.Add(time.Hour), time.Time{}))
+	assert.Empty(t, extractArchive(t, dir, "1:unknown", time.Time{}, time.Time{}))
+}
+
+func TestArchive_flush(t *testing.T) {
+	withFlushInterval(t, 10*time.Millisecond)
+
+	dir := t.TempDir()
+	cfg := config.ArchiveConfig{Enabled: true, Path: dir}
+	setArchiveDefaults(&cfg)
+	request := tcpPacket(t, "10.0.0.1", 40000, "10.0.0.2", 80)
+	id := communityID(layers.LinkTypeEthernet, request, nil)
+
+	a := newArchiveWriter(cfg, nil)
+	defer a.Close()
+
+	// packets can be extracted from the open chunk once flushed
+	archivePacket(a, request, time.Unix(1600000000, 0))
+	assert.Eventually(t, func() bool {
+		var buf bytes.Buffer
+		n, err := ExtractArchive(dir, id, time.Time{}, time.Time{}, &buf)
+		return err == nil && n == 1
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestArchive_rotation(t *testing.T) {
+	dir := t.TempDir()
+	cfg := config.ArchiveConfig{Enabled: true, Path: dir, ChunkSizeMb: 1, MaxSizeMb: 2}
+	setArchiveDefaults(&cfg)
+	require.NoError(t, validateArchiveConfig(&cfg))
+
+	packet := tcpPacket(t, "10.0.0.1", 40000, "10.0.0.2", 80)
+	packet = append(packet, make([]byte, 64<<10)...)
+	id := communityID(layers.LinkTypeEthernet, packet, nil)
+
+	ts := time.Unix(1600000000, 0)
+	a := newArchiveWriter(cfg, nil)
+	for i := 0; i < 100; i++ {
+		archivePacket(a, packet, ts.Add(time.Duration(i)*time.Second))
+	}
+	a.Close()
+
+	chunks, err := filepath.Glob(filepath.Join(dir, "packetbeat-*.pcap"))
+	require.NoError(t, err)
+	assert.Len(t, chunks, 2)
+	indexes, err := filepath.Glob(filepath.Join(dir, "packetbeat-*.idx"))
+	require.NoError(t, err)
+	assert.Len(t, indexes, 2)
+
+	// the packets of the chunks kept
+	packets := extractArchive(t, dir, id, time.Time{}, time.Time{})
+	assert.Len(t, packets, 100-5*16)
+}
+
+func TestValidateArchiveConfig(t *testing.T) {
+	cfg := config.ArchiveConfig{Enabled: true}
+	setArchiveDefaults(&cfg)
+	assert.NoError(t, validateArchiveConfig(&cfg))
+
+	cfg.ChunkInterval = -time.Second
+	assert.Error(t, validateArchiveConfig(&cfg))
+
+	cfg.ChunkInterval = 0
+	cfg.MaxSizeMb = 10
+	cfg.ChunkSizeMb = 20
+	assert.Error(t, validateArchiveConfig(&cfg))
+}
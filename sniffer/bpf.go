@@ -0,0 +1,62 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sniffer
+
+import (
+	"fmt"
+
+	"golang.org/x/net/bpf"
+
+	"github.com/njcx/gopacket_dpdk/layers"
+	"github.com/njcx/gopacket_dpdk/pcap"
+)
+
+// compileBPF compiles a filter expression into a BPF program that can be
+// executed in user space, for handles that can not filter in the kernel.
+// A nil VM is returned for an empty expression.
+func compileBPF(linkType layers.LinkType, snaplen int, expr string) (*bpf.VM, error) {
+	if expr == "" {
+		return nil, nil
+	}
+
+	raw, err := compileBPFRaw(linkType, snaplen, expr)
+	if err != nil {
+		return nil, err
+	}
+	prog, ok := bpf.Disassemble(raw)
+	if !ok {
+		return nil, fmt.Errorf("failed to decode BPF program for filter '%s'", expr)
+	}
+
+	return bpf.NewVM(prog)
+}
+
+// compileBPFRaw compiles a filter expression for frames of the given link
+// type into the instructions attached to a socket.
+func compileBPFRaw(linkType layers.LinkType, snaplen int, expr string) ([]bpf.RawInstruction, error) {
+	insns, err := pcap.CompileBPFFilter(linkType, snaplen, expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter '%s': %v", expr, err)
+	}
+
+	raw := make([]bpf.RawInstruction, len(insns))
+	for i, ins := range insns {
+		raw[i] = bpf.RawInstruction{Op: ins.Code, Jt: ins.Jt, Jf: ins.Jf, K: ins.K}
+	}
+	return raw, nil
+}
@@ -21,10 +21,13 @@ import (
 	"fmt"
 	"runtime"
 	"strconv"
+	"strings"
 
 	"github.com/njcx/gopacket_dpdk/pcap"
 
 	"github.com/njcx/libbeat_v7/logp"
+
+	"github.com/njcx/packetbeat7_dpdk/config"
 )
 
 var deviceAnySupported = runtime.GOOS == "linux"
@@ -73,6 +76,28 @@ func ListDeviceNames(withDescription bool, withIP bool) ([]string, error) {
 	return ret, nil
 }
 
+// InterfaceName returns the name of the interface captured from by a sniffer
+// configured with cfg. The name is empty if packets are read from a file or
+// stream.
+func InterfaceName(cfg config.InterfacesConfig) string {
+	switch {
+	case cfg.File != "", cfg.Type == "stream":
+		return ""
+	case cfg.Type == "dpdk" && cfg.Dpdk.PortName != "":
+		return cfg.Dpdk.PortName
+	case cfg.Type == "dpdk" && len(cfg.Dpdk.Vdevs) > 0:
+		return strings.SplitN(cfg.Dpdk.Vdevs[0], ",", 2)[0]
+	case cfg.Type == "dpdk":
+		return fmt.Sprintf("dpdk%d", cfg.Dpdk.Port)
+	}
+
+	name, err := resolveDeviceName(cfg.Device)
+	if err != nil {
+		return cfg.Device
+	}
+	return name
+}
+
 func resolveDeviceName(name string) (string, error) {
 	if name == "" {
 		return "any", nil
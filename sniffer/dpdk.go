@@ -0,0 +1,282 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sniffer
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/net/bpf"
+
+	"github.com/njcx/gopacket_dpdk"
+	"github.com/njcx/gopacket_dpdk/layers"
+
+	"github.com/njcx/libbeat_v7/logp"
+
+	"github.com/njcx/packetbeat7_dpdk/config"
+	"github.com/njcx/packetbeat7_dpdk/dpdkinit"
+)
+
+const (
+	defaultDpdkMbufPoolSize  = 8191
+	defaultDpdkRxDescriptors = 1024
+	defaultDpdkBurstSize     = 32
+)
+
+// dpdkHandle reads packets from a DPDK port. If the port is configured with
+// multiple RX queues, each queue is read through its own handle returned by
+// Queues. Filters are applied in user space, as the packets never pass the
+// kernel.
+type dpdkHandle struct {
+	port   *dpdkinit.Port
+	queues []*dpdkQueueHandle
+}
+
+type dpdkQueueHandle struct {
+	queue  *dpdkinit.Queue
+	filter atomic.Pointer[bpf.VM] // swapped while reading
+	buf    []byte
+	burst  *dpdkinit.Burst
+}
+
+func newDpdkHandle(cfg *config.InterfacesConfig) (*dpdkHandle, error) {
+	if err := dpdkinit.DpdkInit(cfg.Dpdk.EalArgs); err != nil {
+		return nil, err
+	}
+
+	portID, err := dpdkPortID(&cfg.Dpdk)
+	if err != nil {
+		return nil, err
+	}
+
+	port, err := dpdkinit.OpenPort(dpdkinit.PortConfig{
+		Port:          portID,
+		RxQueues:      cfg.Dpdk.RxQueues,
+		MbufPoolSize:  cfg.Dpdk.MbufPoolSize,
+		RxDescriptors: cfg.Dpdk.RxDescriptors,
+		Promiscuous:   cfg.Dpdk.PromiscEnabled(),
+		MTU:           cfg.Dpdk.MTU,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	h := &dpdkHandle{port: port}
+	for _, q := range port.Queues() {
+		h.queues = append(h.queues, &dpdkQueueHandle{
+			queue: q,
+			buf:   make([]byte, cfg.Snaplen),
+			burst: dpdkinit.NewBurst(cfg.Dpdk.BurstSize, cfg.Snaplen),
+		})
+	}
+	return h, nil
+}
+
+// dpdkPortID creates the configured vdevs and returns the id of the port to
+// capture from. The port is selected by port_name if set, otherwise the
+// first vdev is used if any are configured.
+func dpdkPortID(cfg *config.DpdkConfig) (uint16, error) {
+	var vdevs []string
+	for _, spec := range cfg.Vdevs {
+		name, err := dpdkinit.AttachVdev(spec)
+		if err != nil {
+			return 0, err
+		}
+		vdevs = append(vdevs, name)
+	}
+
+	switch {
+	case cfg.PortName != "":
+		return dpdkinit.PortByName(cfg.PortName)
+	case len(vdevs) > 0:
+		return dpdkinit.PortByName(vdevs[0])
+	default:
+		return cfg.Port, nil
+	}
+}
+
+func (h *dpdkHandle) ReadPacketData() (data []byte, ci gopacket_dpdk.CaptureInfo, err error) {
+	return h.queues[0].ReadPacketData()
+}
+
+func (h *dpdkHandle) Queues() []snifferHandle {
+	handles := make([]snifferHandle, len(h.queues))
+	for i, q := range h.queues {
+		handles[i] = q
+	}
+	return handles
+}
+
+func (h *dpdkHandle) SetBPFFilter(expr string) error {
+	filter, err := compileBPF(layers.LinkTypeEthernet, len(h.queues[0].buf), expr)
+	if err != nil {
+		return err
+	}
+	for _, q := range h.queues {
+		q.filter.Store(filter)
+	}
+	return nil
+}
+
+// Stats reports the port statistics. Packets dropped by the NIC, because
+// the RX queues were full, are reported as dropped by the interface.
+func (h *dpdkHandle) Stats() (captureStats, error) {
+	st, err := h.port.Stats()
+	if err != nil {
+		return captureStats{}, err
+	}
+
+	counters := map[string]uint64{
+		"ipackets":  st.Packets,
+		"ibytes":    st.Bytes,
+		"imissed":   st.Missed,
+		"ierrors":   st.Errors,
+		"rx_nombuf": st.NoMbuf,
+	}
+	for i := range st.QueuePackets {
+		counters[fmt.Sprintf("queues.%d.ipackets", i)] = st.QueuePackets[i]
+		counters[fmt.Sprintf("queues.%d.errors", i)] = st.QueueErrors[i]
+	}
+
+	xstats, err := h.port.XStats()
+	if err != nil {
+		logp.Debug("sniffer", "Failed to read dpdk xstats: %v", err)
+	}
+	for name, v := range xstats {
+		counters["xstats."+monitoringName(name)] = v
+	}
+
+	return captureStats{
+		received:  st.Packets,
+		dropped:   st.NoMbuf,
+		ifDropped: st.Missed,
+		errors:    st.Errors,
+		counters:  counters,
+	}, nil
+}
+
+func (h *dpdkHandle) LinkType() layers.LinkType {
+	return layers.LinkTypeEthernet
+}
+
+func (h *dpdkHandle) Close() {
+	h.port.Close()
+}
+
+func (h *dpdkQueueHandle) ReadPacketData() (data []byte, ci gopacket_dpdk.CaptureInfo, err error) {
+	for {
+		n, length, ok := h.queue.ReadPacket(h.buf)
+		if !ok {
+			// no packet available, return empty packet like a timeout
+			return nil, ci, nil
+		}
+
+		if filter := h.filter.Load(); filter != nil {
+			if keep, _ := filter.Run(h.buf[:n]); keep == 0 {
+				continue
+			}
+		}
+
+		data = make([]byte, n)
+		copy(data, h.buf[:n])
+		ci = gopacket_dpdk.CaptureInfo{
+			Timestamp:     time.Now(),
+			CaptureLength: n,
+			Length:        length,
+		}
+		return data, ci, nil
+	}
+}
+
+// ReadPacketBatch reads a burst of packets with a single call into DPDK.
+// The packets of a burst share one buffer, which is allocated per burst, as
+// workers may keep references to the packet data.
+func (h *dpdkQueueHandle) ReadPacketBatch(data [][]byte, ci []gopacket_dpdk.CaptureInfo) (int, error) {
+	burst := h.burst
+	if h.queue.ReadBurst(burst) == 0 {
+		return 0, nil
+	}
+	ts := time.Now()
+
+	size := 0
+	for i := 0; i < burst.Len(); i++ {
+		pkt, _ := burst.Packet(i)
+		size += len(pkt)
+	}
+	buf := make([]byte, size)
+
+	filter := h.filter.Load()
+	n := 0
+	for i := 0; i < burst.Len() && n < len(data); i++ {
+		pkt, length := burst.Packet(i)
+		if filter != nil {
+			if keep, _ := filter.Run(pkt); keep == 0 {
+				continue
+			}
+		}
+
+		c := copy(buf, pkt)
+		data[n] = buf[:c:c]
+		buf = buf[c:]
+		ci[n] = gopacket_dpdk.CaptureInfo{
+			Timestamp:     ts,
+			CaptureLength: c,
+			Length:        length,
+		}
+		n++
+	}
+	return n, nil
+}
+
+func (h *dpdkQueueHandle) LinkType() layers.LinkType {
+	return layers.LinkTypeEthernet
+}
+
+// Close is a no-op, queues are released when the port is closed.
+func (h *dpdkQueueHandle) Close() {
+}
+
+func validateDpdkConfig(cfg *config.InterfacesConfig) error {
+	dpdk := &cfg.Dpdk
+	if dpdk.RxQueues <= 0 || dpdk.RxQueues > 1024 {
+		return fmt.Errorf("dpdk.rx_queues must be in range [1, 1024], got %d", dpdk.RxQueues)
+	}
+	if dpdk.MbufPoolSize <= 0 {
+		return fmt.Errorf("dpdk.mbuf_pool_size must be > 0, got %d", dpdk.MbufPoolSize)
+	}
+	if dpdk.RxDescriptors <= 0 || dpdk.RxDescriptors > 1<<15 {
+		return fmt.Errorf("dpdk.rx_descriptors must be in range [1, %d], got %d", 1<<15, dpdk.RxDescriptors)
+	}
+	if dpdk.MbufPoolSize < dpdk.RxQueues*dpdk.RxDescriptors {
+		return fmt.Errorf("dpdk.mbuf_pool_size %d is too small to fill %d rx queues with %d descriptors each",
+			dpdk.MbufPoolSize, dpdk.RxQueues, dpdk.RxDescriptors)
+	}
+	if dpdk.MTU < 0 || dpdk.MTU > 65535 {
+		return fmt.Errorf("dpdk.mtu must be in range [0, 65535], got %d", dpdk.MTU)
+	}
+	if dpdk.BurstSize <= 0 || dpdk.BurstSize > maxBatchSize {
+		return fmt.Errorf("dpdk.burst_size must be in range [1, %d], got %d", maxBatchSize, dpdk.BurstSize)
+	}
+	for _, spec := range dpdk.Vdevs {
+		if spec == "" || spec[0] == ',' {
+			return fmt.Errorf("invalid dpdk.vdevs entry '%s': missing device name", spec)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build integration
+// +build integration
+
+package sniffer
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/njcx/gopacket_dpdk"
+	"github.com/njcx/gopacket_dpdk/layers"
+
+	"github.com/njcx/packetbeat7_dpdk/config"
+	"github.com/njcx/packetbeat7_dpdk/decoder"
+	"github.com/njcx/packetbeat7_dpdk/flows"
+	"github.com/njcx/packetbeat7_dpdk/protos"
+)
+
+// The DPDK tests run against virtual devices and require the net_pcap and
+// net_null drivers, but neither hugepages nor a NIC bound to a DPDK driver.
+var dpdkTestEalArgs = []string{"--no-pci", "--no-huge", "-m", "256", "--log-level", "warning"}
+
+type countingTCPProcessor struct {
+	sync.Mutex
+	packets int
+}
+
+func (p *countingTCPProcessor) Process(id *flows.FlowID, hdr *layers.TCP, pkt *protos.Packet) {
+	p.Lock()
+	defer p.Unlock()
+	p.packets++
+}
+
+func (p *countingTCPProcessor) count() int {
+	p.Lock()
+	defer p.Unlock()
+	return p.packets
+}
+
+type countingWorker struct {
+	sync.Mutex
+	packets int
+}
+
+func (w *countingWorker) OnPacket(data []byte, ci *gopacket_dpdk.CaptureInfo) {
+	w.Lock()
+	defer w.Unlock()
+	w.packets++
+}
+
+func (w *countingWorker) count() int {
+	w.Lock()
+	defer w.Unlock()
+	return w.packets
+}
+
+// runDpdkSniffer runs a sniffer until done returns true or the test times
+// out.
+func runDpdkSniffer(t *testing.T, cfg config.InterfacesConfig, factory WorkerFactory, done func() bool) {
+	s, err := New(false, "", factory, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	errs := make(chan error, 1)
+	go func() { errs <- s.Run() }()
+
+	deadline := time.Now().Add(10 * time.Second)
+	for !done() && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	s.Stop()
+	if err := <-errs; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDpdkVdevPcap(t *testing.T) {
+	cfg := config.InterfacesConfig{
+		Type: "dpdk",
+		Dpdk: config.DpdkConfig{
+			EalArgs: dpdkTestEalArgs,
+			Vdevs:   []string{"net_pcap0,rx_pcap=../tests/system/pcaps/http_post.pcap"},
+		},
+	}
+
+	// all 8 packets of the trace are TCP
+	tcp := &countingTCPProcessor{}
+	factory := func(dl layers.LinkType) (Worker, error) {
+		return decoder.New(nil, dl, nil, nil, tcp, nil)
+	}
+	runDpdkSniffer(t, cfg, factory, func() bool { return tcp.count() >= 8 })
+
+	if n := tcp.count(); n != 8 {
+		t.Errorf("decoded %d TCP packets, expected 8", n)
+	}
+}
+
+func TestDpdkVdevNull(t *testing.T) {
+	cfg := config.InterfacesConfig{
+		Type: "dpdk",
+		Dpdk: config.DpdkConfig{
+			EalArgs:  dpdkTestEalArgs,
+			Vdevs:    []string{"net_null0", "net_null1"},
+			PortName: "net_null1",
+			RxQueues: 2,
+		},
+	}
+
+	var (
+		mu      sync.Mutex
+		workers []*countingWorker
+	)
+	factory := func(dl layers.LinkType) (Worker, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		w := &countingWorker{}
+		workers = append(workers, w)
+		return w, nil
+	}
+	received := func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		for _, w := range workers {
+			if w.count() < 1000 {
+				return false
+			}
+		}
+		return len(workers) == 2
+	}
+	runDpdkSniffer(t, cfg, factory, received)
+
+	if !received() {
+		t.Error("expected every queue to receive packets from the null device")
+	}
+}
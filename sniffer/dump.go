@@ -0,0 +1,489 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sniffer
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/net/bpf"
+
+	"github.com/njcx/gopacket_dpdk"
+	"github.com/njcx/gopacket_dpdk/layers"
+
+	"github.com/njcx/libbeat_v7/logp"
+	"github.com/njcx/libbeat_v7/paths"
+
+	"github.com/njcx/packetbeat7_dpdk/config"
+)
+
+const (
+	defaultDumpPath          = "dump"
+	defaultDumpFilename      = "packetbeat"
+	defaultDumpFormat        = "pcapng"
+	defaultDumpRotateEveryKb = 100 * 1024
+	defaultDumpNumberOfFiles = 7
+
+	dumpTimeLayout = "20060102-150405.000"
+)
+
+// dumpFlushInterval is how often buffered packets are written to the dump
+// file and the archive.
+var dumpFlushInterval = time.Second
+
+func setDumpDefaults(cfg *config.DumpConfig) {
+	if cfg.Path == "" {
+		cfg.Path = defaultDumpPath
+	}
+	cfg.Path = paths.Resolve(paths.Data, cfg.Path)
+	if cfg.Filename == "" {
+		cfg.Filename = defaultDumpFilename
+	}
+	if cfg.Format == "" {
+		cfg.Format = defaultDumpFormat
+	}
+	if cfg.RotateEveryKb == 0 {
+		cfg.RotateEveryKb = defaultDumpRotateEveryKb
+	}
+	if cfg.NumberOfFiles == 0 {
+		cfg.NumberOfFiles = defaultDumpNumberOfFiles
+	}
+	if cfg.SensorName == "" {
+		cfg.SensorName, _ = os.Hostname()
+	}
+}
+
+func validateDumpConfig(cfg *config.DumpConfig) error {
+	if cfg.Format != "pcapng" && cfg.Format != "pcap" {
+		return fmt.Errorf("unknown dump format '%s', must be pcapng or pcap", cfg.Format)
+	}
+	if cfg.RotateEveryKb < 0 {
+		return fmt.Errorf("dump rotate_every_kb must not be negative")
+	}
+	if cfg.RotateInterval < 0 {
+		return fmt.Errorf("dump rotate_interval must not be negative")
+	}
+	if cfg.NumberOfFiles < 1 {
+		return fmt.Errorf("dump number_of_files must be at least 1")
+	}
+	return validatePcapFilter(cfg.BpfFilter)
+}
+
+// packetDumper writes packets to pcapng or pcap files. A new file is started
+// once the current file exceeds the configured size or age, and the oldest
+// files are removed such that at most number_of_files files are kept.
+// Files are created when the first packet is written to them.
+//
+// If the dumper writes to a fixed file, as configured by the -dump flag,
+// files are neither rotated nor removed.
+type packetDumper struct {
+	mu sync.Mutex
+
+	cfg     config.DumpConfig
+	fixed   string
+	ifaceOf func(ci *gopacket_dpdk.CaptureInfo) Interface
+	pattern *regexp.Regexp
+
+	filters   map[layers.LinkType]*bpf.VM
+	badFilter map[layers.LinkType]bool
+
+	f        *os.File
+	w        *bufio.Writer
+	enc      dumpEncoder
+	size     int64
+	opened   time.Time
+	lastName time.Time // time in the name of the last file created
+	failed   bool
+	stop     chan struct{} // stops flushing the current file
+}
+
+// dumpEncoder encodes packets in a capture file format.
+type dumpEncoder interface {
+	// writePacket writes the packet, preceded by headers not written to the
+	// file yet. It returns the number of bytes written.
+	writePacket(w io.Writer, iface Interface, data []byte, ci *gopacket_dpdk.CaptureInfo) (int, error)
+}
+
+func newPacketDumper(cfg config.DumpConfig, fixed string, ifaceOf func(ci *gopacket_dpdk.CaptureInfo) Interface) *packetDumper {
+	d := &packetDumper{
+		cfg:       cfg,
+		fixed:     fixed,
+		ifaceOf:   ifaceOf,
+		filters:   map[layers.LinkType]*bpf.VM{},
+		badFilter: map[layers.LinkType]bool{},
+	}
+	if fixed == "" {
+		d.pattern = regexp.MustCompile(`^` + regexp.QuoteMeta(cfg.Filename) +
+			`-\d{8}-\d{6}\.\d{3}\.` + regexp.QuoteMeta(cfg.Format) + `$`)
+	}
+	return d
+}
+
+// WritePacketData writes a packet if it matches the dump filter. Errors are
+// logged, dumping is retried with the next packet.
+func (d *packetDumper) WritePacketData(data []byte, ci *gopacket_dpdk.CaptureInfo) {
+	iface := d.ifaceOf(ci)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if !d.matches(iface.LinkType, data) {
+		return
+	}
+
+	now := time.Now()
+	if d.f != nil && d.rotationDue(now) {
+		d.closeFile()
+	}
+	if d.f == nil {
+		if err := d.openFile(now); err != nil {
+			if !d.failed {
+				logp.Err("Failed to open dump file: %v", err)
+				d.failed = true
+			}
+			return
+		}
+		d.failed = false
+	}
+
+	n, err := d.enc.writePacket(d.w, iface, data, ci)
+	d.size += int64(n)
+	if err != nil {
+		logp.Err("Failed to write to dump file %s: %v", d.f.Name(), err)
+		d.closeFile()
+	}
+}
+
+func (d *packetDumper) matches(linkType layers.LinkType, data []byte) bool {
+	if d.cfg.BpfFilter == "" {
+		return true
+	}
+	if d.badFilter[linkType] {
+		return false
+	}
+
+	vm, exists := d.filters[linkType]
+	if !exists {
+		var err error
+		vm, err = compileBPF(linkType, 65535, d.cfg.BpfFilter)
+		if err != nil {
+			logp.Warn("Not dumping packets of link type %s: %v", linkType, err)
+			d.badFilter[linkType] = true
+			return false
+		}
+		d.filters[linkType] = vm
+	}
+	keep, err := vm.Run(data)
+	return err == nil && keep != 0
+}
+
+func (d *packetDumper) rotationDue(now time.Time) bool {
+	if d.fixed != "" {
+		return false
+	}
+	if d.cfg.RotateEveryKb > 0 && d.size >= int64(d.cfg.RotateEveryKb)*1024 {
+		return true
+	}
+	return d.cfg.RotateInterval > 0 && now.Sub(d.opened) >= d.cfg.RotateInterval
+}
+
+func (d *packetDumper) openFile(now time.Time) error {
+	var f *os.File
+	var err error
+	if d.fixed != "" {
+		f, err = os.Create(d.fixed)
+	} else {
+		f, err = d.createRotated(now)
+	}
+	if err != nil {
+		return err
+	}
+
+	d.f = f
+	d.w = bufio.NewWriterSize(f, 1<<16)
+	d.size = 0
+	d.opened = now
+	d.stop = make(chan struct{})
+	flushPeriodically(&d.mu, d.stop, func() { d.w.Flush() })
+	if d.cfg.Format == "pcap" {
+		d.enc = &pcapEncoder{}
+	} else {
+		d.enc = &pcapngEncoder{sensor: d.cfg.SensorName}
+	}
+
+	if d.fixed == "" {
+		d.removeOldFiles()
+	}
+	return nil
+}
+
+// createRotated creates a new file named after the time it is started, with
+// millisecond precision. Names are kept unique and ascending, such that the
+// oldest files sort first.
+func (d *packetDumper) createRotated(now time.Time) (*os.File, error) {
+	if err := os.MkdirAll(d.cfg.Path, 0750); err != nil {
+		return nil, err
+	}
+
+	ts := now.UTC().Truncate(time.Millisecond)
+	if !ts.After(d.lastName) {
+		ts = d.lastName.Add(time.Millisecond)
+	}
+	for {
+		path := filepath.Join(d.cfg.Path, fmt.Sprintf("%s-%s.%s", d.cfg.Filename, ts.Format(dumpTimeLayout), d.cfg.Format))
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		if os.IsExist(err) {
+			ts = ts.Add(time.Millisecond)
+			continue
+		}
+		if err == nil {
+			d.lastName = ts
+			logp.Debug("sniffer", "Dumping packets to %s", path)
+		}
+		return f, err
+	}
+}
+
+// removeOldFiles removes the oldest dump files, keeping number_of_files
+// files including the current one.
+func (d *packetDumper) removeOldFiles() {
+	entries, err := os.ReadDir(d.cfg.Path)
+	if err != nil {
+		logp.Warn("Failed to list dump files: %v", err)
+		return
+	}
+
+	var files []string
+	for _, e := range entries {
+		if e.Type().IsRegular() && d.pattern.MatchString(e.Name()) {
+			files = append(files, e.Name())
+		}
+	}
+	sort.Strings(files)
+	for len(files) > d.cfg.NumberOfFiles {
+		path := filepath.Join(d.cfg.Path, files[0])
+		if err := os.Remove(path); err != nil {
+			logp.Warn("Failed to remove dump file %s: %v", path, err)
+		}
+		files = files[1:]
+	}
+}
+
+func (d *packetDumper) closeFile() {
+	close(d.stop)
+	if err := d.w.Flush(); err != nil {
+		logp.Err("Failed to write to dump file %s: %v", d.f.Name(), err)
+	}
+	d.f.Close()
+	d.f, d.w, d.enc, d.stop = nil, nil, nil, nil
+}
+
+// flushPeriodically starts a goroutine calling flush with mu held every
+// dumpFlushInterval until stop is closed, such that buffered packets are
+// written while no further packets arrive. stop must be closed with mu held.
+func flushPeriodically(mu *sync.Mutex, stop chan struct{}, flush func()) {
+	ticker := time.NewTicker(dumpFlushInterval)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+			case <-stop:
+				return
+			}
+
+			mu.Lock()
+			select {
+			case <-stop:
+			default:
+				flush()
+			}
+			mu.Unlock()
+		}
+	}()
+}
+
+func (d *packetDumper) Close() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.f != nil {
+		d.closeFile()
+	}
+}
+
+// pcapEncoder writes pcap files with microsecond timestamps. A pcap file has
+// a single link type, packets of other link types are dropped.
+type pcapEncoder struct {
+	linkType layers.LinkType
+	started  bool
+	warned   bool
+	buf      [24]byte
+}
+
+func (e *pcapEncoder) writePacket(w io.Writer, iface Interface, data []byte, ci *gopacket_dpdk.CaptureInfo) (int, error) {
+	written := 0
+	if !e.started {
+		e.started = true
+		e.linkType = iface.LinkType
+		binary.LittleEndian.PutUint32(e.buf[0:4], pcapMagicMicros)
+		binary.LittleEndian.PutUint16(e.buf[4:6], 2)
+		binary.LittleEndian.PutUint16(e.buf[6:8], 4)
+		binary.LittleEndian.PutUint32(e.buf[8:12], 0)
+		binary.LittleEndian.PutUint32(e.buf[12:16], 0)
+		binary.LittleEndian.PutUint32(e.buf[16:20], 65535)
+		binary.LittleEndian.PutUint32(e.buf[20:24], uint32(e.linkType))
+		n, err := w.Write(e.buf[:24])
+		written += n
+		if err != nil {
+			return written, err
+		}
+	}
+	if iface.LinkType != e.linkType {
+		if !e.warned {
+			logp.Warn("Not dumping packets of link type %s to pcap file of link type %s", iface.LinkType, e.linkType)
+			e.warned = true
+		}
+		return written, nil
+	}
+
+	ts := ci.Timestamp
+	binary.LittleEndian.PutUint32(e.buf[0:4], uint32(ts.Unix()))
+	binary.LittleEndian.PutUint32(e.buf[4:8], uint32(ts.Nanosecond()/int(time.Microsecond)))
+	binary.LittleEndian.PutUint32(e.buf[8:12], uint32(len(data)))
+	binary.LittleEndian.PutUint32(e.buf[12:16], uint32(ci.Length))
+	n, err := w.Write(e.buf[:16])
+	written += n
+	if err != nil {
+		return written, err
+	}
+	n, err = w.Write(data)
+	return written + n, err
+}
+
+// pcapngEncoder writes pcapng files with nanosecond timestamps. The section
+// header records the sensor name, an interface description is written for
+// every interface packets are dumped of. Packet comments are kept.
+type pcapngEncoder struct {
+	sensor  string
+	started bool
+	ids     map[int]uint32 // interface ids by Interface.Index
+	buf     []byte
+}
+
+func (e *pcapngEncoder) writePacket(w io.Writer, iface Interface, data []byte, ci *gopacket_dpdk.CaptureInfo) (int, error) {
+	b := e.buf[:0]
+	if !e.started {
+		e.started = true
+		e.ids = map[int]uint32{}
+		b = e.sectionHeader(b)
+	}
+	id, exists := e.ids[iface.Index]
+	if !exists {
+		id = uint32(len(e.ids))
+		e.ids[iface.Index] = id
+		b = e.interfaceDescription(b, iface)
+	}
+	b = e.enhancedPacket(b, id, data, ci)
+	e.buf = b
+	return w.Write(b)
+}
+
+func (e *pcapngEncoder) sectionHeader(b []byte) []byte {
+	body := make([]byte, 16)
+	binary.LittleEndian.PutUint32(body[0:4], pcapngByteOrderMagic)
+	binary.LittleEndian.PutUint16(body[4:6], 1)
+	binary.LittleEndian.PutUint16(body[6:8], 0)
+	binary.LittleEndian.PutUint64(body[8:16], ^uint64(0)) // unknown section length
+	if e.sensor != "" {
+		body = appendPcapngOption(body, pcapngOptComment, []byte("sensor: "+e.sensor))
+	}
+	body = appendPcapngOption(body, pcapngOptShbOS, []byte(runtime.GOOS+"/"+runtime.GOARCH))
+	body = appendPcapngOption(body, pcapngOptShbUserAppl, []byte("packetbeat"))
+	body = appendPcapngOption(body, pcapngOptEnd, nil)
+	return appendPcapngBlock(b, pcapngSectionHeader, body)
+}
+
+func (e *pcapngEncoder) interfaceDescription(b []byte, iface Interface) []byte {
+	body := make([]byte, 8)
+	binary.LittleEndian.PutUint16(body[0:2], uint16(iface.LinkType))
+	binary.LittleEndian.PutUint32(body[4:8], uint32(iface.Snaplen))
+	if iface.Name != "" {
+		body = appendPcapngOption(body, pcapngOptIfName, []byte(iface.Name))
+	}
+	if iface.Description != "" {
+		body = appendPcapngOption(body, pcapngOptIfDesc, []byte(iface.Description))
+	}
+	body = appendPcapngOption(body, pcapngOptIfTsResol, []byte{9})
+	body = appendPcapngOption(body, pcapngOptEnd, nil)
+	return appendPcapngBlock(b, pcapngInterfaceDescription, body)
+}
+
+func (e *pcapngEncoder) enhancedPacket(b []byte, id uint32, data []byte, ci *gopacket_dpdk.CaptureInfo) []byte {
+	start := len(b)
+	b = append(b, make([]byte, 28)...)
+	hdr := b[start:]
+	ts := uint64(ci.Timestamp.UnixNano())
+	binary.LittleEndian.PutUint32(hdr[0:4], pcapngEnhancedPacket)
+	binary.LittleEndian.PutUint32(hdr[8:12], id)
+	binary.LittleEndian.PutUint32(hdr[12:16], uint32(ts>>32))
+	binary.LittleEndian.PutUint32(hdr[16:20], uint32(ts))
+	binary.LittleEndian.PutUint32(hdr[20:24], uint32(len(data)))
+	binary.LittleEndian.PutUint32(hdr[24:28], uint32(ci.Length))
+	b = append(b, data...)
+	b = append(b, make([]byte, (4-len(data)%4)%4)...)
+
+	comments := false
+	for _, v := range ci.AncillaryData {
+		if c, ok := v.(PacketComment); ok {
+			b = appendPcapngOption(b, pcapngOptComment, []byte(c))
+			comments = true
+		}
+	}
+	if comments {
+		b = appendPcapngOption(b, pcapngOptEnd, nil)
+	}
+
+	// block length, also repeated at the end of the block
+	length := uint32(len(b) - start + 4)
+	binary.LittleEndian.PutUint32(b[start+4:start+8], length)
+	return binary.LittleEndian.AppendUint32(b, length)
+}
+
+func appendPcapngBlock(b []byte, typ uint32, body []byte) []byte {
+	length := uint32(12 + len(body))
+	b = binary.LittleEndian.AppendUint32(b, typ)
+	b = binary.LittleEndian.AppendUint32(b, length)
+	b = append(b, body...)
+	return binary.LittleEndian.AppendUint32(b, length)
+}
+
+func appendPcapngOption(b []byte, code uint16, value []byte) []byte {
+	b = binary.LittleEndian.AppendUint16(b, code)
+	b = binary.LittleEndian.AppendUint16(b, uint16(len(value)))
+	b = append(b, value...)
+	return append(b, make([]byte, (4-len(value)%4)%4)...)
+}
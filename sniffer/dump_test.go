@@ -0,0 +1,252 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !integration
+// +build !integration
+
+package sniffer
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/njcx/gopacket_dpdk"
+	"github.com/njcx/gopacket_dpdk/layers"
+
+	"github.com/njcx/packetbeat7_dpdk/config"
+)
+
+// ipv4Packet returns an ethernet frame carrying an IPv4 packet of the given
+// protocol, padded to size bytes.
+func ipv4Packet(proto byte, size int) []byte {
+	p := make([]byte, size)
+	p[12], p[13] = 0x08, 0x00
+	p[14] = 0x45
+	p[23] = proto
+	return p
+}
+
+func dumpFiles(t *testing.T, dir string) []string {
+	files, err := filepath.Glob(filepath.Join(dir, "*"))
+	require.NoError(t, err)
+	return files
+}
+
+func TestPacketDumper_pcapng(t *testing.T) {
+	dir := t.TempDir()
+	cfg := config.DumpConfig{Enabled: true, Path: dir, SensorName: "sensor-1"}
+	setDumpDefaults(&cfg)
+	require.NoError(t, validateDumpConfig(&cfg))
+
+	ifaces := []Interface{
+		{Index: 0, Name: "eth0", Description: "uplink", LinkType: layers.LinkTypeEthernet, Snaplen: 1514},
+		{Index: 1, Name: "any", LinkType: layers.LinkTypeLinuxSLL},
+	}
+	d := newPacketDumper(cfg, "", func(ci *gopacket_dpdk.CaptureInfo) Interface {
+		return ifaces[ci.InterfaceIndex]
+	})
+
+	ts := time.Unix(1600000000, 123456789)
+	d.WritePacketData([]byte{1, 2, 3}, &gopacket_dpdk.CaptureInfo{
+		Timestamp:     ts,
+		CaptureLength: 3,
+		Length:        10,
+		AncillaryData: []interface{}{PacketComment("retransmission")},
+	})
+	d.WritePacketData([]byte{4}, &gopacket_dpdk.CaptureInfo{Timestamp: ts, CaptureLength: 1, Length: 1, InterfaceIndex: 1})
+	d.WritePacketData([]byte{5}, &gopacket_dpdk.CaptureInfo{Timestamp: ts, CaptureLength: 1, Length: 1})
+	d.Close()
+
+	files := dumpFiles(t, dir)
+	require.Len(t, files, 1)
+	assert.Regexp(t, `^packetbeat-\d{8}-\d{6}\.\d{3}\.pcapng$`, filepath.Base(files[0]))
+
+	raw, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	assert.True(t, bytes.Contains(raw, []byte("sensor: sensor-1")))
+
+	r, err := openPcapng(files[0])
+	require.NoError(t, err)
+	defer r.Close()
+
+	data, ci, err := r.ReadPacketData()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{1, 2, 3}, data)
+	assert.Equal(t, ts, ci.Timestamp)
+	assert.Equal(t, 10, ci.Length)
+	assert.Equal(t, []interface{}{PacketComment("retransmission")}, ci.AncillaryData)
+
+	data, ci, err = r.ReadPacketData()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{4}, data)
+	assert.Equal(t, 1, ci.InterfaceIndex)
+
+	data, ci, err = r.ReadPacketData()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{5}, data)
+	assert.Equal(t, 0, ci.InterfaceIndex)
+
+	_, _, err = r.ReadPacketData()
+	assert.Equal(t, io.EOF, err)
+
+	iface, _ := r.Interface(0)
+	assert.Equal(t, Interface{Index: 0, Name: "eth0", Description: "uplink", LinkType: layers.LinkTypeEthernet, Snaplen: 1514}, iface)
+	iface, _ = r.Interface(1)
+	assert.Equal(t, layers.LinkTypeLinuxSLL, iface.LinkType)
+}
+
+func TestPacketDumper_pcap(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "out.pcap")
+	d := newPacketDumper(config.DumpConfig{Format: "pcap"}, file, func(ci *gopacket_dpdk.CaptureInfo) Interface {
+		return Interface{LinkType: layers.LinkType(ci.InterfaceIndex)}
+	})
+
+	ts := time.Unix(1600000000, 123456000)
+	d.WritePacketData([]byte{1, 2}, &gopacket_dpdk.CaptureInfo{Timestamp: ts, CaptureLength: 2, Length: 2, InterfaceIndex: int(layers.LinkTypeEthernet)})
+	// packets of other link types are dropped
+	d.WritePacketData([]byte{3}, &gopacket_dpdk.CaptureInfo{Timestamp: ts, CaptureLength: 1, Length: 1, InterfaceIndex: int(layers.LinkTypeLinuxSLL)})
+	d.Close()
+
+	f, err := os.Open(file)
+	require.NoError(t, err)
+	defer f.Close()
+	r, err := newStreamReader(f)
+	require.NoError(t, err)
+	assert.Equal(t, layers.LinkTypeEthernet, r.LinkType())
+
+	data, ci, err := r.ReadPacketData()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{1, 2}, data)
+	assert.Equal(t, ts, ci.Timestamp)
+	_, _, err = r.ReadPacketData()
+	assert.Equal(t, io.EOF, err)
+}
+
+func withFlushInterval(t *testing.T, interval time.Duration) {
+	old := dumpFlushInterval
+	dumpFlushInterval = interval
+	t.Cleanup(func() { dumpFlushInterval = old })
+}
+
+func TestPacketDumper_flush(t *testing.T) {
+	withFlushInterval(t, 10*time.Millisecond)
+
+	file := filepath.Join(t.TempDir(), "out.pcap")
+	d := newPacketDumper(config.DumpConfig{Format: "pcap"}, file, func(ci *gopacket_dpdk.CaptureInfo) Interface {
+		return Interface{LinkType: layers.LinkTypeEthernet}
+	})
+	defer d.Close()
+
+	// buffered packets are written without waiting for the next packet
+	d.WritePacketData([]byte{1, 2}, &gopacket_dpdk.CaptureInfo{Timestamp: time.Unix(1600000000, 0), CaptureLength: 2, Length: 2})
+	assert.Eventually(t, func() bool {
+		info, err := os.Stat(file)
+		return err == nil && info.Size() == 24+16+2
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestPacketDumper_rotation(t *testing.T) {
+	ethernet := func(*gopacket_dpdk.CaptureInfo) Interface {
+		return Interface{LinkType: layers.LinkTypeEthernet}
+	}
+
+	t.Run("size", func(t *testing.T) {
+		dir := t.TempDir()
+		cfg := config.DumpConfig{Enabled: true, Path: dir, RotateEveryKb: 1, NumberOfFiles: 3}
+		setDumpDefaults(&cfg)
+		d := newPacketDumper(cfg, "", ethernet)
+
+		// other files in the directory are kept
+		other := filepath.Join(dir, "packetbeat-other.pcapng")
+		require.NoError(t, os.WriteFile(other, nil, 0644))
+
+		// 2 packets per file
+		for i := 0; i < 10; i++ {
+			d.WritePacketData(ipv4Packet(6, 600), &gopacket_dpdk.CaptureInfo{Timestamp: time.Now(), CaptureLength: 600, Length: 600})
+		}
+		d.Close()
+
+		files := dumpFiles(t, dir)
+		assert.Len(t, files, 4)
+		assert.Contains(t, files, other)
+		for _, f := range files {
+			if f != other {
+				info, err := os.Stat(f)
+				require.NoError(t, err)
+				assert.True(t, info.Size() > 1024 && info.Size() < 2048, "size of %s: %d", f, info.Size())
+			}
+		}
+	})
+
+	t.Run("interval", func(t *testing.T) {
+		dir := t.TempDir()
+		cfg := config.DumpConfig{Enabled: true, Path: dir, RotateInterval: time.Millisecond, NumberOfFiles: 10}
+		setDumpDefaults(&cfg)
+		d := newPacketDumper(cfg, "", ethernet)
+
+		for i := 0; i < 3; i++ {
+			d.WritePacketData(ipv4Packet(6, 60), &gopacket_dpdk.CaptureInfo{Timestamp: time.Now(), CaptureLength: 60, Length: 60})
+			time.Sleep(5 * time.Millisecond)
+		}
+		d.Close()
+
+		assert.Len(t, dumpFiles(t, dir), 3)
+	})
+}
+
+func TestPacketDumper_filter(t *testing.T) {
+	dir := t.TempDir()
+	cfg := config.DumpConfig{Enabled: true, Path: dir, Format: "pcap", BpfFilter: "udp"}
+	setDumpDefaults(&cfg)
+	require.NoError(t, validateDumpConfig(&cfg))
+	d := newPacketDumper(cfg, "", func(*gopacket_dpdk.CaptureInfo) Interface {
+		return Interface{LinkType: layers.LinkTypeEthernet}
+	})
+
+	d.WritePacketData(ipv4Packet(6, 60), &gopacket_dpdk.CaptureInfo{Timestamp: time.Now(), CaptureLength: 60, Length: 60})
+	d.WritePacketData(ipv4Packet(17, 61), &gopacket_dpdk.CaptureInfo{Timestamp: time.Now(), CaptureLength: 61, Length: 61})
+	d.Close()
+
+	files := dumpFiles(t, dir)
+	require.Len(t, files, 1)
+	info, err := os.Stat(files[0])
+	require.NoError(t, err)
+	assert.Equal(t, int64(24+16+61), info.Size())
+}
+
+func TestValidateDumpConfig(t *testing.T) {
+	cfg := config.DumpConfig{Enabled: true}
+	setDumpDefaults(&cfg)
+	assert.NoError(t, validateDumpConfig(&cfg))
+
+	for _, c := range []config.DumpConfig{
+		{Format: "erf"},
+		{RotateEveryKb: -1},
+		{RotateInterval: -time.Second},
+		{NumberOfFiles: -1},
+	} {
+		setDumpDefaults(&c)
+		assert.Error(t, validateDumpConfig(&c), "%+v", c)
+	}
+}
@@ -27,23 +27,32 @@ import (
 	"github.com/njcx/gopacket_dpdk/pcap"
 
 	"github.com/njcx/libbeat_v7/logp"
+
+	"github.com/njcx/packetbeat7_dpdk/config"
 )
 
+// fileReader reads packets from a pcap or pcapng file.
+type fileReader interface {
+	gopacket_dpdk.PacketDataSource
+
+	LinkType() layers.LinkType
+	Close()
+}
+
 type fileHandler struct {
-	pcapHandle *pcap.Handle
-	file       string
+	reader fileReader
+	file   string
 
 	loopCount, maxLoopCount int
 
-	topSpeed bool
-	lastTS   time.Time
+	clock replayClock
 }
 
-func newFileHandler(file string, topSpeed bool, maxLoopCount int) (*fileHandler, error) {
+func newFileHandler(cfg *config.InterfacesConfig) (*fileHandler, error) {
 	h := &fileHandler{
-		file:         file,
-		topSpeed:     topSpeed,
-		maxLoopCount: maxLoopCount,
+		file:         cfg.File,
+		clock:        newReplayClock(cfg),
+		maxLoopCount: cfg.Loop,
 	}
 	if err := h.open(); err != nil {
 		return nil, err
@@ -52,25 +61,66 @@ func newFileHandler(file string, topSpeed bool, maxLoopCount int) (*fileHandler,
 	return h, nil
 }
 
+func validateReplayConfig(cfg *config.InterfacesConfig) error {
+	replay := cfg.Replay
+	if cfg.File == "" {
+		if replay != (config.ReplayConfig{}) {
+			return fmt.Errorf("replay settings require file to be set")
+		}
+		return nil
+	}
+	if replay.Speed < 0 {
+		return fmt.Errorf("replay.speed must not be negative, got %v", replay.Speed)
+	}
+	// the clock is advanced by the reader, while queued packets are decoded
+	// later on
+	if replay.VirtualClock && cfg.DecodeQueue.Enabled() {
+		return fmt.Errorf("replay.virtual_clock can not be used with decode_queue")
+	}
+	return nil
+}
+
 func (h *fileHandler) open() error {
-	tmp, err := pcap.OpenOffline(h.file)
+	r, err := openCaptureFile(h.file)
 	if err != nil {
 		return err
 	}
 
-	h.pcapHandle = tmp
+	h.reader = r
 	return nil
 }
 
+// openCaptureFile opens a pcap or pcapng file. pcapng files are read
+// natively to keep the interface of each packet.
+func openCaptureFile(file string) (fileReader, error) {
+	ng, err := isPcapng(file)
+	if err != nil {
+		return nil, err
+	}
+	if ng {
+		r, err := openPcapng(file)
+		if err != nil {
+			return nil, err
+		}
+		return r, nil
+	}
+
+	h, err := pcap.OpenOffline(file)
+	if err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
 func (h *fileHandler) ReadPacketData() ([]byte, gopacket_dpdk.CaptureInfo, error) {
-	data, ci, err := h.pcapHandle.ReadPacketData()
+	data, ci, err := h.reader.ReadPacketData()
 	if err != nil {
 		if err != io.EOF {
 			return data, ci, err
 		}
 
-		h.pcapHandle.Close()
-		h.pcapHandle = nil
+		h.reader.Close()
+		h.reader = nil
 
 		h.loopCount++
 		if h.loopCount >= h.maxLoopCount {
@@ -82,36 +132,121 @@ func (h *fileHandler) ReadPacketData() ([]byte, gopacket_dpdk.CaptureInfo, error
 			return nil, ci, fmt.Errorf("Error reopening file: %s", err)
 		}
 
-		data, ci, err = h.pcapHandle.ReadPacketData()
-		h.lastTS = ci.Timestamp
-		return data, ci, err
-	}
-
-	if h.topSpeed {
-		return data, ci, nil
-	}
-
-	if !h.lastTS.IsZero() {
-		sleep := ci.Timestamp.Sub(h.lastTS)
-		if sleep > 0 {
-			time.Sleep(sleep)
-		} else {
-			logp.Warn("Time in pcap went backwards: %d", sleep)
+		h.clock.restart()
+		data, ci, err = h.reader.ReadPacketData()
+		if err != nil {
+			return data, ci, err
 		}
 	}
 
-	h.lastTS = ci.Timestamp
-	ci.Timestamp = time.Now()
+	h.clock.pace(&ci)
 	return data, ci, nil
 }
 
 func (h *fileHandler) LinkType() layers.LinkType {
-	return h.pcapHandle.LinkType()
+	return h.reader.LinkType()
+}
+
+// HasInterfaces reports whether the file records the interface of each
+// packet, which is the case for pcapng files.
+func (h *fileHandler) HasInterfaces() bool {
+	_, ok := h.reader.(*pcapngReader)
+	return ok
+}
+
+// Interface returns the interface of the given CaptureInfo.InterfaceIndex.
+func (h *fileHandler) Interface(index int) (Interface, bool) {
+	if r, ok := h.reader.(*pcapngReader); ok {
+		return r.Interface(index)
+	}
+	return Interface{}, false
 }
 
 func (h *fileHandler) Close() {
-	if h.pcapHandle != nil {
-		h.pcapHandle.Close()
-		h.pcapHandle = nil
+	if h.reader != nil {
+		h.reader.Close()
+		h.reader = nil
+	}
+}
+
+// replayClock paces the packets read from files according to their
+// timestamps and the replay speed, unless reading at top speed. Paced
+// packets are timestamped with the time they are read at, unless the
+// timestamps are rebased or follow the virtual clock.
+type replayClock struct {
+	topSpeed bool
+	speed    float64
+	rebase   bool
+	virtual  bool
+
+	lastTS time.Time // timestamp of the last packet read
+
+	// rebasing
+	rebased bool
+	first   time.Time // timestamp of the first packet since the last restart
+	start   time.Time // rebased timestamp of first
+	lastOut time.Time // latest rebased timestamp
+}
+
+func newReplayClock(cfg *config.InterfacesConfig) replayClock {
+	speed := cfg.Replay.Speed
+	if speed <= 0 {
+		speed = 1
+	}
+	return replayClock{
+		topSpeed: cfg.TopSpeed,
+		speed:    speed,
+		rebase:   cfg.Replay.RebaseTimestamps,
+		virtual:  cfg.Replay.VirtualClock,
+	}
+}
+
+// restart is called when the replay starts over. The next packet is not
+// paced by the time since the last packet, and rebased timestamps continue
+// from the later of now and the last rebased timestamp.
+func (c *replayClock) restart() {
+	c.lastTS = time.Time{}
+	c.rebased = false
+}
+
+func (c *replayClock) pace(ci *gopacket_dpdk.CaptureInfo) {
+	if !c.topSpeed && !c.lastTS.IsZero() {
+		gap := ci.Timestamp.Sub(c.lastTS)
+		if gap > 0 {
+			time.Sleep(time.Duration(float64(gap) / c.speed))
+		} else if gap < 0 {
+			logp.Warn("Time in pcap went backwards: %d", gap)
+		}
+	}
+	c.lastTS = ci.Timestamp
+
+	switch {
+	case c.rebase:
+		ci.Timestamp = c.rebaseTS(ci.Timestamp)
+	case !c.topSpeed && !c.virtual:
+		ci.Timestamp = time.Now()
+	}
+}
+
+// rebaseTS shifts ts such that the replay starts now. Unless reading at top
+// speed, the time between packets is scaled by the replay speed.
+func (c *replayClock) rebaseTS(ts time.Time) time.Time {
+	if !c.rebased {
+		c.rebased = true
+		c.first = ts
+		c.start = time.Now()
+		if c.start.Before(c.lastOut) {
+			c.start = c.lastOut
+		}
+	}
+
+	offset := ts.Sub(c.first)
+	if !c.topSpeed {
+		offset = time.Duration(float64(offset) / c.speed)
+	}
+	out := c.start.Add(offset)
+	if out.After(c.lastOut) {
+		c.lastOut = out
 	}
+	return out
 }
@@ -0,0 +1,119 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !integration
+// +build !integration
+
+package sniffer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/njcx/gopacket_dpdk"
+
+	"github.com/njcx/packetbeat7_dpdk/config"
+)
+
+func replay(c *replayClock, timestamps ...time.Time) []time.Time {
+	var out []time.Time
+	for _, ts := range timestamps {
+		ci := gopacket_dpdk.CaptureInfo{Timestamp: ts}
+		c.pace(&ci)
+		out = append(out, ci.Timestamp)
+	}
+	return out
+}
+
+func TestReplayClock(t *testing.T) {
+	t0 := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("top speed keeps timestamps", func(t *testing.T) {
+		c := newReplayClock(&config.InterfacesConfig{TopSpeed: true})
+		out := replay(&c, t0, t0.Add(time.Hour))
+		assert.Equal(t, []time.Time{t0, t0.Add(time.Hour)}, out)
+	})
+
+	t.Run("virtual clock keeps timestamps", func(t *testing.T) {
+		c := newReplayClock(&config.InterfacesConfig{
+			Replay: config.ReplayConfig{Speed: 1000, VirtualClock: true},
+		})
+		out := replay(&c, t0, t0.Add(time.Second))
+		assert.Equal(t, []time.Time{t0, t0.Add(time.Second)}, out)
+	})
+
+	t.Run("speed", func(t *testing.T) {
+		c := newReplayClock(&config.InterfacesConfig{
+			Replay: config.ReplayConfig{Speed: 20},
+		})
+		start := time.Now()
+		out := replay(&c, t0, t0.Add(time.Second), t0.Add(2*time.Second))
+		elapsed := time.Since(start)
+
+		assert.True(t, elapsed >= 100*time.Millisecond, "elapsed %v", elapsed)
+		assert.True(t, elapsed < time.Second, "elapsed %v", elapsed)
+		// paced packets are timestamped with the time they are read at
+		assert.False(t, out[0].Before(start))
+	})
+
+	t.Run("rebase", func(t *testing.T) {
+		c := newReplayClock(&config.InterfacesConfig{
+			TopSpeed: true,
+			Replay:   config.ReplayConfig{RebaseTimestamps: true},
+		})
+		start := time.Now()
+		out := replay(&c, t0, t0.Add(time.Hour))
+		assert.False(t, out[0].Before(start))
+		assert.Equal(t, time.Hour, out[1].Sub(out[0]))
+
+		// a restart continues after the last timestamp
+		c.restart()
+		again := replay(&c, t0)
+		assert.Equal(t, out[1], again[0])
+	})
+
+	t.Run("rebase scales by speed", func(t *testing.T) {
+		c := newReplayClock(&config.InterfacesConfig{
+			Replay: config.ReplayConfig{Speed: 100, RebaseTimestamps: true},
+		})
+		out := replay(&c, t0, t0.Add(time.Second))
+		assert.Equal(t, 10*time.Millisecond, out[1].Sub(out[0]))
+	})
+}
+
+func TestValidateReplayConfig(t *testing.T) {
+	assert.NoError(t, validateReplayConfig(&config.InterfacesConfig{Device: "any"}))
+	assert.Error(t, validateReplayConfig(&config.InterfacesConfig{
+		Device: "any",
+		Replay: config.ReplayConfig{VirtualClock: true},
+	}))
+	assert.NoError(t, validateReplayConfig(&config.InterfacesConfig{
+		File:   "test.pcap",
+		Replay: config.ReplayConfig{Speed: 0.5, VirtualClock: true},
+	}))
+	assert.Error(t, validateReplayConfig(&config.InterfacesConfig{
+		File:   "test.pcap",
+		Replay: config.ReplayConfig{Speed: -1},
+	}))
+	assert.Error(t, validateReplayConfig(&config.InterfacesConfig{
+		File:        "test.pcap",
+		Replay:      config.ReplayConfig{VirtualClock: true},
+		DecodeQueue: config.DecodeQueueConfig{Size: 100},
+	}))
+}
@@ -0,0 +1,455 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sniffer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/njcx/gopacket_dpdk"
+	"github.com/njcx/gopacket_dpdk/layers"
+	"github.com/njcx/gopacket_dpdk/pcap"
+
+	"github.com/njcx/libbeat_v7/logp"
+	"github.com/njcx/libbeat_v7/paths"
+
+	"github.com/njcx/packetbeat7_dpdk/config"
+)
+
+const (
+	defaultWatchInterval     = 10 * time.Second
+	defaultWatchMinAge       = 10 * time.Second
+	defaultWatchRegistryFile = "packetbeat-files.json"
+
+	// maxWatchSleep limits the time ReadPacketData blocks while waiting for
+	// new files, such that a stopped sniffer returns in time.
+	maxWatchSleep = 500 * time.Millisecond
+)
+
+func setFileWatchDefaults(cfg *config.FileWatchConfig) {
+	if cfg.Interval <= 0 {
+		cfg.Interval = defaultWatchInterval
+	}
+	if cfg.MinAge < 0 {
+		cfg.MinAge = 0
+	} else if cfg.MinAge == 0 {
+		cfg.MinAge = defaultWatchMinAge
+	}
+	if cfg.RegistryFile == "" {
+		cfg.RegistryFile = defaultWatchRegistryFile
+	}
+	cfg.RegistryFile = paths.Resolve(paths.Data, cfg.RegistryFile)
+}
+
+// fileSetHandler reads the capture files in a directory or matching a glob
+// pattern one after another, in timestamp order. All files are processed by
+// the same workers, such that TCP and flow state is kept across file
+// boundaries. Interfaces of different files with the same name, description
+// and link type are considered to be the same interface.
+//
+// In watch mode the files are scanned periodically for new files, which are
+// read once they have not been modified for min_age. Files read completely
+// are recorded in the registry.
+type fileSetHandler struct {
+	files    *captureFiles
+	watch    config.FileWatchConfig
+	registry *fileRegistry
+
+	pending  []captureFile // files to read, in order
+	present  []captureFile // files found by the last scan
+	lastScan time.Time
+
+	current captureFile
+	reader  fileReader
+
+	loopCount, maxLoopCount int
+
+	clock replayClock
+
+	linkType   layers.LinkType
+	interfaces interfaceMap
+}
+
+func newFileSetHandler(cfg *config.InterfacesConfig) (*fileSetHandler, error) {
+	h := &fileSetHandler{
+		files:        newCaptureFiles(cfg.File),
+		watch:        cfg.Watch,
+		maxLoopCount: cfg.Loop,
+		clock:        newReplayClock(cfg),
+		linkType:     layers.LinkTypeEthernet,
+	}
+
+	if h.watch.Enabled {
+		registry, err := loadFileRegistry(h.watch.RegistryFile)
+		if err != nil {
+			return nil, err
+		}
+		h.registry = registry
+	}
+
+	if err := h.scan(); err != nil {
+		return nil, err
+	}
+	if !h.watch.Enabled && len(h.pending) == 0 {
+		return nil, fmt.Errorf("no capture files found in %s", cfg.File)
+	}
+
+	// the link type of the first file is reported for all files, packets
+	// are processed by the workers of their interface though
+	if len(h.pending) > 0 {
+		if err := h.next(); err != nil {
+			if err == io.EOF {
+				return nil, fmt.Errorf("no readable capture files found in %s", cfg.File)
+			}
+			return nil, err
+		}
+		if h.reader != nil {
+			h.linkType = h.reader.LinkType()
+		}
+	}
+	return h, nil
+}
+
+// scan lists the capture files and queues the files to be read.
+func (h *fileSetHandler) scan() error {
+	files, err := h.files.scan()
+	if err != nil {
+		return err
+	}
+	h.lastScan = time.Now()
+	h.present = files
+
+	h.pending = h.pending[:0]
+	for _, f := range files {
+		if h.registry != nil {
+			if h.registry.isDone(f) {
+				continue
+			}
+			if age := time.Since(f.info.ModTime()); age < h.watch.MinAge {
+				logp.Debug("sniffer", "Capture file %s modified %v ago, deferring", f.path, age)
+				continue
+			}
+		}
+		h.pending = append(h.pending, f)
+	}
+	return nil
+}
+
+// next opens the next pending file. Without pending files the files are read
+// again if looping, or scanned again once the watch interval has passed.
+// io.EOF is returned once all files have been read. While waiting for new
+// files pcap.NextErrorTimeoutExpired is returned, giving the sniffer the
+// chance to stop.
+func (h *fileSetHandler) next() error {
+	for {
+		if len(h.pending) == 0 {
+			if !h.watch.Enabled {
+				h.loopCount++
+				if h.loopCount >= h.maxLoopCount {
+					return io.EOF
+				}
+
+				logp.Debug("sniffer", "Reading the files again")
+				if err := h.scan(); err != nil {
+					return err
+				}
+				if len(h.pending) == 0 {
+					return io.EOF
+				}
+				h.clock.restart()
+				continue
+			}
+
+			if wait := h.watch.Interval - time.Since(h.lastScan); wait > 0 {
+				if wait > maxWatchSleep {
+					wait = maxWatchSleep
+				}
+				time.Sleep(wait)
+				// do not pace the first packet of the next file by the time
+				// spent waiting for it
+				h.clock.restart()
+				return pcap.NextErrorTimeoutExpired
+			}
+			if err := h.scan(); err != nil {
+				return err
+			}
+			continue
+		}
+
+		f := h.pending[0]
+		h.pending = h.pending[1:]
+
+		r, err := openCaptureFile(f.path)
+		if err != nil {
+			logp.Warn("Skipping capture file %s: %v", f.path, err)
+			h.current = f
+			h.markDone()
+			continue
+		}
+
+		logp.Info("Reading capture file %s", f.path)
+		h.current = f
+		h.reader = r
+		h.interfaces.reset()
+		return nil
+	}
+}
+
+func (h *fileSetHandler) ReadPacketData() ([]byte, gopacket_dpdk.CaptureInfo, error) {
+	for {
+		if h.reader == nil {
+			if err := h.next(); err != nil {
+				return nil, gopacket_dpdk.CaptureInfo{}, err
+			}
+		}
+
+		data, ci, err := h.reader.ReadPacketData()
+		if err != nil {
+			if err != io.EOF {
+				logp.Warn("Error reading capture file %s, skipping the rest of the file: %v", h.current.path, err)
+			}
+			h.reader.Close()
+			h.reader = nil
+			h.markDone()
+			continue
+		}
+
+		ci.InterfaceIndex = h.interfaces.lookup(h.reader, ci.InterfaceIndex)
+		h.clock.pace(&ci)
+		return data, ci, nil
+	}
+}
+
+// markDone records the current file in the registry, if watching.
+func (h *fileSetHandler) markDone() {
+	if h.registry == nil {
+		return
+	}
+	if err := h.registry.markDone(h.current, h.present); err != nil {
+		logp.Warn("Failed to update file registry %s: %v", h.registry.path, err)
+	}
+}
+
+func (h *fileSetHandler) LinkType() layers.LinkType {
+	return h.linkType
+}
+
+// HasInterfaces returns true, as the files may hold packets of different
+// interfaces and link types.
+func (h *fileSetHandler) HasInterfaces() bool {
+	return true
+}
+
+// Interface returns the interface of the given CaptureInfo.InterfaceIndex.
+func (h *fileSetHandler) Interface(index int) (Interface, bool) {
+	return h.interfaces.Interface(index)
+}
+
+func (h *fileSetHandler) Close() {
+	if h.reader != nil {
+		h.reader.Close()
+		h.reader = nil
+	}
+}
+
+// isFilePattern returns true if file names a directory or is a glob
+// pattern, matching any number of capture files.
+func isFilePattern(file string) bool {
+	if strings.ContainsAny(file, "*?[") {
+		return true
+	}
+	info, err := os.Stat(file)
+	return err == nil && info.IsDir()
+}
+
+// captureFiles lists the capture files in a directory or matching a glob
+// pattern, in the order of the timestamp of their first packet.
+type captureFiles struct {
+	pattern string
+
+	// first packet timestamps, by path
+	firstTS map[string]time.Time
+}
+
+type captureFile struct {
+	path string
+	info os.FileInfo
+	ts   time.Time
+}
+
+func newCaptureFiles(pattern string) *captureFiles {
+	return &captureFiles{pattern: pattern, firstTS: map[string]time.Time{}}
+}
+
+// scan returns the capture files currently present. Hidden files and
+// subdirectories are ignored.
+func (c *captureFiles) scan() ([]captureFile, error) {
+	var paths []string
+	if info, err := os.Stat(c.pattern); err == nil && info.IsDir() {
+		entries, err := ioutil.ReadDir(c.pattern)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range entries {
+			paths = append(paths, filepath.Join(c.pattern, e.Name()))
+		}
+	} else {
+		matches, err := filepath.Glob(c.pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid file pattern %s: %v", c.pattern, err)
+		}
+		paths = matches
+	}
+
+	seen := make(map[string]bool, len(paths))
+	var files []captureFile
+	for _, path := range paths {
+		if strings.HasPrefix(filepath.Base(path), ".") {
+			continue
+		}
+		info, err := os.Stat(path)
+		if err != nil || !info.Mode().IsRegular() {
+			continue
+		}
+		seen[path] = true
+		files = append(files, captureFile{path: path, info: info, ts: c.timestamp(path, info)})
+	}
+
+	// forget files that have been removed
+	for path := range c.firstTS {
+		if !seen[path] {
+			delete(c.firstTS, path)
+		}
+	}
+
+	sortCaptureFiles(files)
+	return files, nil
+}
+
+// timestamp returns the timestamp of the first packet of the file. The
+// modification time is used for files without a readable packet.
+func (c *captureFiles) timestamp(path string, info os.FileInfo) time.Time {
+	if ts, exists := c.firstTS[path]; exists {
+		return ts
+	}
+
+	r, err := openCaptureFile(path)
+	if err != nil {
+		return info.ModTime()
+	}
+	defer r.Close()
+	_, ci, err := r.ReadPacketData()
+	if err != nil {
+		return info.ModTime()
+	}
+	c.firstTS[path] = ci.Timestamp
+	return ci.Timestamp
+}
+
+func sortCaptureFiles(files []captureFile) {
+	sort.SliceStable(files, func(i, j int) bool {
+		if !files[i].ts.Equal(files[j].ts) {
+			return files[i].ts.Before(files[j].ts)
+		}
+		return files[i].path < files[j].path
+	})
+}
+
+// fileRegistry records the capture files that have been read completely.
+// A file is read again if its size or modification time changes.
+type fileRegistry struct {
+	path  string
+	files map[string]registryEntry
+}
+
+type registryEntry struct {
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"mtime"`
+}
+
+func loadFileRegistry(path string) (*fileRegistry, error) {
+	r := &fileRegistry{path: path, files: map[string]registryEntry{}}
+
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return r, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var entries struct {
+		Files map[string]registryEntry `json:"files"`
+	}
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to read file registry %s: %v", path, err)
+	}
+	if entries.Files != nil {
+		r.files = entries.Files
+	}
+	logp.Debug("sniffer", "Loaded %d files from registry %s", len(r.files), path)
+	return r, nil
+}
+
+// isDone returns true if the file has been read completely.
+func (r *fileRegistry) isDone(file captureFile) bool {
+	e, exists := r.files[file.path]
+	return exists && e.Size == file.info.Size() && e.ModTime.Equal(file.info.ModTime())
+}
+
+// markDone records the file as read. Entries of files not present anymore
+// are removed. The registry is written to a temporary file first, which then
+// replaces the registry file.
+func (r *fileRegistry) markDone(file captureFile, present []captureFile) error {
+	r.files[file.path] = registryEntry{Size: file.info.Size(), ModTime: file.info.ModTime()}
+
+	if present != nil {
+		exists := make(map[string]bool, len(present))
+		for _, f := range present {
+			exists[f.path] = true
+		}
+		for path := range r.files {
+			if !exists[path] && path != file.path {
+				delete(r.files, path)
+			}
+		}
+	}
+
+	data, err := json.Marshal(struct {
+		Files map[string]registryEntry `json:"files"`
+	}{r.files})
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0750); err != nil {
+		return err
+	}
+	tmp := r.path + ".new"
+	if err := ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, r.path)
+}
@@ -0,0 +1,168 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !integration
+// +build !integration
+
+package sniffer
+
+import (
+	"encoding/binary"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/njcx/gopacket_dpdk/layers"
+	"github.com/njcx/gopacket_dpdk/pcap"
+
+	"github.com/njcx/packetbeat7_dpdk/config"
+)
+
+// writeCaptureFile writes a pcapng file with one packet per timestamp,
+// captured on eth0. The packet data is the timestamp in seconds.
+func writeCaptureFile(t *testing.T, path string, ts ...uint64) {
+	b := &pcapngBuilder{order: binary.LittleEndian}
+	b.section()
+	b.iface(layers.LinkTypeEthernet, "eth0", "", 0)
+	for _, sec := range ts {
+		b.packet(0, sec*1000000, []byte{byte(sec)})
+	}
+	require.NoError(t, os.WriteFile(path, b.buf.Bytes(), 0644))
+}
+
+func readAll(t *testing.T, h *fileSetHandler) []byte {
+	var packets []byte
+	for {
+		data, ci, err := h.ReadPacketData()
+		if err == io.EOF {
+			return packets
+		}
+		require.NoError(t, err)
+		assert.Equal(t, 0, ci.InterfaceIndex)
+		packets = append(packets, data...)
+	}
+}
+
+func TestFileSetHandler(t *testing.T) {
+	dir := t.TempDir()
+	writeCaptureFile(t, filepath.Join(dir, "a.pcapng"), 20, 21)
+	writeCaptureFile(t, filepath.Join(dir, "b.pcapng"), 10, 11)
+	writeCaptureFile(t, filepath.Join(dir, "c.pcapng"), 30)
+	writeCaptureFile(t, filepath.Join(dir, ".hidden.pcapng"), 1)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "empty.pcapng"), nil, 0644))
+
+	for name, pattern := range map[string]string{
+		"directory": dir,
+		"glob":      filepath.Join(dir, "*.pcapng"),
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.True(t, isFilePattern(pattern))
+
+			h, err := newFileSetHandler(&config.InterfacesConfig{File: pattern, TopSpeed: true, Loop: 2})
+			require.NoError(t, err)
+			defer h.Close()
+
+			assert.Equal(t, layers.LinkTypeEthernet, h.LinkType())
+			assert.Equal(t, []byte{10, 11, 20, 21, 30, 10, 11, 20, 21, 30}, readAll(t, h))
+
+			// the interface of all files is the same
+			iface, ok := h.Interface(0)
+			assert.True(t, ok)
+			assert.Equal(t, "eth0", iface.Name)
+			_, ok = h.Interface(1)
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestFileSetHandler_noFiles(t *testing.T) {
+	_, err := newFileSetHandler(&config.InterfacesConfig{File: filepath.Join(t.TempDir(), "*.pcap"), TopSpeed: true})
+	assert.Error(t, err)
+}
+
+func TestFileSetHandler_watch(t *testing.T) {
+	dir := t.TempDir()
+	registry := filepath.Join(t.TempDir(), "registry.json")
+	cfg := &config.InterfacesConfig{
+		File:     filepath.Join(dir, "*.pcapng"),
+		TopSpeed: true,
+		Watch: config.FileWatchConfig{
+			Enabled:      true,
+			Interval:     10 * time.Millisecond,
+			MinAge:       time.Hour,
+			RegistryFile: registry,
+		},
+	}
+	old := time.Now().Add(-2 * time.Hour)
+	writeFile := func(name string, ts ...uint64) {
+		path := filepath.Join(dir, name)
+		writeCaptureFile(t, path, ts...)
+		require.NoError(t, os.Chtimes(path, old, old))
+	}
+	read := func(h *fileSetHandler) []byte {
+		var packets []byte
+		for timeouts := 0; timeouts < 3; {
+			data, _, err := h.ReadPacketData()
+			if err == pcap.NextErrorTimeoutExpired {
+				timeouts++
+				continue
+			}
+			require.NoError(t, err)
+			packets = append(packets, data...)
+		}
+		return packets
+	}
+
+	// watching an empty directory is fine
+	h, err := newFileSetHandler(cfg)
+	require.NoError(t, err)
+	assert.Empty(t, read(h))
+
+	writeFile("1.pcapng", 1, 2)
+	// files modified recently are deferred
+	writeCaptureFile(t, filepath.Join(dir, "2.pcapng"), 3)
+	assert.Equal(t, []byte{1, 2}, read(h))
+
+	writeFile("2.pcapng", 3)
+	assert.Equal(t, []byte{3}, read(h))
+	h.Close()
+
+	// files read before the restart are skipped
+	writeFile("3.pcapng", 4)
+	h, err = newFileSetHandler(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{4}, read(h))
+	h.Close()
+
+	// removed files are dropped from the registry
+	require.NoError(t, os.Remove(filepath.Join(dir, "1.pcapng")))
+	writeFile("4.pcapng", 5)
+	h, err = newFileSetHandler(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{5}, read(h))
+	h.Close()
+
+	r, err := loadFileRegistry(registry)
+	require.NoError(t, err)
+	assert.Len(t, r.files, 3)
+	assert.NotContains(t, r.files, filepath.Join(dir, "1.pcapng"))
+}
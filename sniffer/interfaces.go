@@ -0,0 +1,154 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sniffer
+
+import (
+	"sync"
+
+	"github.com/njcx/gopacket_dpdk"
+	"github.com/njcx/gopacket_dpdk/layers"
+
+	"github.com/njcx/libbeat_v7/logp"
+)
+
+// interfacesHandle is implemented by handles reading packets captured on
+// multiple interfaces, identified by CaptureInfo.InterfaceIndex.
+type interfacesHandle interface {
+	snifferHandle
+
+	HasInterfaces() bool
+	Interface(index int) (Interface, bool)
+}
+
+// InterfaceWorkerFactory constructs a worker processing the packets captured
+// on one interface recorded in a capture file.
+type InterfaceWorkerFactory func(Interface) (Worker, error)
+
+// interfaceDemux forwards packets to one worker per capture interface. The
+// worker of an interface is created when its first packet is seen, with the
+// link type of the interface. Packets of interfaces no worker can be
+// created for, e.g. because of an unsupported link type, are dropped.
+type interfaceDemux struct {
+	handle  interfacesHandle
+	factory InterfaceWorkerFactory
+	workers map[int]Worker
+}
+
+func newInterfaceDemux(handle interfacesHandle, factory InterfaceWorkerFactory) *interfaceDemux {
+	return &interfaceDemux{
+		handle:  handle,
+		factory: factory,
+		workers: map[int]Worker{},
+	}
+}
+
+func (d *interfaceDemux) OnPacket(data []byte, ci *gopacket_dpdk.CaptureInfo) {
+	w, exists := d.workers[ci.InterfaceIndex]
+	if !exists {
+		w = d.newWorker(ci.InterfaceIndex)
+		d.workers[ci.InterfaceIndex] = w
+	}
+	if w != nil {
+		w.OnPacket(data, ci)
+	}
+}
+
+func (d *interfaceDemux) newWorker(index int) Worker {
+	iface, ok := d.handle.Interface(index)
+	if !ok {
+		logp.Warn("Dropping packets of unknown interface %d", index)
+		return nil
+	}
+
+	logp.Debug("sniffer", "New capture interface %d: name=%s, link type=%s", index, iface.Name, iface.LinkType)
+	w, err := d.factory(iface)
+	if err != nil {
+		logp.Warn("Dropping packets of interface %d (%s): %v", index, iface.Name, err)
+		return nil
+	}
+	return w
+}
+
+// interfaceMap assigns indexes to the interfaces of consecutive capture files
+// or streams. Interfaces with the same name, description and link type get
+// the same index, such that their packets are processed by the same worker.
+// Plain pcap files have a single interface without name.
+type interfaceMap struct {
+	mu         sync.Mutex
+	interfaces []Interface
+	index      map[interfaceKey]int
+	local      []int // indexes of the interfaces of the current reader, -1 if unknown
+}
+
+type interfaceKey struct {
+	name, description string
+	linkType          layers.LinkType
+}
+
+// reset is called when switching to the next reader.
+func (m *interfaceMap) reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.local = m.local[:0]
+}
+
+// lookup maps the index of an interface of the current reader r to its
+// index among all readers.
+func (m *interfaceMap) lookup(r fileReader, local int) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if local < 0 {
+		local = 0
+	}
+	if local < len(m.local) && m.local[local] >= 0 {
+		return m.local[local]
+	}
+	for len(m.local) <= local {
+		m.local = append(m.local, -1)
+	}
+
+	iface := Interface{LinkType: r.LinkType()}
+	if ng, ok := r.(*pcapngReader); ok {
+		iface, _ = ng.Interface(local)
+	}
+	key := interfaceKey{name: iface.Name, description: iface.Description, linkType: iface.LinkType}
+	if m.index == nil {
+		m.index = map[interfaceKey]int{}
+	}
+	idx, exists := m.index[key]
+	if !exists {
+		idx = len(m.interfaces)
+		iface.Index = idx
+		m.interfaces = append(m.interfaces, iface)
+		m.index[key] = idx
+	}
+	m.local[local] = idx
+	return idx
+}
+
+// Interface returns the interface with the given index.
+func (m *interfaceMap) Interface(index int) (Interface, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if index < 0 || index >= len(m.interfaces) {
+		return Interface{}, false
+	}
+	return m.interfaces[index], true
+}
@@ -0,0 +1,42 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sniffer
+
+import (
+	"github.com/njcx/gopacket_dpdk/pcap"
+)
+
+// pcapHandle adds capture statistics to a live pcap handle.
+type pcapHandle struct {
+	*pcap.Handle
+}
+
+// Stats reports the statistics of the pcap handle. libpcap counters are 32
+// bit wide on most platforms and wrap around on busy links.
+func (h pcapHandle) Stats() (captureStats, error) {
+	st, err := h.Handle.Stats()
+	if err != nil {
+		return captureStats{}, err
+	}
+
+	return captureStats{
+		received:  uint64(st.PacketsReceived),
+		dropped:   uint64(st.PacketsDropped),
+		ifDropped: uint64(st.PacketsIfDropped),
+	}, nil
+}
@@ -0,0 +1,454 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sniffer
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/bits"
+	"os"
+	"time"
+
+	"github.com/njcx/gopacket_dpdk"
+	"github.com/njcx/gopacket_dpdk/layers"
+)
+
+// pcapng block types
+const (
+	pcapngSectionHeader        = 0x0A0D0D0A
+	pcapngInterfaceDescription = 0x00000001
+	pcapngPacket               = 0x00000002 // obsolete
+	pcapngSimplePacket         = 0x00000003
+	pcapngEnhancedPacket       = 0x00000006
+
+	pcapngByteOrderMagic = 0x1A2B3C4D
+	pcapngMaxBlockSize   = 64 << 20
+)
+
+// pcapng option codes
+const (
+	pcapngOptEnd         = 0
+	pcapngOptComment     = 1
+	pcapngOptIfName      = 2
+	pcapngOptIfDesc      = 3
+	pcapngOptShbOS       = 3
+	pcapngOptShbUserAppl = 4
+	pcapngOptIfTsResol   = 9
+	pcapngOptIfTsOffset  = 14
+	pcapngDefaultTsResol = 6
+)
+
+var errPcapngFormat = errors.New("invalid pcapng file")
+
+// Interface describes an interface packets have been captured on, as
+// recorded in an Interface Description Block of a pcapng file. Index is
+// unique within the file, also if it holds multiple sections.
+type Interface struct {
+	Index       int
+	Name        string
+	Description string
+	Comment     string
+	LinkType    layers.LinkType
+	Snaplen     int
+}
+
+// PacketComment is a comment attached to a packet in a pcapng file. Comments
+// are passed in the AncillaryData of the packet's CaptureInfo.
+type PacketComment string
+
+type pcapngInterface struct {
+	Interface
+
+	tsPow10  bool  // resolution is a power of 10, not 2
+	tsResol  uint  // negative exponent of the resolution
+	tsOffset int64 // seconds added to all timestamps
+}
+
+// pcapngReader reads pcapng files. Packets of all interfaces are returned,
+// with CaptureInfo.InterfaceIndex identifying the interface and timestamps
+// in the resolution of the interface.
+type pcapngReader struct {
+	c     io.Closer
+	r     *bufio.Reader
+	order binary.ByteOrder
+
+	interfaces  []*pcapngInterface // all interfaces seen so far
+	sectionBase int                // index of the first interface of the current section
+
+	block   []byte
+	pending bool // block holds a block not processed yet
+	typ     uint32
+}
+
+// isPcapng checks the magic number of file for a pcapng section header.
+func isPcapng(file string) (bool, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	var magic [4]byte
+	if _, err := io.ReadFull(f, magic[:]); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return false, nil
+		}
+		return false, err
+	}
+	return binary.LittleEndian.Uint32(magic[:]) == pcapngSectionHeader, nil
+}
+
+func openPcapng(file string) (*pcapngReader, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := newPcapngReader(bufio.NewReaderSize(f, 1<<16), f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return r, nil
+}
+
+// newPcapngReader reads a pcapng stream from r. c is closed by Close.
+func newPcapngReader(r *bufio.Reader, c io.Closer) (*pcapngReader, error) {
+	ng := &pcapngReader{c: c, r: r, order: binary.LittleEndian}
+
+	// read the section header and the interface descriptions following it,
+	// such that the link type of the first interface is known
+	for {
+		typ, err := ng.readBlock()
+		if err != nil {
+			if err == io.EOF {
+				return nil, fmt.Errorf("%v: no interface description found", errPcapngFormat)
+			}
+			return nil, err
+		}
+		if typ != pcapngSectionHeader && typ != pcapngInterfaceDescription {
+			ng.pending = true
+			break
+		}
+		if err := ng.processBlock(typ); err != nil {
+			return nil, err
+		}
+	}
+	if len(ng.interfaces) == 0 {
+		return nil, fmt.Errorf("%v: no interface description found", errPcapngFormat)
+	}
+	return ng, nil
+}
+
+// readBlock reads the next block into r.block, starting with the block body.
+func (r *pcapngReader) readBlock() (uint32, error) {
+	var hdr [12]byte
+	if _, err := io.ReadFull(r.r, hdr[:8]); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return 0, errPcapngFormat
+		}
+		return 0, err
+	}
+
+	typ := r.order.Uint32(hdr[:4])
+	hdrLen := 8
+	if typ == pcapngSectionHeader {
+		// the byte order of a section is given by its header
+		if _, err := io.ReadFull(r.r, hdr[8:12]); err != nil {
+			return 0, errPcapngFormat
+		}
+		switch {
+		case binary.LittleEndian.Uint32(hdr[8:12]) == pcapngByteOrderMagic:
+			r.order = binary.LittleEndian
+		case binary.BigEndian.Uint32(hdr[8:12]) == pcapngByteOrderMagic:
+			r.order = binary.BigEndian
+		default:
+			return 0, fmt.Errorf("%v: unknown byte order magic", errPcapngFormat)
+		}
+		hdrLen = 12
+	}
+
+	length := int(r.order.Uint32(hdr[4:8]))
+	if length < 12 || length%4 != 0 || length > pcapngMaxBlockSize || length < hdrLen+4 {
+		return 0, fmt.Errorf("%v: invalid block length %d", errPcapngFormat, length)
+	}
+
+	// body and trailing block length
+	n := length - hdrLen
+	if cap(r.block) < n {
+		r.block = make([]byte, n)
+	}
+	r.block = r.block[:n]
+	if _, err := io.ReadFull(r.r, r.block); err != nil {
+		return 0, errPcapngFormat
+	}
+	r.block = r.block[:n-4]
+	r.typ = typ
+	return typ, nil
+}
+
+// processBlock handles section headers and interface descriptions.
+func (r *pcapngReader) processBlock(typ uint32) error {
+	switch typ {
+	case pcapngSectionHeader:
+		// interface ids are local to a section
+		r.sectionBase = len(r.interfaces)
+	case pcapngInterfaceDescription:
+		return r.addInterface(r.block)
+	}
+	return nil
+}
+
+func (r *pcapngReader) addInterface(b []byte) error {
+	if len(b) < 8 {
+		return fmt.Errorf("%v: short interface description", errPcapngFormat)
+	}
+
+	iface := &pcapngInterface{
+		Interface: Interface{
+			Index:    len(r.interfaces),
+			LinkType: layers.LinkType(r.order.Uint16(b[0:2])),
+			Snaplen:  int(r.order.Uint32(b[4:8])),
+		},
+		tsPow10: true,
+		tsResol: pcapngDefaultTsResol,
+	}
+	err := r.walkOptions(b[8:], func(code uint16, value []byte) error {
+		switch code {
+		case pcapngOptComment:
+			iface.Comment = string(value)
+		case pcapngOptIfName:
+			iface.Name = string(value)
+		case pcapngOptIfDesc:
+			iface.Description = string(value)
+		case pcapngOptIfTsResol:
+			if len(value) < 1 {
+				return fmt.Errorf("%v: invalid if_tsresol", errPcapngFormat)
+			}
+			iface.tsPow10 = value[0]&0x80 == 0
+			iface.tsResol = uint(value[0] & 0x7f)
+			if (iface.tsPow10 && iface.tsResol > 19) || (!iface.tsPow10 && iface.tsResol > 63) {
+				return fmt.Errorf("%v: unsupported timestamp resolution 0x%x", errPcapngFormat, value[0])
+			}
+		case pcapngOptIfTsOffset:
+			if len(value) < 8 {
+				return fmt.Errorf("%v: invalid if_tsoffset", errPcapngFormat)
+			}
+			iface.tsOffset = int64(r.order.Uint64(value))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	r.interfaces = append(r.interfaces, iface)
+	return nil
+}
+
+func (r *pcapngReader) walkOptions(b []byte, fn func(code uint16, value []byte) error) error {
+	for len(b) >= 4 {
+		code := r.order.Uint16(b[0:2])
+		length := int(r.order.Uint16(b[2:4]))
+		if code == pcapngOptEnd {
+			return nil
+		}
+		padded := (length + 3) &^ 3
+		if 4+padded > len(b) {
+			return fmt.Errorf("%v: option exceeds block", errPcapngFormat)
+		}
+		if err := fn(code, b[4:4+length]); err != nil {
+			return err
+		}
+		b = b[4+padded:]
+	}
+	return nil
+}
+
+func (r *pcapngReader) ReadPacketData() (data []byte, ci gopacket_dpdk.CaptureInfo, err error) {
+	for {
+		typ := r.typ
+		if r.pending {
+			r.pending = false
+		} else if typ, err = r.readBlock(); err != nil {
+			return nil, ci, err
+		}
+
+		switch typ {
+		case pcapngSectionHeader, pcapngInterfaceDescription:
+			if err := r.processBlock(typ); err != nil {
+				return nil, ci, err
+			}
+		case pcapngEnhancedPacket:
+			return r.enhancedPacket(r.block)
+		case pcapngSimplePacket:
+			return r.simplePacket(r.block)
+		case pcapngPacket:
+			return r.obsoletePacket(r.block)
+		}
+		// other blocks, e.g. name resolution or statistics, are skipped
+	}
+}
+
+func (r *pcapngReader) sectionInterface(id uint32) (*pcapngInterface, error) {
+	idx := r.sectionBase + int(id)
+	if int(id) < 0 || idx >= len(r.interfaces) {
+		return nil, fmt.Errorf("%v: packet references unknown interface %d", errPcapngFormat, id)
+	}
+	return r.interfaces[idx], nil
+}
+
+func (r *pcapngReader) enhancedPacket(b []byte) ([]byte, gopacket_dpdk.CaptureInfo, error) {
+	var ci gopacket_dpdk.CaptureInfo
+	if len(b) < 20 {
+		return nil, ci, fmt.Errorf("%v: short enhanced packet block", errPcapngFormat)
+	}
+
+	iface, err := r.sectionInterface(r.order.Uint32(b[0:4]))
+	if err != nil {
+		return nil, ci, err
+	}
+	ts := uint64(r.order.Uint32(b[4:8]))<<32 | uint64(r.order.Uint32(b[8:12]))
+	caplen := int(r.order.Uint32(b[12:16]))
+	length := int(r.order.Uint32(b[16:20]))
+	padded := (caplen + 3) &^ 3
+	if caplen < 0 || 20+padded > len(b) {
+		return nil, ci, fmt.Errorf("%v: packet exceeds block", errPcapngFormat)
+	}
+
+	ci = gopacket_dpdk.CaptureInfo{
+		Timestamp:      iface.timestamp(ts),
+		CaptureLength:  caplen,
+		Length:         length,
+		InterfaceIndex: iface.Index,
+	}
+	err = r.walkOptions(b[20+padded:], func(code uint16, value []byte) error {
+		if code == pcapngOptComment {
+			ci.AncillaryData = append(ci.AncillaryData, PacketComment(value))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, ci, err
+	}
+
+	data := make([]byte, caplen)
+	copy(data, b[20:20+caplen])
+	return data, ci, nil
+}
+
+// simplePacket reads a simple packet block. Simple packets have been
+// captured on the first interface of the section and carry no timestamp.
+func (r *pcapngReader) simplePacket(b []byte) ([]byte, gopacket_dpdk.CaptureInfo, error) {
+	var ci gopacket_dpdk.CaptureInfo
+	if len(b) < 4 {
+		return nil, ci, fmt.Errorf("%v: short simple packet block", errPcapngFormat)
+	}
+
+	iface, err := r.sectionInterface(0)
+	if err != nil {
+		return nil, ci, err
+	}
+	length := int(r.order.Uint32(b[0:4]))
+	caplen := len(b) - 4
+	if length < caplen {
+		caplen = length
+	}
+	if iface.Snaplen > 0 && iface.Snaplen < caplen {
+		caplen = iface.Snaplen
+	}
+
+	ci = gopacket_dpdk.CaptureInfo{
+		CaptureLength:  caplen,
+		Length:         length,
+		InterfaceIndex: iface.Index,
+	}
+	data := make([]byte, caplen)
+	copy(data, b[4:4+caplen])
+	return data, ci, nil
+}
+
+func (r *pcapngReader) obsoletePacket(b []byte) ([]byte, gopacket_dpdk.CaptureInfo, error) {
+	var ci gopacket_dpdk.CaptureInfo
+	if len(b) < 20 {
+		return nil, ci, fmt.Errorf("%v: short packet block", errPcapngFormat)
+	}
+
+	iface, err := r.sectionInterface(uint32(r.order.Uint16(b[0:2])))
+	if err != nil {
+		return nil, ci, err
+	}
+	ts := uint64(r.order.Uint32(b[4:8]))<<32 | uint64(r.order.Uint32(b[8:12]))
+	caplen := int(r.order.Uint32(b[12:16]))
+	length := int(r.order.Uint32(b[16:20]))
+	if caplen < 0 || 20+caplen > len(b) {
+		return nil, ci, fmt.Errorf("%v: packet exceeds block", errPcapngFormat)
+	}
+
+	ci = gopacket_dpdk.CaptureInfo{
+		Timestamp:      iface.timestamp(ts),
+		CaptureLength:  caplen,
+		Length:         length,
+		InterfaceIndex: iface.Index,
+	}
+	data := make([]byte, caplen)
+	copy(data, b[20:20+caplen])
+	return data, ci, nil
+}
+
+// timestamp converts a timestamp in units of the interface's resolution,
+// keeping nanosecond precision.
+func (i *pcapngInterface) timestamp(ts uint64) time.Time {
+	var sec, frac, nsec uint64
+	if i.tsPow10 {
+		units := uint64(1)
+		for n := uint(0); n < i.tsResol; n++ {
+			units *= 10
+		}
+		sec, frac = ts/units, ts%units
+		hi, lo := bits.Mul64(frac, uint64(time.Second))
+		nsec, _ = bits.Div64(hi, lo, units)
+	} else if i.tsResol == 0 {
+		sec = ts
+	} else {
+		sec, frac = ts>>i.tsResol, ts&(1<<i.tsResol-1)
+		hi, lo := bits.Mul64(frac, uint64(time.Second))
+		nsec = hi<<(64-i.tsResol) | lo>>i.tsResol
+	}
+	return time.Unix(int64(sec)+i.tsOffset, int64(nsec))
+}
+
+// Interface returns the description of the interface with the given index.
+func (r *pcapngReader) Interface(index int) (Interface, bool) {
+	if index < 0 || index >= len(r.interfaces) {
+		return Interface{}, false
+	}
+	return r.interfaces[index].Interface, true
+}
+
+// LinkType returns the link type of the first interface.
+func (r *pcapngReader) LinkType() layers.LinkType {
+	return r.interfaces[0].LinkType
+}
+
+func (r *pcapngReader) Close() {
+	r.c.Close()
+}
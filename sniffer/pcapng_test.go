@@ -0,0 +1,222 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !integration
+// +build !integration
+
+package sniffer
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/njcx/gopacket_dpdk"
+	"github.com/njcx/gopacket_dpdk/layers"
+)
+
+type pcapngBuilder struct {
+	order binary.ByteOrder
+	buf   bytes.Buffer
+}
+
+func (b *pcapngBuilder) option(body *bytes.Buffer, code uint16, value []byte) {
+	binary.Write(body, b.order, code)
+	binary.Write(body, b.order, uint16(len(value)))
+	body.Write(value)
+	body.Write(make([]byte, (4-len(value)%4)%4))
+}
+
+func (b *pcapngBuilder) block(typ uint32, body []byte) {
+	length := uint32(12 + len(body))
+	binary.Write(&b.buf, b.order, typ)
+	binary.Write(&b.buf, b.order, length)
+	b.buf.Write(body)
+	binary.Write(&b.buf, b.order, length)
+}
+
+func (b *pcapngBuilder) section() {
+	var body bytes.Buffer
+	binary.Write(&body, b.order, uint32(pcapngByteOrderMagic))
+	binary.Write(&body, b.order, uint16(1))
+	binary.Write(&body, b.order, uint16(0))
+	binary.Write(&body, b.order, int64(-1))
+	b.block(pcapngSectionHeader, body.Bytes())
+}
+
+func (b *pcapngBuilder) iface(linkType layers.LinkType, name, desc string, tsresol byte) {
+	var body bytes.Buffer
+	binary.Write(&body, b.order, uint16(linkType))
+	binary.Write(&body, b.order, uint16(0))
+	binary.Write(&body, b.order, uint32(65535))
+	if name != "" {
+		b.option(&body, pcapngOptIfName, []byte(name))
+	}
+	if desc != "" {
+		b.option(&body, pcapngOptIfDesc, []byte(desc))
+	}
+	if tsresol != 0 {
+		b.option(&body, pcapngOptIfTsResol, []byte{tsresol})
+	}
+	b.option(&body, pcapngOptEnd, nil)
+	b.block(pcapngInterfaceDescription, body.Bytes())
+}
+
+func (b *pcapngBuilder) packet(iface uint32, ts uint64, data []byte, comments ...string) {
+	var body bytes.Buffer
+	binary.Write(&body, b.order, iface)
+	binary.Write(&body, b.order, uint32(ts>>32))
+	binary.Write(&body, b.order, uint32(ts))
+	binary.Write(&body, b.order, uint32(len(data)))
+	binary.Write(&body, b.order, uint32(len(data)+10))
+	body.Write(data)
+	body.Write(make([]byte, (4-len(data)%4)%4))
+	for _, c := range comments {
+		b.option(&body, pcapngOptComment, []byte(c))
+	}
+	if len(comments) > 0 {
+		b.option(&body, pcapngOptEnd, nil)
+	}
+	b.block(pcapngEnhancedPacket, body.Bytes())
+}
+
+func (b *pcapngBuilder) write(t *testing.T) string {
+	file := filepath.Join(t.TempDir(), "test.pcapng")
+	require.NoError(t, os.WriteFile(file, b.buf.Bytes(), 0644))
+	return file
+}
+
+func TestPcapngReader(t *testing.T) {
+	for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		t.Run(order.String(), func(t *testing.T) {
+			b := &pcapngBuilder{order: order}
+			b.section()
+			b.iface(layers.LinkTypeEthernet, "eth0", "uplink", 9)
+			b.iface(layers.LinkTypeLinuxSLL, "any", "", 0)
+			b.packet(0, 1600000000123456789, []byte{1, 2, 3}, "first", "retransmission")
+			b.packet(1, 1600000001500000, []byte{4, 5, 6, 7, 8})
+			// interface ids restart with every section
+			b.section()
+			b.iface(layers.LinkTypeNull, "lo0", "", 0x80|10)
+			b.packet(0, 3<<10|512, []byte{9})
+			file := b.write(t)
+
+			ng, err := isPcapng(file)
+			require.NoError(t, err)
+			assert.True(t, ng)
+
+			r, err := openPcapng(file)
+			require.NoError(t, err)
+			defer r.Close()
+			assert.Equal(t, layers.LinkTypeEthernet, r.LinkType())
+
+			data, ci, err := r.ReadPacketData()
+			require.NoError(t, err)
+			assert.Equal(t, []byte{1, 2, 3}, data)
+			assert.Equal(t, 0, ci.InterfaceIndex)
+			assert.Equal(t, 3, ci.CaptureLength)
+			assert.Equal(t, 13, ci.Length)
+			assert.Equal(t, time.Unix(1600000000, 123456789), ci.Timestamp)
+			assert.Equal(t, []interface{}{PacketComment("first"), PacketComment("retransmission")}, ci.AncillaryData)
+
+			data, ci, err = r.ReadPacketData()
+			require.NoError(t, err)
+			assert.Equal(t, []byte{4, 5, 6, 7, 8}, data)
+			assert.Equal(t, 1, ci.InterfaceIndex)
+			assert.Equal(t, time.Unix(1600000001, 500000000), ci.Timestamp)
+			assert.Empty(t, ci.AncillaryData)
+
+			data, ci, err = r.ReadPacketData()
+			require.NoError(t, err)
+			assert.Equal(t, []byte{9}, data)
+			assert.Equal(t, 2, ci.InterfaceIndex)
+			assert.Equal(t, time.Unix(3, 500000000), ci.Timestamp)
+
+			_, _, err = r.ReadPacketData()
+			assert.Equal(t, io.EOF, err)
+
+			iface, ok := r.Interface(0)
+			assert.True(t, ok)
+			assert.Equal(t, Interface{Index: 0, Name: "eth0", Description: "uplink", LinkType: layers.LinkTypeEthernet, Snaplen: 65535}, iface)
+			iface, ok = r.Interface(2)
+			assert.True(t, ok)
+			assert.Equal(t, "lo0", iface.Name)
+			assert.Equal(t, layers.LinkTypeNull, iface.LinkType)
+			_, ok = r.Interface(3)
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestPcapngReader_unknownInterface(t *testing.T) {
+	b := &pcapngBuilder{order: binary.LittleEndian}
+	b.section()
+	b.iface(layers.LinkTypeEthernet, "eth0", "", 0)
+	b.packet(1, 0, []byte{1})
+
+	r, err := openPcapng(b.write(t))
+	require.NoError(t, err)
+	defer r.Close()
+
+	_, _, err = r.ReadPacketData()
+	assert.Error(t, err)
+}
+
+type testInterfacesHandle struct {
+	batchTestHandle
+	interfaces []Interface
+}
+
+func (h *testInterfacesHandle) HasInterfaces() bool { return true }
+
+func (h *testInterfacesHandle) Interface(index int) (Interface, bool) {
+	if index < 0 || index >= len(h.interfaces) {
+		return Interface{}, false
+	}
+	return h.interfaces[index], true
+}
+
+func TestInterfaceDemux(t *testing.T) {
+	handle := &testInterfacesHandle{interfaces: []Interface{
+		{Index: 0, Name: "eth0", LinkType: layers.LinkTypeEthernet},
+		{Index: 1, Name: "wlan0", LinkType: layers.LinkTypeIEEE802_11},
+	}}
+	workers := map[string]*testWorker{}
+	demux := newInterfaceDemux(handle, func(iface Interface) (Worker, error) {
+		if iface.LinkType != layers.LinkTypeEthernet {
+			return nil, errors.New("unsupported link type")
+		}
+		w := &testWorker{}
+		workers[iface.Name] = w
+		return w, nil
+	})
+
+	for _, idx := range []int{0, 1, 0, 2} {
+		demux.OnPacket([]byte{byte(idx)}, &gopacket_dpdk.CaptureInfo{InterfaceIndex: idx})
+	}
+
+	require.Len(t, workers, 1)
+	assert.Equal(t, [][]byte{{0}, {0}}, workers["eth0"].packets)
+}
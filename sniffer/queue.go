@@ -0,0 +1,179 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sniffer
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/njcx/gopacket_dpdk"
+
+	"github.com/njcx/packetbeat7_dpdk/config"
+)
+
+// decodeQueue decouples reading packets from decoding them. The queue is
+// the Worker of the reading goroutine. Packets are copied into a bounded
+// queue, as handles reuse their buffers for the next read, and passed to the
+// actual worker by a goroutine of its own. The copies are not reused, as
+// protocol parsers may keep the payload of a packet. Packets arriving while
+// the queue is full are dropped.
+type decodeQueue struct {
+	worker      Worker
+	batchWorker BatchWorker
+
+	packets chan queuedPacket
+	done    chan struct{}
+
+	highWater uint64 // accessed atomically
+	dropped   uint64 // accessed atomically
+}
+
+type queuedPacket struct {
+	data []byte
+	ci   gopacket_dpdk.CaptureInfo
+}
+
+// queueStats holds the statistics of the decode queues of a handle.
+type queueStats struct {
+	depth     uint64 // packets currently queued
+	highWater uint64 // maximum number of packets queued
+	dropped   uint64 // packets dropped, because the queue was full
+}
+
+func validateDecodeQueueConfig(cfg *config.DecodeQueueConfig) error {
+	if cfg.Size < 0 {
+		return fmt.Errorf("decode queue size must not be negative, got %d", cfg.Size)
+	}
+	return nil
+}
+
+// newDecodeQueue creates a queue of size packets and starts passing the
+// packets queued to worker.
+func newDecodeQueue(worker Worker, size int) *decodeQueue {
+	q := &decodeQueue{
+		worker:  worker,
+		packets: make(chan queuedPacket, size),
+		done:    make(chan struct{}),
+	}
+	q.batchWorker, _ = worker.(BatchWorker)
+
+	go q.run()
+	return q
+}
+
+// OnPacket queues a copy of the packet. OnPacket must not be called
+// concurrently.
+func (q *decodeQueue) OnPacket(data []byte, ci *gopacket_dpdk.CaptureInfo) {
+	// the queue has a single producer, a queue not being full can not
+	// become full before the packet is sent
+	if len(q.packets) == cap(q.packets) {
+		atomic.AddUint64(&q.dropped, 1)
+		return
+	}
+
+	q.packets <- queuedPacket{data: append([]byte(nil), data...), ci: *ci}
+
+	depth := uint64(len(q.packets))
+	if depth > atomic.LoadUint64(&q.highWater) {
+		atomic.StoreUint64(&q.highWater, depth)
+	}
+}
+
+// OnPackets queues copies of a batch of packets.
+func (q *decodeQueue) OnPackets(data [][]byte, ci []gopacket_dpdk.CaptureInfo) {
+	for i := range data {
+		q.OnPacket(data[i], &ci[i])
+	}
+}
+
+// Close waits for the packets queued to be decoded. No packets must be
+// queued once Close has been called.
+func (q *decodeQueue) Close() {
+	close(q.packets)
+	<-q.done
+}
+
+func (q *decodeQueue) stats() queueStats {
+	return queueStats{
+		depth:     uint64(len(q.packets)),
+		highWater: atomic.LoadUint64(&q.highWater),
+		dropped:   atomic.LoadUint64(&q.dropped),
+	}
+}
+
+// run passes the queued packets to the worker. Workers processing batches
+// get the packets queued at once, up to maxBatchSize.
+func (q *decodeQueue) run() {
+	defer close(q.done)
+
+	if q.batchWorker == nil {
+		for p := range q.packets {
+			q.worker.OnPacket(p.data, &p.ci)
+		}
+		return
+	}
+
+	data := make([][]byte, 0, maxBatchSize)
+	ci := make([]gopacket_dpdk.CaptureInfo, 0, maxBatchSize)
+	for p := range q.packets {
+		data, ci = append(data[:0], p.data), append(ci[:0], p.ci)
+	batch:
+		for len(data) < maxBatchSize {
+			select {
+			case p, ok := <-q.packets:
+				if !ok {
+					break batch
+				}
+				data, ci = append(data, p.data), append(ci, p.ci)
+			default:
+				break batch
+			}
+		}
+
+		q.batchWorker.OnPackets(data, ci)
+	}
+}
+
+// queueStatsHandle adds the statistics of the decode queues to the
+// statistics of a handle, if the handle reports any.
+type queueStatsHandle struct {
+	handle statsHandle
+	queues []*decodeQueue
+}
+
+func (h queueStatsHandle) Stats() (captureStats, error) {
+	var stats captureStats
+	if h.handle != nil {
+		var err error
+		if stats, err = h.handle.Stats(); err != nil {
+			return stats, err
+		}
+	}
+
+	total := queueStats{}
+	for _, q := range h.queues {
+		qs := q.stats()
+		total.depth += qs.depth
+		total.dropped += qs.dropped
+		if qs.highWater > total.highWater {
+			total.highWater = qs.highWater
+		}
+	}
+	stats.queue = &total
+	return stats, nil
+}
@@ -0,0 +1,126 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !integration
+// +build !integration
+
+package sniffer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/njcx/gopacket_dpdk"
+)
+
+// blockingWorker keeps the packets passed, once unblocked.
+type blockingWorker struct {
+	testWorker
+	entered chan struct{}
+	unblock chan struct{}
+}
+
+func (w *blockingWorker) OnPacket(data []byte, ci *gopacket_dpdk.CaptureInfo) {
+	w.entered <- struct{}{}
+	<-w.unblock
+	w.testWorker.OnPacket(data, ci)
+}
+
+func TestDecodeQueue(t *testing.T) {
+	worker := &blockingWorker{entered: make(chan struct{}, 4), unblock: make(chan struct{})}
+	q := newDecodeQueue(worker, 2)
+
+	// the packet buffer is reused by the reader
+	buf := []byte{1}
+	q.OnPacket(buf, &gopacket_dpdk.CaptureInfo{})
+	<-worker.entered
+	for i := byte(2); i <= 4; i++ {
+		buf[0] = i
+		q.OnPacket(buf, &gopacket_dpdk.CaptureInfo{})
+	}
+
+	// the worker holds the first packet, two are queued and the last one
+	// is dropped
+	assert.Equal(t, queueStats{depth: 2, highWater: 2, dropped: 1}, q.stats())
+
+	close(worker.unblock)
+	q.Close()
+
+	assert.Equal(t, [][]byte{{1}, {2}, {3}}, worker.packets)
+	assert.Equal(t, uint64(0), q.stats().depth)
+}
+
+// signallingWorker keeps the packets passed, like protocol parsers keeping
+// payloads, and signals each packet processed.
+type signallingWorker struct {
+	testWorker
+	done chan struct{}
+}
+
+func (w *signallingWorker) OnPacket(data []byte, ci *gopacket_dpdk.CaptureInfo) {
+	w.testWorker.OnPacket(data, ci)
+	w.done <- struct{}{}
+}
+
+func TestDecodeQueue_workerKeepsPackets(t *testing.T) {
+	worker := &signallingWorker{done: make(chan struct{})}
+	q := newDecodeQueue(worker, 1)
+
+	// the packets queued after the first one has been processed must not
+	// overwrite it
+	buf := make([]byte, 1)
+	var want [][]byte
+	for i := byte(1); i <= 8; i++ {
+		buf[0] = i
+		q.OnPacket(buf, &gopacket_dpdk.CaptureInfo{})
+		<-worker.done
+		want = append(want, []byte{i})
+	}
+	q.Close()
+
+	assert.Equal(t, want, worker.packets)
+}
+
+func TestDecodeQueue_batches(t *testing.T) {
+	worker := &testBatchWorker{}
+	q := newDecodeQueue(worker, 16)
+	q.OnPackets([][]byte{{1}, {2}}, make([]gopacket_dpdk.CaptureInfo, 2))
+	q.OnPacket([]byte{3}, &gopacket_dpdk.CaptureInfo{})
+	q.Close()
+
+	assert.Equal(t, [][]byte{{1}, {2}, {3}}, worker.packets)
+	assert.True(t, worker.batches >= 1 && worker.batches <= 3)
+	assert.Equal(t, uint64(0), q.stats().dropped)
+}
+
+func TestQueueStatsHandle(t *testing.T) {
+	handle := &testStatsHandle{stats: captureStats{received: 10}}
+	q1 := &decodeQueue{packets: make(chan queuedPacket, 4), highWater: 3, dropped: 1}
+	q2 := &decodeQueue{packets: make(chan queuedPacket, 4), highWater: 4, dropped: 2}
+	q2.packets <- queuedPacket{}
+
+	stats, err := queueStatsHandle{handle: handle, queues: []*decodeQueue{q1, q2}}.Stats()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(10), stats.received)
+	assert.Equal(t, &queueStats{depth: 1, highWater: 4, dropped: 3}, stats.queue)
+
+	stats, err = queueStatsHandle{queues: []*decodeQueue{q1}}.Stats()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), stats.received)
+	assert.Equal(t, &queueStats{highWater: 3, dropped: 1}, stats.queue)
+}
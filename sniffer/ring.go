@@ -0,0 +1,208 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sniffer
+
+import (
+	"bufio"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/njcx/gopacket_dpdk"
+	"github.com/njcx/gopacket_dpdk/layers"
+
+	"github.com/njcx/packetbeat7_dpdk/decoder"
+)
+
+// PacketRing keeps copies of the packets captured recently in memory. Packets
+// are dropped once they are older than the maximum age, relative to the
+// newest packet, or once the packets kept exceed the maximum size. The
+// packets of a connection can be written to a pcapng file on demand.
+// Tunneled packets are matched by the connection they carry, like the
+// decoder reports them.
+type PacketRing struct {
+	mu sync.Mutex
+
+	maxAge  time.Duration
+	maxSize int
+	ports   decoder.TunnelPorts
+
+	packets []ringPacket
+	head    int // index of the oldest packet
+	size    int
+}
+
+type ringPacket struct {
+	iface Interface
+	ci    gopacket_dpdk.CaptureInfo
+	data  []byte
+}
+
+// Connection identifies the packets exchanged between two endpoints, in
+// either direction. Zero ports match packets of any port and an empty
+// transport matches packets of any transport protocol.
+type Connection struct {
+	Transport    string // tcp, udp, icmp or ipv6-icmp
+	IP1, IP2     net.IP
+	Port1, Port2 uint16
+}
+
+// NewPacketRing creates a ring keeping the packets of the last maxAge, up to
+// maxSize bytes. ports are the UDP tunnel ports of the decoder, nil if
+// tunnels are not decapsulated.
+func NewPacketRing(maxAge time.Duration, maxSize int, ports decoder.TunnelPorts) *PacketRing {
+	return &PacketRing{maxAge: maxAge, maxSize: maxSize, ports: ports}
+}
+
+// Add stores a copy of the packet captured on iface.
+func (r *PacketRing) Add(iface Interface, data []byte, ci *gopacket_dpdk.CaptureInfo) {
+	p := ringPacket{iface: iface, ci: *ci, data: append([]byte(nil), data...)}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.packets = append(r.packets, p)
+	r.size += len(p.data)
+	for r.head < len(r.packets)-1 {
+		oldest := &r.packets[r.head]
+		if r.size <= r.maxSize && ci.Timestamp.Sub(oldest.ci.Timestamp) <= r.maxAge {
+			break
+		}
+		r.size -= len(oldest.data)
+		*oldest = ringPacket{}
+		r.head++
+	}
+
+	// reuse the space of dropped packets once half of the slice is unused
+	if r.head > len(r.packets)/2 {
+		n := copy(r.packets, r.packets[r.head:])
+		for i := n; i < len(r.packets); i++ {
+			r.packets[i] = ringPacket{}
+		}
+		r.packets = r.packets[:n]
+		r.head = 0
+	}
+}
+
+// WriteConnection writes the packets of conn kept in the ring to a pcapng
+// file, replacing the file if it exists. The section header records the
+// sensor name. No file is written if the ring holds no packets of conn.
+// WriteConnection returns the number of packets written.
+func (r *PacketRing) WriteConnection(path string, conn Connection, sensor string) (int, error) {
+	r.mu.Lock()
+	packets := append([]ringPacket(nil), r.packets[r.head:]...)
+	r.mu.Unlock()
+
+	matched := packets[:0]
+	for _, p := range packets {
+		if conn.matches(&p, r.ports) {
+			matched = append(matched, p)
+		}
+	}
+	if len(matched) == 0 {
+		return 0, nil
+	}
+
+	tmp := path + ".new"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return 0, err
+	}
+	w := bufio.NewWriter(f)
+	enc := &pcapngEncoder{sensor: sensor}
+	for i := range matched {
+		p := &matched[i]
+		if _, err = enc.writePacket(w, p.iface, p.data, &p.ci); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp, path)
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return 0, err
+	}
+	return len(matched), nil
+}
+
+func (c *Connection) matches(p *ringPacket, ports decoder.TunnelPorts) bool {
+	packet, ok := innerPacket(p.iface.LinkType, p.data, ports)
+	if !ok {
+		return false
+	}
+	network := packet.NetworkLayer()
+	if network == nil {
+		return false
+	}
+	src, dst := network.NetworkFlow().Endpoints()
+
+	var (
+		transport    string
+		sport, dport uint16
+	)
+	switch l := packet.TransportLayer().(type) {
+	case *layers.TCP:
+		transport, sport, dport = "tcp", uint16(l.SrcPort), uint16(l.DstPort)
+	case *layers.UDP:
+		transport, sport, dport = "udp", uint16(l.SrcPort), uint16(l.DstPort)
+	default:
+		if packet.Layer(layers.LayerTypeICMPv4) != nil {
+			transport = "icmp"
+		} else if packet.Layer(layers.LayerTypeICMPv6) != nil {
+			transport = "ipv6-icmp"
+		}
+	}
+	if c.Transport != "" && c.Transport != transport {
+		return false
+	}
+
+	return (endpointMatches(src.Raw(), sport, c.IP1, c.Port1) && endpointMatches(dst.Raw(), dport, c.IP2, c.Port2)) ||
+		(endpointMatches(src.Raw(), sport, c.IP2, c.Port2) && endpointMatches(dst.Raw(), dport, c.IP1, c.Port1))
+}
+
+// innerPacket lazily decodes the innermost IP packet of a frame,
+// decapsulated like the decoder does.
+func innerPacket(linkType layers.LinkType, data []byte, ports decoder.TunnelPorts) (gopacket_dpdk.Packet, bool) {
+	ip, ok := decoder.InnerIP(linkType, data, ports)
+	if !ok {
+		return nil, false
+	}
+	first := layers.LayerTypeIPv4
+	if ip[0]>>4 == 6 {
+		first = layers.LayerTypeIPv6
+	}
+	return gopacket_dpdk.NewPacket(ip, first, gopacket_dpdk.DecodeOptions{Lazy: true, NoCopy: true}), true
+}
+
+func endpointMatches(addr []byte, port uint16, ip net.IP, wantPort uint16) bool {
+	if wantPort != 0 && port != wantPort {
+		return false
+	}
+	if len(addr) == net.IPv4len {
+		ip = ip.To4()
+	}
+	return net.IP(addr).Equal(ip)
+}
@@ -0,0 +1,200 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !integration
+// +build !integration
+
+package sniffer
+
+import (
+	"io"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/njcx/gopacket_dpdk"
+	"github.com/njcx/gopacket_dpdk/layers"
+
+	"github.com/njcx/packetbeat7_dpdk/config"
+	"github.com/njcx/packetbeat7_dpdk/decoder"
+)
+
+var ringIface = Interface{Name: "eth0", LinkType: layers.LinkTypeEthernet, Snaplen: 65535}
+
+// tcpPacket returns an ethernet frame carrying a TCP segment between the
+// given endpoints.
+func tcpPacket(t *testing.T, src string, sport uint16, dst string, dport uint16) []byte {
+	eth := &layers.Ethernet{
+		SrcMAC:       net.HardwareAddr{0, 1, 2, 3, 4, 5},
+		DstMAC:       net.HardwareAddr{0, 1, 2, 3, 4, 6},
+		EthernetType: layers.EthernetTypeIPv4,
+	}
+	ip := &layers.IPv4{
+		Version:  4,
+		TTL:      64,
+		Protocol: layers.IPProtocolTCP,
+		SrcIP:    net.ParseIP(src).To4(),
+		DstIP:    net.ParseIP(dst).To4(),
+	}
+	tcp := &layers.TCP{SrcPort: layers.TCPPort(sport), DstPort: layers.TCPPort(dport), ACK: true}
+	require.NoError(t, tcp.SetNetworkLayerForChecksum(ip))
+
+	buf := gopacket_dpdk.NewSerializeBuffer()
+	opts := gopacket_dpdk.SerializeOptions{FixLengths: true, ComputeChecksums: true}
+	require.NoError(t, gopacket_dpdk.SerializeLayers(buf, opts, eth, ip, tcp, gopacket_dpdk.Payload("data")))
+	return buf.Bytes()
+}
+
+// vxlanPacket returns an ethernet frame carrying inner in a VXLAN tunnel
+// from 192.168.0.1 to 192.168.0.2.
+func vxlanPacket(t *testing.T, vni uint32, inner []byte) []byte {
+	eth := &layers.Ethernet{
+		SrcMAC:       net.HardwareAddr{0, 1, 2, 3, 4, 7},
+		DstMAC:       net.HardwareAddr{0, 1, 2, 3, 4, 8},
+		EthernetType: layers.EthernetTypeIPv4,
+	}
+	ip := &layers.IPv4{
+		Version:  4,
+		TTL:      64,
+		Protocol: layers.IPProtocolUDP,
+		SrcIP:    net.IPv4(192, 168, 0, 1).To4(),
+		DstIP:    net.IPv4(192, 168, 0, 2).To4(),
+	}
+	udp := &layers.UDP{SrcPort: 50000, DstPort: 4789}
+	require.NoError(t, udp.SetNetworkLayerForChecksum(ip))
+	vxlan := []byte{0x08, 0, 0, 0, byte(vni >> 16), byte(vni >> 8), byte(vni), 0}
+
+	buf := gopacket_dpdk.NewSerializeBuffer()
+	opts := gopacket_dpdk.SerializeOptions{FixLengths: true, ComputeChecksums: true}
+	require.NoError(t, gopacket_dpdk.SerializeLayers(buf, opts, eth, ip, udp, gopacket_dpdk.Payload(append(vxlan, inner...))))
+	return buf.Bytes()
+}
+
+func addToRing(r *PacketRing, data []byte, ts time.Time) {
+	r.Add(ringIface, data, &gopacket_dpdk.CaptureInfo{Timestamp: ts, CaptureLength: len(data), Length: len(data)})
+}
+
+func ringLen(r *PacketRing) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.packets) - r.head
+}
+
+func TestPacketRing_limits(t *testing.T) {
+	ts := time.Unix(1600000000, 0)
+
+	r := NewPacketRing(10*time.Second, 1000, nil)
+	for i := 0; i < 20; i++ {
+		addToRing(r, make([]byte, 10), ts.Add(time.Duration(i)*time.Second))
+	}
+	// packets of the last 10 seconds
+	assert.Equal(t, 11, ringLen(r))
+	assert.Equal(t, 110, r.size)
+
+	r = NewPacketRing(time.Minute, 100, nil)
+	for i := 0; i < 20; i++ {
+		addToRing(r, make([]byte, 30), ts)
+	}
+	assert.Equal(t, 3, ringLen(r))
+	assert.Equal(t, 90, r.size)
+
+	// the newest packet is kept even if it exceeds the size limit
+	addToRing(r, make([]byte, 200), ts)
+	assert.Equal(t, 1, ringLen(r))
+}
+
+func TestPacketRing_writeConnection(t *testing.T) {
+	ts := time.Unix(1600000000, 0)
+	request := tcpPacket(t, "10.0.0.1", 40000, "10.0.0.2", 80)
+	response := tcpPacket(t, "10.0.0.2", 80, "10.0.0.1", 40000)
+	other := tcpPacket(t, "10.0.0.1", 40001, "10.0.0.2", 80)
+
+	r := NewPacketRing(time.Minute, 1<<20, nil)
+	addToRing(r, request, ts)
+	addToRing(r, other, ts.Add(time.Millisecond))
+	addToRing(r, response, ts.Add(2*time.Millisecond))
+
+	path := filepath.Join(t.TempDir(), "conn.pcapng")
+	conn := Connection{
+		Transport: "tcp",
+		IP1:       net.ParseIP("10.0.0.2"),
+		Port1:     80,
+		IP2:       net.ParseIP("10.0.0.1"),
+		Port2:     40000,
+	}
+	n, err := r.WriteConnection(path, conn, "sensor-1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	f, err := openPcapng(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	data, ci, err := f.ReadPacketData()
+	require.NoError(t, err)
+	assert.Equal(t, request, data)
+	assert.Equal(t, ts, ci.Timestamp)
+	data, _, err = f.ReadPacketData()
+	require.NoError(t, err)
+	assert.Equal(t, response, data)
+	_, _, err = f.ReadPacketData()
+	assert.Equal(t, io.EOF, err)
+
+	// no packets of the connection, no file
+	conn.Transport = "udp"
+	path = filepath.Join(t.TempDir(), "none.pcapng")
+	n, err = r.WriteConnection(path, conn, "sensor-1")
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+	assert.Empty(t, dumpFiles(t, filepath.Dir(path)))
+}
+
+func TestPacketRing_tunneledConnection(t *testing.T) {
+	ts := time.Unix(1600000000, 0)
+	request := vxlanPacket(t, 1, tcpPacket(t, "10.0.0.1", 40000, "10.0.0.2", 80))
+	response := vxlanPacket(t, 1, tcpPacket(t, "10.0.0.2", 80, "10.0.0.1", 40000))
+	conn := Connection{
+		Transport: "tcp",
+		IP1:       net.ParseIP("10.0.0.1"),
+		Port1:     40000,
+		IP2:       net.ParseIP("10.0.0.2"),
+		Port2:     80,
+	}
+
+	// matched by the inner connection, like the decoder reports it
+	r := NewPacketRing(time.Minute, 1<<20, decoder.NewTunnelPorts(config.TunnelsConfig{}))
+	addToRing(r, request, ts)
+	addToRing(r, response, ts.Add(time.Millisecond))
+	n, err := r.WriteConnection(filepath.Join(t.TempDir(), "conn.pcapng"), conn, "sensor-1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	// matched by the outer connection if tunnels are not decapsulated
+	r = NewPacketRing(time.Minute, 1<<20, nil)
+	addToRing(r, request, ts)
+	n, err = r.WriteConnection(filepath.Join(t.TempDir(), "conn.pcapng"), conn, "sensor-1")
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+	outer := Connection{Transport: "udp", IP1: net.ParseIP("192.168.0.1"), IP2: net.ParseIP("192.168.0.2"), Port2: 4789}
+	n, err = r.WriteConnection(filepath.Join(t.TempDir(), "conn.pcapng"), outer, "sensor-1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+}
@@ -0,0 +1,203 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sniffer
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+
+	"github.com/njcx/gopacket_dpdk"
+	"github.com/njcx/gopacket_dpdk/layers"
+
+	"github.com/njcx/packetbeat7_dpdk/config"
+	"github.com/njcx/packetbeat7_dpdk/decoder"
+)
+
+// sampler decides which of the packets read from a queue are analyzed.
+// Every queue has its own sampler.
+type sampler struct {
+	flow       bool
+	rate       uint32
+	count      uint32
+	ports      decoder.TunnelPorts
+	linkTypeOf func(ci *gopacket_dpdk.CaptureInfo) layers.LinkType
+}
+
+func validateSamplingConfig(cfg *config.SamplingConfig) error {
+	if cfg.Rate < 0 {
+		return fmt.Errorf("sampling rate must not be negative, got %d", cfg.Rate)
+	}
+	switch cfg.SampleMode() {
+	case "packet", "flow":
+	default:
+		return fmt.Errorf("unknown sampling mode '%s', expected packet or flow", cfg.Mode)
+	}
+	return nil
+}
+
+// newSampler creates the sampler of the packets read from handle. It
+// returns nil if sampling is disabled.
+func (s *Sniffer) newSampler(handle snifferHandle) *sampler {
+	cfg := s.config.Sampling
+	if !cfg.Enabled() {
+		return nil
+	}
+
+	linkTypeOf := func(ci *gopacket_dpdk.CaptureInfo) layers.LinkType {
+		return handle.LinkType()
+	}
+	if ih, ok := handle.(interfacesHandle); ok && ih.HasInterfaces() {
+		linkTypeOf = func(ci *gopacket_dpdk.CaptureInfo) layers.LinkType {
+			if iface, ok := ih.Interface(ci.InterfaceIndex); ok {
+				return iface.LinkType
+			}
+			return handle.LinkType()
+		}
+	}
+	return &sampler{
+		flow:       cfg.SampleMode() == "flow",
+		rate:       uint32(cfg.Rate),
+		ports:      decoder.NewTunnelPorts(s.config.Tunnels),
+		linkTypeOf: linkTypeOf,
+	}
+}
+
+// keep returns true if the packet is to be analyzed. In flow mode, packets
+// without a 5-tuple, e.g. ARP, are always kept.
+func (s *sampler) keep(data []byte, ci *gopacket_dpdk.CaptureInfo) bool {
+	if !s.flow {
+		keep := s.count == 0
+		s.count++
+		if s.count == s.rate {
+			s.count = 0
+		}
+		return keep
+	}
+
+	hash, ok := flowHash(s.linkTypeOf(ci), data, s.ports)
+	return !ok || hash%s.rate == 0
+}
+
+// sample removes the packets not to be analyzed from a batch and returns
+// the number of packets kept at the start of data and ci.
+func (s *sampler) sample(data [][]byte, ci []gopacket_dpdk.CaptureInfo) int {
+	n := 0
+	for i := range data {
+		if !s.keep(data[i], &ci[i]) {
+			continue
+		}
+		data[n], ci[n] = data[i], ci[i]
+		n++
+	}
+	return n
+}
+
+// transport protocols whose ports are hashed by flowHash
+const (
+	ipProtoTCP  = 6
+	ipProtoUDP  = 17
+	ipProtoSCTP = 132
+)
+
+// flowHash hashes the 5-tuple of an IP packet. Both directions of a
+// connection have the same hash. Tunneled packets are hashed by the
+// connection they carry, decapsulated like the decoder does, such that a
+// connection is sampled as a whole like events report it. ports are the UDP
+// tunnel ports of the decoder, nil if tunnels are not decapsulated.
+// Fragments are hashed by their addresses and protocol only, as their ports
+// are not known. ok is false if data is not an IP packet.
+func flowHash(linkType layers.LinkType, data []byte, ports decoder.TunnelPorts) (hash uint32, ok bool) {
+	ip, ok := decoder.InnerIP(linkType, data, ports)
+	if !ok || len(ip) < 1 {
+		return 0, false
+	}
+
+	var (
+		src, dst []byte
+		proto    byte
+		l4       []byte
+	)
+	switch ip[0] >> 4 {
+	case 4:
+		if len(ip) < 20 {
+			return 0, false
+		}
+		hlen := int(ip[0]&0x0f) * 4
+		if hlen < 20 || len(ip) < hlen {
+			return 0, false
+		}
+		src, dst, proto = ip[12:16], ip[16:20], ip[9]
+		// only unfragmented packets carry the ports
+		if binary.BigEndian.Uint16(ip[6:8])&0x3fff == 0 {
+			l4 = ip[hlen:]
+		}
+	case 6:
+		if len(ip) < 40 {
+			return 0, false
+		}
+		src, dst, proto = ip[8:24], ip[24:40], ip[6]
+		l4 = ip[40:]
+		for l4 != nil && isIPv6ExtensionHeader(proto) {
+			if proto == 44 {
+				// fragment
+				if len(l4) < 8 {
+					return 0, false
+				}
+				proto, l4 = l4[0], nil
+				break
+			}
+			if len(l4) < 8 || len(l4) < (int(l4[1])+1)*8 {
+				return 0, false
+			}
+			proto, l4 = l4[0], l4[(int(l4[1])+1)*8:]
+		}
+	default:
+		return 0, false
+	}
+
+	var sport, dport []byte
+	if len(l4) >= 4 && (proto == ipProtoTCP || proto == ipProtoUDP || proto == ipProtoSCTP) {
+		sport, dport = l4[0:2], l4[2:4]
+	}
+
+	// order the endpoints, such that both directions hash alike
+	if c := bytes.Compare(src, dst); c > 0 || (c == 0 && bytes.Compare(sport, dport) > 0) {
+		src, dst = dst, src
+		sport, dport = dport, sport
+	}
+
+	h := fnv.New32a()
+	h.Write(src)
+	h.Write(sport)
+	h.Write(dst)
+	h.Write(dport)
+	h.Write([]byte{proto})
+	return h.Sum32(), true
+}
+
+// isIPv6ExtensionHeader returns true for the hop-by-hop, routing, fragment
+// and destination options headers preceding the transport header.
+func isIPv6ExtensionHeader(proto byte) bool {
+	switch proto {
+	case 0, 43, 44, 60:
+		return true
+	}
+	return false
+}
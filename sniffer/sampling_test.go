@@ -0,0 +1,211 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !integration
+// +build !integration
+
+package sniffer
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/njcx/gopacket_dpdk"
+	"github.com/njcx/gopacket_dpdk/layers"
+
+	"github.com/njcx/packetbeat7_dpdk/config"
+	"github.com/njcx/packetbeat7_dpdk/decoder"
+)
+
+// ipv4Packet returns an ethernet frame of a UDP packet.
+func ipv4Packet(src, dst string, sport, dport uint16) []byte {
+	frame := make([]byte, 14+20+8)
+	frame[12], frame[13] = 0x08, 0x00
+	ip := frame[14:]
+	ip[0] = 0x45
+	ip[9] = ipProtoUDP
+	copy(ip[12:16], net.ParseIP(src).To4())
+	copy(ip[16:20], net.ParseIP(dst).To4())
+	udp := ip[20:]
+	udp[0], udp[1] = byte(sport>>8), byte(sport)
+	udp[2], udp[3] = byte(dport>>8), byte(dport)
+	return frame
+}
+
+func TestFlowHash(t *testing.T) {
+	request := ipv4Packet("10.0.0.1", "10.0.0.2", 40000, 53)
+	response := ipv4Packet("10.0.0.2", "10.0.0.1", 53, 40000)
+	other := ipv4Packet("10.0.0.1", "10.0.0.2", 40001, 53)
+
+	h1, ok := flowHash(layers.LinkTypeEthernet, request, nil)
+	assert.True(t, ok)
+	h2, ok := flowHash(layers.LinkTypeEthernet, response, nil)
+	assert.True(t, ok)
+	h3, ok := flowHash(layers.LinkTypeEthernet, other, nil)
+	assert.True(t, ok)
+	assert.Equal(t, h1, h2)
+	assert.NotEqual(t, h1, h3)
+
+	// the same packet behind a VLAN tag or without link layer
+	tagged := append([]byte{}, request[:12]...)
+	tagged = append(tagged, 0x81, 0x00, 0x00, 0x0a)
+	tagged = append(tagged, request[12:]...)
+	h, ok := flowHash(layers.LinkTypeEthernet, tagged, nil)
+	assert.True(t, ok)
+	assert.Equal(t, h1, h)
+	h, ok = flowHash(layers.LinkTypeRaw, request[14:], nil)
+	assert.True(t, ok)
+	assert.Equal(t, h1, h)
+
+	// behind a Linux cooked v2 header
+	cooked := make([]byte, 20)
+	cooked[0], cooked[1] = 0x08, 0x00
+	h, ok = flowHash(decoder.LinkTypeLinuxSLL2, append(cooked, request[14:]...), nil)
+	assert.True(t, ok)
+	assert.Equal(t, h1, h)
+
+	// below two MPLS labels
+	labeled := append([]byte{}, request[:12]...)
+	labeled = append(labeled, 0x88, 0x47, 0x00, 0x01, 0x00, 0x40, 0x00, 0x02, 0x01, 0x40)
+	labeled = append(labeled, request[14:]...)
+	h, ok = flowHash(layers.LinkTypeEthernet, labeled, nil)
+	assert.True(t, ok)
+	assert.Equal(t, h1, h)
+
+	arp := make([]byte, 42)
+	arp[12], arp[13] = 0x08, 0x06
+	_, ok = flowHash(layers.LinkTypeEthernet, arp, nil)
+	assert.False(t, ok)
+	_, ok = flowHash(layers.LinkTypeEthernet, request[:20], nil)
+	assert.False(t, ok)
+}
+
+// tunnelPacket returns an ethernet frame of an IPv4 packet of the given
+// protocol between the tunnel endpoints src and dst.
+func tunnelPacket(src, dst string, proto byte, payload []byte) []byte {
+	frame := make([]byte, 14+20, 14+20+len(payload))
+	frame[12], frame[13] = 0x08, 0x00
+	ip := frame[14:]
+	ip[0] = 0x45
+	binary.BigEndian.PutUint16(ip[2:4], uint16(20+len(payload)))
+	ip[9] = proto
+	copy(ip[12:16], net.ParseIP(src).To4())
+	copy(ip[16:20], net.ParseIP(dst).To4())
+	return append(frame, payload...)
+}
+
+func udpTunnelPacket(src, dst string, port uint16, payload []byte) []byte {
+	udp := make([]byte, 8)
+	binary.BigEndian.PutUint16(udp[0:2], 50000)
+	binary.BigEndian.PutUint16(udp[2:4], port)
+	binary.BigEndian.PutUint16(udp[4:6], uint16(8+len(payload)))
+	return tunnelPacket(src, dst, ipProtoUDP, append(udp, payload...))
+}
+
+func TestFlowHash_tunnels(t *testing.T) {
+	ports := decoder.NewTunnelPorts(config.TunnelsConfig{})
+	request := ipv4Packet("10.0.0.1", "10.0.0.2", 40000, 53)
+	response := ipv4Packet("10.0.0.2", "10.0.0.1", 53, 40000)
+	want, ok := flowHash(layers.LinkTypeEthernet, request, ports)
+	require.True(t, ok)
+
+	for name, encap := range map[string]func(src, dst string, frame []byte) []byte{
+		"vxlan": func(src, dst string, frame []byte) []byte {
+			return udpTunnelPacket(src, dst, 4789, append([]byte{0x08, 0, 0, 0, 0, 0, 1, 0}, frame...))
+		},
+		"geneve": func(src, dst string, frame []byte) []byte {
+			return udpTunnelPacket(src, dst, 6081, append([]byte{0, 0, 0x65, 0x58, 0, 0, 1, 0}, frame...))
+		},
+		"gtp-u": func(src, dst string, frame []byte) []byte {
+			hdr := []byte{0x30, 0xff, 0, 0, 0, 0, 0, 1}
+			binary.BigEndian.PutUint16(hdr[2:4], uint16(len(frame)-14))
+			return udpTunnelPacket(src, dst, 2152, append(hdr, frame[14:]...))
+		},
+		"gre": func(src, dst string, frame []byte) []byte {
+			return tunnelPacket(src, dst, 47, append([]byte{0, 0, 0x08, 0x00}, frame[14:]...))
+		},
+		"erspan": func(src, dst string, frame []byte) []byte {
+			hdr := []byte{0x10, 0, 0x88, 0xbe, 0, 0, 0, 1, 0x10, 0, 0, 1, 0, 0, 0, 0}
+			return tunnelPacket(src, dst, 47, append(hdr, frame...))
+		},
+		"ipip": func(src, dst string, frame []byte) []byte {
+			return tunnelPacket(src, dst, 4, frame[14:])
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// both directions, carried by different tunnel endpoints
+			h, ok := flowHash(layers.LinkTypeEthernet, encap("192.168.0.1", "192.168.0.2", request), ports)
+			assert.True(t, ok)
+			assert.Equal(t, want, h)
+			h, ok = flowHash(layers.LinkTypeEthernet, encap("192.168.0.3", "192.168.0.1", response), ports)
+			assert.True(t, ok)
+			assert.Equal(t, want, h)
+
+			// the tunnel endpoints are hashed if tunnels are not decapsulated
+			h, ok = flowHash(layers.LinkTypeEthernet, encap("192.168.0.1", "192.168.0.2", request), nil)
+			assert.True(t, ok)
+			assert.NotEqual(t, want, h)
+		})
+	}
+}
+
+func TestSampler(t *testing.T) {
+	ethernet := func(*gopacket_dpdk.CaptureInfo) layers.LinkType { return layers.LinkTypeEthernet }
+
+	t.Run("packet", func(t *testing.T) {
+		s := &sampler{rate: 3, linkTypeOf: ethernet}
+		var kept []bool
+		for i := 0; i < 6; i++ {
+			kept = append(kept, s.keep(nil, &gopacket_dpdk.CaptureInfo{}))
+		}
+		assert.Equal(t, []bool{true, false, false, true, false, false}, kept)
+	})
+
+	t.Run("flow", func(t *testing.T) {
+		s := &sampler{flow: true, rate: 4, linkTypeOf: ethernet}
+		kept := 0
+		for port := uint16(1024); port < 1024+400; port++ {
+			request := ipv4Packet("10.0.0.1", "10.0.0.2", port, 80)
+			response := ipv4Packet("10.0.0.2", "10.0.0.1", 80, port)
+			keep := s.keep(request, &gopacket_dpdk.CaptureInfo{})
+			assert.Equal(t, keep, s.keep(response, &gopacket_dpdk.CaptureInfo{}))
+			if keep {
+				kept++
+			}
+		}
+		assert.InDelta(t, 100, kept, 40)
+	})
+
+	t.Run("batch", func(t *testing.T) {
+		s := &sampler{rate: 2, linkTypeOf: ethernet}
+		data := [][]byte{{1}, {2}, {3}, {4}, {5}}
+		ci := make([]gopacket_dpdk.CaptureInfo, len(data))
+		n := s.sample(data, ci)
+		assert.Equal(t, [][]byte{{1}, {3}, {5}}, data[:n])
+	})
+}
+
+func TestValidateSamplingConfig(t *testing.T) {
+	assert.NoError(t, validateSamplingConfig(&config.SamplingConfig{}))
+	assert.NoError(t, validateSamplingConfig(&config.SamplingConfig{Mode: "packet", Rate: 10}))
+	assert.Error(t, validateSamplingConfig(&config.SamplingConfig{Mode: "bytes", Rate: 10}))
+	assert.Error(t, validateSamplingConfig(&config.SamplingConfig{Rate: -1}))
+}
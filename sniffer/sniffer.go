@@ -19,10 +19,10 @@ package sniffer
 
 import (
 	"fmt"
-	"github.com/njcx/packetbeat7_dpdk/dpdkinit"
 	"io"
 	"os"
 	"runtime"
+	"sync"
 	"syscall"
 	"time"
 
@@ -33,21 +33,29 @@ import (
 	"github.com/njcx/libbeat_v7/common/atomic"
 	"github.com/njcx/libbeat_v7/logp"
 
+	"github.com/njcx/packetbeat7_dpdk/clock"
 	"github.com/njcx/packetbeat7_dpdk/config"
+	"github.com/njcx/packetbeat7_dpdk/decoder"
 )
 
 // Sniffer provides packet sniffing capabilities, forwarding packets read
 // to a Worker.
 type Sniffer struct {
 	config config.InterfacesConfig
-	dumper *pcap.Dumper
 
 	state atomic.Int32 // store snifferState
 
-	// bpf filter
-	filter string
+	// bpf filter, guarded by filterMu together with the live handle
+	filterMu sync.Mutex
+	filter   string
+	handle   snifferHandle
 
-	factory WorkerFactory
+	factory      WorkerFactory
+	ifaceFactory InterfaceWorkerFactory
+
+	statsReporter StatsReporter
+
+	ring *PacketRing
 }
 
 // WorkerFactory constructs a new worker instance for use with a Sniffer.
@@ -66,6 +74,43 @@ type snifferHandle interface {
 	Close()
 }
 
+// filterHandle is implemented by handles able to replace their packet
+// filter while capturing.
+type filterHandle interface {
+	SetBPFFilter(expr string) error
+}
+
+// multiQueueHandle is implemented by handles receiving packets on multiple
+// queues. Packets of one connection must always be received on the same
+// queue, so workers do not need to share any state.
+type multiQueueHandle interface {
+	snifferHandle
+
+	Queues() []snifferHandle
+}
+
+// batchHandle is implemented by handles able to read multiple packets with
+// a single call. ReadPacketBatch fills data and ci and returns the number of
+// packets read, which is 0 if no packet is available.
+type batchHandle interface {
+	snifferHandle
+
+	ReadPacketBatch(data [][]byte, ci []gopacket_dpdk.CaptureInfo) (int, error)
+}
+
+// BatchWorker is implemented by workers able to process a batch of packets
+// with a single call. Workers not implementing BatchWorker are passed the
+// packets of a batch one by one.
+type BatchWorker interface {
+	Worker
+
+	OnPackets(data [][]byte, ci []gopacket_dpdk.CaptureInfo)
+}
+
+// maxBatchSize is the maximum number of packets read from a batchHandle at
+// once.
+const maxBatchSize = 64
+
 // sniffer state values
 const (
 	snifferInactive = 0
@@ -105,6 +150,35 @@ func New(
 		// we read file with the pcap provider
 		s.config.Type = "pcap"
 		s.config.Device = ""
+
+		if s.config.Watch.Enabled {
+			setFileWatchDefaults(&s.config.Watch)
+		}
+	} else if s.config.Type == "stream" {
+		// streams are read from stdin, a named pipe or a socket
+		if s.config.BpfFilter != "" {
+			logp.Warn("Packet filters are not applied to pcap streams.")
+		}
+		s.config.Device = ""
+		setStreamDefaults(&s.config.Stream)
+	} else if s.config.Type == "dpdk" {
+		// dpdk ports are selected by port id, the device name is not used
+		if s.config.Snaplen == 0 {
+			s.config.Snaplen = 65535
+		}
+		if s.config.Dpdk.RxQueues == 0 {
+			s.config.Dpdk.RxQueues = 1
+		}
+		if s.config.Dpdk.RxDescriptors == 0 {
+			s.config.Dpdk.RxDescriptors = defaultDpdkRxDescriptors
+		}
+		if s.config.Dpdk.BurstSize == 0 {
+			s.config.Dpdk.BurstSize = defaultDpdkBurstSize
+		}
+		if s.config.Dpdk.MbufPoolSize == 0 {
+			s.config.Dpdk.MbufPoolSize = defaultDpdkMbufPoolSize * s.config.Dpdk.RxQueues
+		}
+		logp.Debug("sniffer", "Sniffer type: dpdk port: %d", s.config.Dpdk.Port)
 	} else {
 		// try to resolve device name (ignore error if testMode is enabled)
 		if name, err := resolveDeviceName(s.config.Device); err != nil {
@@ -127,10 +201,23 @@ func New(
 			if t := s.config.Type; t == "autodetect" || t == "" {
 				s.config.Type = "pcap"
 			}
+			if s.config.Type == "af_xdp" {
+				setAfXdpDefaults(&s.config.AfXdp)
+			}
 			logp.Debug("sniffer", "Sniffer type: %s device: %s", s.config.Type, s.config.Device)
 		}
 	}
 
+	if s.config.CaptureStats.Period <= 0 {
+		s.config.CaptureStats.Period = defaultStatsPeriod
+	}
+	if s.config.Dump.Enabled {
+		setDumpDefaults(&s.config.Dump)
+	}
+	if s.config.Archive.Enabled {
+		setArchiveDefaults(&s.config.Archive)
+	}
+
 	err := validateConfig(filter, &s.config)
 	if err != nil {
 		return nil, err
@@ -139,33 +226,138 @@ func New(
 	return s, nil
 }
 
+// SetStatsReporter configures the sniffer to publish capture_stats events,
+// if the sniffer type supports capture statistics. SetStatsReporter must be
+// called before Run.
+func (s *Sniffer) SetStatsReporter(reporter StatsReporter) {
+	s.statsReporter = reporter
+}
+
+// SetInterfaceWorkerFactory configures the sniffer to create workers for
+// the interfaces recorded in pcapng files with factory. By default, workers
+// are created by the WorkerFactory, with the link type of the interface.
+// SetInterfaceWorkerFactory must be called before Run.
+func (s *Sniffer) SetInterfaceWorkerFactory(factory InterfaceWorkerFactory) {
+	s.ifaceFactory = factory
+}
+
+// SetPacketRing sets the ring keeping copies of the packets read.
+func (s *Sniffer) SetPacketRing(ring *PacketRing) {
+	s.ring = ring
+}
+
+// Filter returns the BPF filter of the sniffer.
+func (s *Sniffer) Filter() string {
+	s.filterMu.Lock()
+	defer s.filterMu.Unlock()
+	return s.filter
+}
+
+// SetFilter replaces the BPF filter of the sniffer. A running sniffer swaps
+// the filter on its live handle, keeping the state of its workers. If the
+// filter can not be applied, the previous filter is restored and an error is
+// returned.
+func (s *Sniffer) SetFilter(expr string) error {
+	s.filterMu.Lock()
+	defer s.filterMu.Unlock()
+
+	if expr == s.filter {
+		return nil
+	}
+	if s.config.File != "" || s.config.Type == "stream" {
+		return fmt.Errorf("packet filters are not applied to pcap files or streams")
+	}
+	if err := validatePcapFilter(expr); err != nil {
+		return err
+	}
+
+	if h, ok := s.handle.(filterHandle); ok {
+		if err := h.SetBPFFilter(expr); err != nil {
+			if restoreErr := h.SetBPFFilter(s.filter); restoreErr != nil {
+				logp.Err("Failed to restore BPF filter '%s': %v", s.filter, restoreErr)
+			}
+			return fmt.Errorf("failed to set BPF filter '%s': %v", expr, err)
+		}
+	}
+
+	logp.Info("BPF filter of %s changed from '%s' to '%s'", InterfaceName(s.config), s.filter, expr)
+	s.filter = expr
+	return nil
+}
+
 // Run opens the sniffing device and processes packets being read from that device.
 // Worker instances are instantiated as needed.
 func (s *Sniffer) Run() error {
-	var (
-		counter = 0
-		dumper  *pcap.Dumper
-	)
-
+	s.filterMu.Lock()
 	handle, err := s.open()
-
 	if err != nil {
+		s.filterMu.Unlock()
 		return fmt.Errorf("Error starting sniffer: %s", err)
 	}
-	defer handle.Close()
+	s.handle = handle
+	s.filterMu.Unlock()
+	defer func() {
+		s.filterMu.Lock()
+		s.handle = nil
+		s.filterMu.Unlock()
+		handle.Close()
+	}()
+
+	tap := s.newTap(handle)
+	if tap != nil {
+		defer tap.Close()
+	}
 
-	if s.config.Dumpfile != "" {
-		dumper, err = openDumper(s.config.Dumpfile, handle.LinkType())
-		if err != nil {
-			return err
+	// Handles distributing packets over multiple receive queues get one
+	// worker per queue, each driven by its own goroutine.
+	queues := []snifferHandle{handle}
+	if mq, ok := handle.(multiQueueHandle); ok {
+		queues = mq.Queues()
+	}
+
+	workers := make([]Worker, len(queues))
+	if ih, ok := handle.(interfacesHandle); ok && ih.HasInterfaces() {
+		// packets captured on different interfaces, possibly with different
+		// link types, are processed by separate workers
+		factory := s.ifaceFactory
+		if factory == nil {
+			factory = func(iface Interface) (Worker, error) {
+				return s.factory(iface.LinkType)
+			}
+		}
+		workers[0] = newInterfaceDemux(ih, factory)
+	} else {
+		for i := range queues {
+			workers[i], err = s.factory(handle.LinkType())
+			if err != nil {
+				return err
+			}
 		}
+	}
 
-		defer dumper.Close()
+	// Packets are decoded by the goroutines of the decode queues, if
+	// enabled, instead of the goroutines reading them.
+	var decodeQueues []*decodeQueue
+	if s.config.DecodeQueue.Enabled() {
+		for i, w := range workers {
+			q := newDecodeQueue(w, s.config.DecodeQueue.Size)
+			defer q.Close()
+			workers[i] = q
+			decodeQueues = append(decodeQueues, q)
+		}
 	}
 
-	worker, err := s.factory(handle.LinkType())
-	if err != nil {
-		return err
+	var sh statsHandle
+	if h, ok := handle.(statsHandle); ok {
+		sh = h
+	}
+	if len(decodeQueues) > 0 {
+		sh = queueStatsHandle{handle: sh, queues: decodeQueues}
+	}
+	if sh != nil {
+		stats := newStatsCollector(sh, s.config.Type, InterfaceName(s.config), s.config.CaptureStats.Period, s.statsReporter)
+		stats.Start()
+		defer stats.Stop()
 	}
 
 	// Mark inactive sniffer as active. In case of the sniffer/packetbeat closing
@@ -176,6 +368,39 @@ func (s *Sniffer) Run() error {
 	}
 	defer s.state.Store(snifferInactive)
 
+	if len(queues) == 1 {
+		return s.runQueue(queues[0], workers[0], tap, s.newSampler(handle))
+	}
+
+	logp.Info("Sniffer reading from %d queues", len(queues))
+	var (
+		wg   sync.WaitGroup
+		once sync.Once
+	)
+	for i := range queues {
+		wg.Add(1)
+		go func(queue snifferHandle, worker Worker) {
+			defer wg.Done()
+			if qErr := s.runQueue(queue, worker, tap, s.newSampler(handle)); qErr != nil {
+				once.Do(func() { err = qErr })
+			}
+		}(queues[i], workers[i])
+	}
+	wg.Wait()
+
+	return err
+}
+
+// runQueue reads packets from handle and forwards them to worker until the
+// sniffer is stopped or reading fails. A failure stops all queues of the
+// sniffer. Packets dropped by the sampler, if any, are neither passed to the
+// tap nor to the worker.
+func (s *Sniffer) runQueue(handle snifferHandle, worker Worker, tap *packetTap, sampler *sampler) error {
+	if bh, ok := handle.(batchHandle); ok && !s.config.OneAtATime {
+		return s.runBatches(bh, worker, tap, sampler)
+	}
+
+	counter := 0
 	for s.state.Load() == snifferActive {
 		if s.config.OneAtATime {
 			fmt.Println("Press enter to read packet")
@@ -190,8 +415,8 @@ func (s *Sniffer) Run() error {
 		}
 
 		if err != nil {
-			// ignore EOF, if sniffer was driven from file
-			if err == io.EOF && s.config.File != "" {
+			// ignore EOF, if sniffer was driven from file or stream
+			if err == io.EOF && (s.config.File != "" || s.config.Type == "stream") {
 				return nil
 			}
 
@@ -204,8 +429,16 @@ func (s *Sniffer) Run() error {
 			continue
 		}
 
-		if dumper != nil {
-			dumper.WritePacketData(data, ci)
+		if sampler != nil && !sampler.keep(data, &ci) {
+			continue
+		}
+
+		if tap != nil {
+			tap.packet(data, &ci)
+		}
+
+		if clock.Virtual() {
+			clock.Advance(ci.Timestamp)
 		}
 
 		counter++
@@ -216,9 +449,51 @@ func (s *Sniffer) Run() error {
 	return nil
 }
 
+// runBatches is the counterpart of runQueue for handles reading batches of
+// packets.
+func (s *Sniffer) runBatches(handle batchHandle, worker Worker, tap *packetTap, sampler *sampler) error {
+	data := make([][]byte, maxBatchSize)
+	ci := make([]gopacket_dpdk.CaptureInfo, maxBatchSize)
+	batchWorker, _ := worker.(BatchWorker)
+
+	for s.state.Load() == snifferActive {
+		n, err := handle.ReadPacketBatch(data, ci)
+		if err != nil {
+			s.state.Store(snifferInactive)
+			return fmt.Errorf("Sniffing error: %s", err)
+		}
+		if sampler != nil {
+			n = sampler.sample(data[:n], ci[:n])
+		}
+		if n == 0 {
+			continue
+		}
+
+		if tap != nil {
+			for i := 0; i < n; i++ {
+				tap.packet(data[i], &ci[i])
+			}
+		}
+
+		logp.Debug("sniffer", "Packet batch of %d packets", n)
+		if batchWorker != nil {
+			batchWorker.OnPackets(data[:n], ci[:n])
+		} else {
+			for i := 0; i < n; i++ {
+				worker.OnPacket(data[i], &ci[i])
+			}
+		}
+	}
+
+	return nil
+}
+
 func (s *Sniffer) open() (snifferHandle, error) {
 	if s.config.File != "" {
-		return newFileHandler(s.config.File, s.config.TopSpeed, s.config.Loop)
+		if s.config.Watch.Enabled || isFilePattern(s.config.File) {
+			return newFileSetHandler(&s.config)
+		}
+		return newFileHandler(&s.config)
 	}
 
 	switch s.config.Type {
@@ -226,8 +501,12 @@ func (s *Sniffer) open() (snifferHandle, error) {
 		return openPcap(s.filter, &s.config)
 	case "af_packet":
 		return openAFPacket(s.filter, &s.config)
+	case "af_xdp":
+		return openAFXdp(s.filter, &s.config)
 	case "dpdk":
-		return openDpdk(s.filter)
+		return openDpdk(s.filter, &s.config)
+	case "stream":
+		return openStream(&s.config)
 	default:
 		return nil, fmt.Errorf("Unknown sniffer type: %s", s.config.Type)
 	}
@@ -245,6 +524,33 @@ func validateConfig(filter string, cfg *config.InterfacesConfig) error {
 		if err := validatePcapFilter(filter); err != nil {
 			return err
 		}
+		if cfg.Watch.Enabled {
+			return fmt.Errorf("watch requires file to be set to a directory or glob pattern")
+		}
+	}
+
+	if err := validateReplayConfig(cfg); err != nil {
+		return err
+	}
+
+	if cfg.Dump.Enabled {
+		if err := validateDumpConfig(&cfg.Dump); err != nil {
+			return err
+		}
+	}
+
+	if cfg.Archive.Enabled {
+		if err := validateArchiveConfig(&cfg.Archive); err != nil {
+			return err
+		}
+	}
+
+	if err := validateSamplingConfig(&cfg.Sampling); err != nil {
+		return err
+	}
+
+	if err := validateDecodeQueueConfig(&cfg.DecodeQueue); err != nil {
+		return err
 	}
 
 	switch cfg.Type {
@@ -252,8 +558,12 @@ func validateConfig(filter string, cfg *config.InterfacesConfig) error {
 		return validatePcapConfig(cfg)
 	case "af_packet":
 		return validateAfPacketConfig(cfg)
+	case "af_xdp":
+		return validateAfXdpConfig(cfg)
 	case "dpdk":
 		return validateDpdkConfig(cfg)
+	case "stream":
+		return validateStreamConfig(cfg)
 
 	default:
 		return fmt.Errorf("Unknown sniffer type: %s", cfg.Type)
@@ -264,13 +574,25 @@ func validatePcapConfig(cfg *config.InterfacesConfig) error {
 	return nil
 }
 
-func validateDpdkConfig(cfg *config.InterfacesConfig) error {
-	return nil
-}
-
 func validateAfPacketConfig(cfg *config.InterfacesConfig) error {
 	_, _, _, err := afpacketComputeSize(cfg.BufferSizeMb, cfg.Snaplen, os.Getpagesize())
-	return err
+	if err != nil {
+		return err
+	}
+
+	fanout := cfg.Fanout
+	switch fanout.Mode {
+	case "", "hash", "cpu", "lb", "rollover":
+	default:
+		return fmt.Errorf("unknown fanout mode '%s', expected one of hash, cpu, lb or rollover", fanout.Mode)
+	}
+	if fanout.Workers < 0 {
+		return fmt.Errorf("fanout workers must not be negative, got %d", fanout.Workers)
+	}
+	if fanout.Workers > 1 && fanout.Mode != "" && fanout.Mode != "hash" {
+		logp.Warn("Fanout mode '%s' distributes the packets of a connection over multiple workers, transactions may not be correlated.", fanout.Mode)
+	}
+	return nil
 }
 
 func validatePcapFilter(expr string) error {
@@ -307,7 +629,7 @@ func openPcap(filter string, cfg *config.InterfacesConfig) (snifferHandle, error
 		return nil, err
 	}
 
-	return h, nil
+	return pcapHandle{h}, nil
 }
 
 func openAFPacket(filter string, cfg *config.InterfacesConfig) (snifferHandle, error) {
@@ -317,7 +639,7 @@ func openAFPacket(filter string, cfg *config.InterfacesConfig) (snifferHandle, e
 	}
 
 	timeout := 500 * time.Millisecond
-	h, err := newAfpacketHandle(cfg.Device, szFrame, szBlock, numBlocks, timeout, cfg.EnableAutoPromiscMode)
+	h, err := newAfpacketHandle(cfg.Device, szFrame, szBlock, numBlocks, timeout, cfg.EnableAutoPromiscMode, cfg.Fanout)
 	if err != nil {
 		return nil, err
 	}
@@ -331,22 +653,114 @@ func openAFPacket(filter string, cfg *config.InterfacesConfig) (snifferHandle, e
 	return h, nil
 }
 
-func openDpdk(filter string) (snifferHandle, error) {
+func openAFXdp(filter string, cfg *config.InterfacesConfig) (snifferHandle, error) {
+	timeout := 500 * time.Millisecond
+	h, err := newAfxdpHandle(cfg.Device, cfg.Snaplen, timeout, cfg.AfXdp)
+	if err != nil {
+		return nil, err
+	}
 
-	h := dpdkinit.DPdk
-	err := h.SetBPFFilter(filter)
+	err = h.SetBPFFilter(filter)
 	if err != nil {
 		h.Close()
 		return nil, err
 	}
+
 	return h, nil
 }
 
-func openDumper(file string, linkType layers.LinkType) (*pcap.Dumper, error) {
-	p, err := pcap.OpenDead(linkType, 65535)
+func openDpdk(filter string, cfg *config.InterfacesConfig) (snifferHandle, error) {
+	h, err := newDpdkHandle(cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	return p.NewDumper(file)
+	err = h.SetBPFFilter(filter)
+	if err != nil {
+		h.Close()
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// packetTap hands the packets read to the dumper, the archive and the packet
+// ring, if any, before they are processed.
+type packetTap struct {
+	ifaceOf func(ci *gopacket_dpdk.CaptureInfo) Interface
+	dumper  *packetDumper
+	archive *archiveWriter
+	ring    *PacketRing
+}
+
+// newTap creates the tap of the packets read from handle. It returns nil if
+// neither dumping, archiving nor the packet ring are enabled.
+func (s *Sniffer) newTap(handle snifferHandle) *packetTap {
+	name := InterfaceName(s.config)
+	ifaceOf := func(ci *gopacket_dpdk.CaptureInfo) Interface {
+		return Interface{Name: name, LinkType: handle.LinkType(), Snaplen: s.config.Snaplen}
+	}
+	if ih, ok := handle.(interfacesHandle); ok && ih.HasInterfaces() {
+		ifaceOf = func(ci *gopacket_dpdk.CaptureInfo) Interface {
+			if iface, ok := ih.Interface(ci.InterfaceIndex); ok {
+				return iface
+			}
+			return Interface{Index: ci.InterfaceIndex, LinkType: handle.LinkType()}
+		}
+	}
+
+	var archive *archiveWriter
+	if s.config.Archive.Enabled {
+		archive = newArchiveWriter(s.config.Archive, decoder.NewTunnelPorts(s.config.Tunnels))
+	}
+
+	dumper := s.newDumper(ifaceOf)
+	if dumper == nil && archive == nil && s.ring == nil {
+		return nil
+	}
+	return &packetTap{ifaceOf: ifaceOf, dumper: dumper, archive: archive, ring: s.ring}
+}
+
+func (t *packetTap) packet(data []byte, ci *gopacket_dpdk.CaptureInfo) {
+	if t.dumper != nil {
+		t.dumper.WritePacketData(data, ci)
+	}
+	if t.archive == nil && t.ring == nil {
+		return
+	}
+	iface := t.ifaceOf(ci)
+	if t.archive != nil {
+		t.archive.WritePacket(iface, data, ci)
+	}
+	if t.ring != nil {
+		t.ring.Add(iface, data, ci)
+	}
+}
+
+func (t *packetTap) Close() {
+	if t.dumper != nil {
+		t.dumper.Close()
+	}
+	if t.archive != nil {
+		t.archive.Close()
+	}
+}
+
+// newDumper creates the dumper writing the packets read, if dumping is
+// enabled. The -dump flag takes precedence over packetbeat.dump.
+func (s *Sniffer) newDumper(ifaceOf func(ci *gopacket_dpdk.CaptureInfo) Interface) *packetDumper {
+	var (
+		cfg   config.DumpConfig
+		fixed string
+	)
+	switch {
+	case s.config.Dumpfile != "":
+		cfg = config.DumpConfig{Format: "pcap"}
+		fixed = s.config.Dumpfile
+	case s.config.Dump.Enabled:
+		cfg = s.config.Dump
+	default:
+		return nil
+	}
+	return newPacketDumper(cfg, fixed, ifaceOf)
 }
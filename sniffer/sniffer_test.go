@@ -21,9 +21,15 @@
 package sniffer
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/njcx/gopacket_dpdk"
+	"github.com/njcx/gopacket_dpdk/layers"
+
+	"github.com/njcx/packetbeat7_dpdk/config"
 )
 
 func TestSniffer_afpacketComputeSize(t *testing.T) {
@@ -90,3 +96,200 @@ func Test_deviceNameFromIndex(t *testing.T) {
 	_, err = deviceNameFromIndex(3, devs)
 	assert.Error(t, err)
 }
+
+func Test_validateDpdkConfig(t *testing.T) {
+	cfg := config.InterfacesConfig{
+		Type: "dpdk",
+		Dpdk: config.DpdkConfig{
+			RxQueues:      1,
+			MbufPoolSize:  defaultDpdkMbufPoolSize,
+			RxDescriptors: defaultDpdkRxDescriptors,
+			BurstSize:     defaultDpdkBurstSize,
+		},
+	}
+	assert.NoError(t, validateDpdkConfig(&cfg))
+
+	cfg.Dpdk.BurstSize = maxBatchSize + 1
+	assert.Error(t, validateDpdkConfig(&cfg))
+	cfg.Dpdk.BurstSize = defaultDpdkBurstSize
+
+	cfg.Dpdk.MTU = 70000
+	assert.Error(t, validateDpdkConfig(&cfg))
+
+	cfg.Dpdk.MTU = 9000
+	cfg.Dpdk.RxDescriptors = 0
+	assert.Error(t, validateDpdkConfig(&cfg))
+
+	cfg.Dpdk.RxDescriptors = defaultDpdkRxDescriptors
+	cfg.Dpdk.MbufPoolSize = -1
+	assert.Error(t, validateDpdkConfig(&cfg))
+
+	// pool too small to fill all rx rings
+	cfg.Dpdk.RxQueues = 16
+	cfg.Dpdk.MbufPoolSize = defaultDpdkMbufPoolSize
+	assert.Error(t, validateDpdkConfig(&cfg))
+
+	cfg.Dpdk.RxQueues = 0
+	assert.Error(t, validateDpdkConfig(&cfg))
+
+	cfg.Dpdk.RxQueues = 1
+	cfg.Dpdk.Vdevs = []string{"net_pcap0,rx_pcap=file.pcap", "net_null0"}
+	assert.NoError(t, validateDpdkConfig(&cfg))
+
+	cfg.Dpdk.Vdevs = []string{",rx_pcap=file.pcap"}
+	assert.Error(t, validateDpdkConfig(&cfg))
+}
+
+func Test_validateAfPacketConfig_fanout(t *testing.T) {
+	cfg := config.InterfacesConfig{
+		Type:         "af_packet",
+		Snaplen:      65535,
+		BufferSizeMb: 30,
+		Fanout: config.FanoutConfig{
+			Mode:    "hash",
+			Workers: 4,
+		},
+	}
+	assert.NoError(t, validateAfPacketConfig(&cfg))
+
+	cfg.Fanout.Mode = "random"
+	assert.Error(t, validateAfPacketConfig(&cfg))
+
+	cfg.Fanout.Mode = "rollover"
+	cfg.Fanout.Workers = -1
+	assert.Error(t, validateAfPacketConfig(&cfg))
+}
+
+func Test_validateAfXdpConfig(t *testing.T) {
+	cfg := config.InterfacesConfig{
+		Type:   "af_xdp",
+		Device: "eth0",
+	}
+	setAfXdpDefaults(&cfg.AfXdp)
+	assert.NoError(t, validateAfXdpConfig(&cfg))
+
+	cfg.AfXdp.Mode = "hw"
+	assert.Error(t, validateAfXdpConfig(&cfg))
+
+	cfg.AfXdp.Mode = "skb"
+	cfg.AfXdp.FrameSize = 1500
+	assert.Error(t, validateAfXdpConfig(&cfg))
+
+	cfg.AfXdp.FrameSize = 2048
+	cfg.AfXdp.RingSize = 1000
+	assert.Error(t, validateAfXdpConfig(&cfg))
+
+	// all frames of the RX ring must be backed by the UMEM
+	cfg.AfXdp.RingSize = 4096
+	cfg.AfXdp.NumFrames = 2048
+	assert.Error(t, validateAfXdpConfig(&cfg))
+
+	cfg.AfXdp.NumFrames = 4096
+	cfg.Device = "any"
+	assert.Error(t, validateAfXdpConfig(&cfg))
+}
+
+type batchTestHandle struct {
+	sniffer *Sniffer
+	batches [][][]byte
+}
+
+func (h *batchTestHandle) ReadPacketData() ([]byte, gopacket_dpdk.CaptureInfo, error) {
+	panic("single packet read on batch handle")
+}
+
+func (h *batchTestHandle) ReadPacketBatch(data [][]byte, ci []gopacket_dpdk.CaptureInfo) (int, error) {
+	if len(h.batches) == 0 {
+		h.sniffer.Stop()
+		return 0, nil
+	}
+	batch := h.batches[0]
+	h.batches = h.batches[1:]
+	n := copy(data, batch)
+	for i := 0; i < n; i++ {
+		ci[i] = gopacket_dpdk.CaptureInfo{CaptureLength: len(data[i]), Length: len(data[i])}
+	}
+	return n, nil
+}
+
+func (h *batchTestHandle) LinkType() layers.LinkType { return layers.LinkTypeEthernet }
+func (h *batchTestHandle) Close()                    {}
+
+type testWorker struct {
+	packets [][]byte
+}
+
+func (w *testWorker) OnPacket(data []byte, ci *gopacket_dpdk.CaptureInfo) {
+	w.packets = append(w.packets, data)
+}
+
+type testBatchWorker struct {
+	testWorker
+	batches int
+}
+
+func (w *testBatchWorker) OnPackets(data [][]byte, ci []gopacket_dpdk.CaptureInfo) {
+	w.batches++
+	for i := range data {
+		w.OnPacket(data[i], &ci[i])
+	}
+}
+
+func TestSniffer_runBatches(t *testing.T) {
+	batches := [][][]byte{
+		{{1}, {2}, {3}},
+		{},
+		{{4}},
+	}
+
+	run := func(worker Worker) {
+		s := &Sniffer{}
+		s.state.Store(snifferActive)
+		h := &batchTestHandle{sniffer: s, batches: batches}
+		assert.NoError(t, s.runQueue(h, worker, nil, nil))
+	}
+
+	single := &testWorker{}
+	run(single)
+	assert.Equal(t, [][]byte{{1}, {2}, {3}, {4}}, single.packets)
+
+	batched := &testBatchWorker{}
+	run(batched)
+	assert.Equal(t, [][]byte{{1}, {2}, {3}, {4}}, batched.packets)
+	assert.Equal(t, 2, batched.batches)
+}
+
+type filterTestHandle struct {
+	batchTestHandle
+	filters []string
+	reject  string
+}
+
+func (h *filterTestHandle) SetBPFFilter(expr string) error {
+	if expr == h.reject {
+		return fmt.Errorf("rejected")
+	}
+	h.filters = append(h.filters, expr)
+	return nil
+}
+
+func TestSniffer_SetFilter(t *testing.T) {
+	h := &filterTestHandle{reject: "udp port 53"}
+	s := &Sniffer{filter: "tcp port 80", handle: h}
+	s.config.Type = "af_packet"
+
+	assert.NoError(t, s.SetFilter("tcp port 443"))
+	assert.Equal(t, "tcp port 443", s.Filter())
+
+	// invalid filters are not passed to the handle
+	assert.Error(t, s.SetFilter("tcp port"))
+	assert.Equal(t, "tcp port 443", s.Filter())
+
+	// the previous filter is restored if the handle fails
+	assert.Error(t, s.SetFilter("udp port 53"))
+	assert.Equal(t, "tcp port 443", s.Filter())
+	assert.Equal(t, []string{"tcp port 443", "tcp port 443"}, h.filters)
+
+	s.config.Type = "stream"
+	assert.Error(t, s.SetFilter(""))
+}
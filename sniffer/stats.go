@@ -0,0 +1,210 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sniffer
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/njcx/libbeat_v7/beat"
+	"github.com/njcx/libbeat_v7/common"
+	"github.com/njcx/libbeat_v7/logp"
+	"github.com/njcx/libbeat_v7/monitoring"
+)
+
+const defaultStatsPeriod = 10 * time.Second
+
+// captureStats holds the statistics of a sniffer handle. All counters are
+// totals since the handle has been opened.
+type captureStats struct {
+	received  uint64 // packets received
+	dropped   uint64 // packets dropped, because the sniffer could not keep up
+	ifDropped uint64 // packets dropped by the network interface or its driver
+	errors    uint64 // erroneous packets received
+
+	// counters specific to the sniffer type, keyed by dotted names
+	counters map[string]uint64
+
+	// statistics of the decode queues, if enabled
+	queue *queueStats
+}
+
+// statsHandle is implemented by handles able to report capture statistics.
+type statsHandle interface {
+	Stats() (captureStats, error)
+}
+
+// StatsReporter publishes capture_stats events.
+type StatsReporter func(beat.Event)
+
+// statsCollector periodically polls the statistics of a handle. The
+// statistics are registered in the packetbeat.sniffer.<type>.<interface>
+// monitoring namespace and optionally reported as capture_stats events,
+// holding the counter increments since the last event.
+type statsCollector struct {
+	handle   statsHandle
+	typ      string
+	period   time.Duration
+	reporter StatsReporter
+
+	regName  string
+	registry *monitoring.Registry
+	vars     map[string]*monitoring.Uint
+	last     captureStats
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+func newStatsCollector(handle statsHandle, typ, name string, period time.Duration, reporter StatsReporter) *statsCollector {
+	if name == "" {
+		name = "default"
+	}
+	return &statsCollector{
+		handle:   handle,
+		typ:      typ,
+		period:   period,
+		reporter: reporter,
+		regName:  "packetbeat.sniffer." + typ + "." + monitoringName(name),
+		vars:     map[string]*monitoring.Uint{},
+		done:     make(chan struct{}),
+	}
+}
+
+// monitoringName replaces the separator of monitoring namespaces in name.
+func monitoringName(name string) string {
+	return strings.Replace(name, ".", "_", -1)
+}
+
+func (c *statsCollector) Start() {
+	c.registry = monitoring.Default.GetRegistry(c.regName)
+	if c.registry == nil {
+		c.registry = monitoring.Default.NewRegistry(c.regName)
+	}
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+
+		ticker := time.NewTicker(c.period)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-c.done:
+				return
+			case <-ticker.C:
+				c.collect()
+			}
+		}
+	}()
+}
+
+func (c *statsCollector) Stop() {
+	close(c.done)
+	c.wg.Wait()
+	monitoring.Default.Remove(c.regName)
+}
+
+func (c *statsCollector) collect() {
+	stats, err := c.handle.Stats()
+	if err != nil {
+		logp.Warn("Failed to read %s capture statistics: %v", c.typ, err)
+		return
+	}
+
+	c.set("received", stats.received)
+	c.set("dropped", stats.dropped)
+	c.set("if_dropped", stats.ifDropped)
+	c.set("errors", stats.errors)
+	for name, v := range stats.counters {
+		c.set(name, v)
+	}
+	if q := stats.queue; q != nil {
+		c.set("queue.depth", q.depth)
+		c.set("queue.high_water", q.highWater)
+		c.set("queue.dropped", q.dropped)
+	}
+
+	if c.reporter != nil {
+		c.reporter(c.event(stats))
+	}
+	c.last = stats
+}
+
+func (c *statsCollector) set(name string, v uint64) {
+	u, exists := c.vars[name]
+	if !exists {
+		if u, _ = c.registry.Get(name).(*monitoring.Uint); u == nil {
+			u = monitoring.NewUint(c.registry, name)
+		}
+		c.vars[name] = u
+	}
+	u.Set(v)
+}
+
+func (c *statsCollector) event(stats captureStats) beat.Event {
+	delta := func(cur, last uint64) uint64 {
+		if cur < last {
+			// counters have been reset
+			return cur
+		}
+		return cur - last
+	}
+
+	counters := common.MapStr{}
+	for name, v := range stats.counters {
+		counters.Put(name, delta(v, c.last.counters[name]))
+	}
+
+	capture := common.MapStr{
+		"type":       c.typ,
+		"received":   delta(stats.received, c.last.received),
+		"dropped":    delta(stats.dropped, c.last.dropped),
+		"if_dropped": delta(stats.ifDropped, c.last.ifDropped),
+		"errors":     delta(stats.errors, c.last.errors),
+		c.typ:        counters,
+	}
+	if q := stats.queue; q != nil {
+		var lastDropped uint64
+		if c.last.queue != nil {
+			lastDropped = c.last.queue.dropped
+		}
+		// depth and high water mark are reported as is
+		capture["queue"] = common.MapStr{
+			"depth":      q.depth,
+			"high_water": q.highWater,
+			"dropped":    delta(q.dropped, lastDropped),
+		}
+	}
+
+	return beat.Event{
+		Timestamp: time.Now(),
+		Fields: common.MapStr{
+			"type": "capture_stats",
+			"event": common.MapStr{
+				"dataset":  "capture_stats",
+				"kind":     "metric",
+				"category": []string{"network"},
+				"type":     []string{"info"},
+				"duration": c.period,
+			},
+			"capture": capture,
+		},
+	}
+}
@@ -0,0 +1,99 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !integration
+// +build !integration
+
+package sniffer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/njcx/libbeat_v7/beat"
+	"github.com/njcx/libbeat_v7/monitoring"
+)
+
+type testStatsHandle struct {
+	stats captureStats
+}
+
+func (h *testStatsHandle) Stats() (captureStats, error) {
+	return h.stats, nil
+}
+
+func TestStatsCollector(t *testing.T) {
+	handle := &testStatsHandle{}
+	var events []beat.Event
+	c := newStatsCollector(handle, "dpdk", "dpdk0", time.Second, func(e beat.Event) {
+		events = append(events, e)
+	})
+	c.registry = monitoring.NewRegistry()
+
+	handle.stats = captureStats{received: 10, dropped: 1, counters: map[string]uint64{"queues.0.ipackets": 10}}
+	c.collect()
+	handle.stats = captureStats{received: 25, dropped: 1, ifDropped: 2, counters: map[string]uint64{"queues.0.ipackets": 25}}
+	c.collect()
+
+	assert.Equal(t, "packetbeat.sniffer.dpdk.dpdk0", c.regName)
+	assert.Equal(t, uint64(25), c.registry.Get("received").(*monitoring.Uint).Get())
+	assert.Equal(t, uint64(25), c.registry.Get("queues.0.ipackets").(*monitoring.Uint).Get())
+
+	if assert.Len(t, events, 2) {
+		fields := events[1].Fields
+		for field, expected := range map[string]uint64{
+			"capture.received":               15,
+			"capture.dropped":                0,
+			"capture.if_dropped":             2,
+			"capture.dpdk.queues.0.ipackets": 15,
+		} {
+			v, err := fields.GetValue(field)
+			assert.NoError(t, err, field)
+			assert.Equal(t, expected, v, field)
+		}
+	}
+}
+
+func TestStatsCollector_queue(t *testing.T) {
+	handle := &testStatsHandle{}
+	var events []beat.Event
+	c := newStatsCollector(handle, "af_packet", "eth0", time.Second, func(e beat.Event) {
+		events = append(events, e)
+	})
+	c.registry = monitoring.NewRegistry()
+
+	handle.stats = captureStats{queue: &queueStats{depth: 5, highWater: 10, dropped: 3}}
+	c.collect()
+	handle.stats = captureStats{queue: &queueStats{depth: 2, highWater: 12, dropped: 7}}
+	c.collect()
+
+	assert.Equal(t, uint64(12), c.registry.Get("queue.high_water").(*monitoring.Uint).Get())
+	if assert.Len(t, events, 2) {
+		fields := events[1].Fields
+		for field, expected := range map[string]uint64{
+			"capture.queue.depth":      2,
+			"capture.queue.high_water": 12,
+			"capture.queue.dropped":    4,
+		} {
+			v, err := fields.GetValue(field)
+			assert.NoError(t, err, field)
+			assert.Equal(t, expected, v, field)
+		}
+	}
+}
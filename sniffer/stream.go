@@ -0,0 +1,431 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sniffer
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/njcx/gopacket_dpdk"
+	"github.com/njcx/gopacket_dpdk/layers"
+	"github.com/njcx/gopacket_dpdk/pcap"
+
+	"github.com/njcx/libbeat_v7/logp"
+
+	"github.com/njcx/packetbeat7_dpdk/config"
+)
+
+const (
+	defaultStreamBackoff    = time.Second
+	defaultStreamMaxBackoff = 30 * time.Second
+
+	// streamTimeout is the time ReadPacketData waits for a packet before
+	// returning pcap.NextErrorTimeoutExpired.
+	streamTimeout = 500 * time.Millisecond
+
+	streamDialTimeout = 10 * time.Second
+	streamQueueSize   = 64
+)
+
+// pcap file header magic numbers, for microsecond and nanosecond timestamps
+const (
+	pcapMagicMicros = 0xa1b2c3d4
+	pcapMagicNanos  = 0xa1b23c4d
+
+	pcapMaxPacketSize = 256 << 10
+)
+
+var errPcapFormat = errors.New("invalid pcap stream")
+
+func setStreamDefaults(cfg *config.StreamConfig) {
+	if cfg.Backoff <= 0 {
+		cfg.Backoff = defaultStreamBackoff
+	}
+	if cfg.MaxBackoff < cfg.Backoff {
+		cfg.MaxBackoff = defaultStreamMaxBackoff
+		if cfg.MaxBackoff < cfg.Backoff {
+			cfg.MaxBackoff = cfg.Backoff
+		}
+	}
+}
+
+func validateStreamConfig(cfg *config.InterfacesConfig) error {
+	s := cfg.Stream
+	if (s.Source == "") == (s.Listen == "") {
+		return errors.New("stream requires either source or listen to be set")
+	}
+	if s.Listen != "" {
+		if _, _, err := net.SplitHostPort(s.Listen); err != nil {
+			return fmt.Errorf("invalid stream listen address %s: %v", s.Listen, err)
+		}
+	}
+	if addr := strings.TrimPrefix(s.Source, "tcp://"); addr != s.Source {
+		if _, _, err := net.SplitHostPort(addr); err != nil {
+			return fmt.Errorf("invalid stream source %s: %v", s.Source, err)
+		}
+	}
+	return nil
+}
+
+// pcapStreamReader reads a libpcap byte stream, which unlike a pcap file can
+// not be seeked.
+type pcapStreamReader struct {
+	r        *bufio.Reader
+	order    binary.ByteOrder
+	nanos    bool
+	linkType layers.LinkType
+	hdr      [16]byte
+}
+
+// newStreamReader detects the format of a pcap or pcapng stream and reads
+// its header. An empty stream results in io.EOF.
+func newStreamReader(src io.Reader) (fileReader, error) {
+	r := bufio.NewReaderSize(src, 1<<16)
+	magic, err := r.Peek(4)
+	if err != nil {
+		if err == io.EOF && len(magic) == 0 {
+			return nil, io.EOF
+		}
+		return nil, errPcapFormat
+	}
+
+	if binary.LittleEndian.Uint32(magic) == pcapngSectionHeader {
+		return newPcapngReader(r, ioutil.NopCloser(r))
+	}
+
+	var hdr [24]byte
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		return nil, errPcapFormat
+	}
+	p := &pcapStreamReader{r: r}
+	switch {
+	case binary.LittleEndian.Uint32(hdr[0:4]) == pcapMagicMicros:
+		p.order = binary.LittleEndian
+	case binary.BigEndian.Uint32(hdr[0:4]) == pcapMagicMicros:
+		p.order = binary.BigEndian
+	case binary.LittleEndian.Uint32(hdr[0:4]) == pcapMagicNanos:
+		p.order, p.nanos = binary.LittleEndian, true
+	case binary.BigEndian.Uint32(hdr[0:4]) == pcapMagicNanos:
+		p.order, p.nanos = binary.BigEndian, true
+	default:
+		return nil, fmt.Errorf("%v: unknown magic number 0x%x", errPcapFormat, hdr[0:4])
+	}
+	// the upper bits of the link type may hold FCS information
+	p.linkType = layers.LinkType(p.order.Uint32(hdr[20:24]) & 0x0fffffff)
+	return p, nil
+}
+
+func (p *pcapStreamReader) ReadPacketData() ([]byte, gopacket_dpdk.CaptureInfo, error) {
+	var ci gopacket_dpdk.CaptureInfo
+	if _, err := io.ReadFull(p.r, p.hdr[:]); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			err = errPcapFormat
+		}
+		return nil, ci, err
+	}
+
+	sec := int64(p.order.Uint32(p.hdr[0:4]))
+	frac := int64(p.order.Uint32(p.hdr[4:8]))
+	if !p.nanos {
+		frac *= int64(time.Microsecond)
+	}
+	caplen := int(p.order.Uint32(p.hdr[8:12]))
+	if caplen > pcapMaxPacketSize {
+		return nil, ci, fmt.Errorf("%v: packet of %d bytes", errPcapFormat, caplen)
+	}
+
+	data := make([]byte, caplen)
+	if _, err := io.ReadFull(p.r, data); err != nil {
+		return nil, ci, errPcapFormat
+	}
+	ci = gopacket_dpdk.CaptureInfo{
+		Timestamp:     time.Unix(sec, frac),
+		CaptureLength: caplen,
+		Length:        int(p.order.Uint32(p.hdr[12:16])),
+	}
+	return data, ci, nil
+}
+
+func (p *pcapStreamReader) LinkType() layers.LinkType {
+	return p.linkType
+}
+
+func (p *pcapStreamReader) Close() {}
+
+// streamHandle reads packets from pcap or pcapng streams. Streams are read
+// by a separate goroutine, as reading stdin or opening a named pipe can not
+// be interrupted. Once a stream ends, the next stream is awaited if
+// reconnecting is enabled, otherwise io.EOF is returned.
+//
+// Packets of all streams are processed by the same workers. Each link type
+// or pcapng interface is reported as an interface of its own.
+type streamHandle struct {
+	cfg      config.StreamConfig
+	listener net.Listener
+	fifo     bool
+
+	packets chan streamPacket
+	done    chan struct{}
+	once    sync.Once
+
+	mu  sync.Mutex
+	src io.Closer // the stream read, closed to interrupt reading
+
+	interfaces interfaceMap
+}
+
+type streamPacket struct {
+	data []byte
+	ci   gopacket_dpdk.CaptureInfo
+	err  error
+}
+
+func openStream(cfg *config.InterfacesConfig) (*streamHandle, error) {
+	h := &streamHandle{
+		cfg:     cfg.Stream,
+		packets: make(chan streamPacket, streamQueueSize),
+		done:    make(chan struct{}),
+	}
+
+	switch src := h.cfg.Source; {
+	case h.cfg.Listen != "":
+		l, err := net.Listen("tcp", h.cfg.Listen)
+		if err != nil {
+			return nil, err
+		}
+		h.listener = l
+		logp.Info("Listening for pcap streams on %s", l.Addr())
+	case src != "-" && !strings.HasPrefix(src, "tcp://"):
+		info, err := os.Stat(src)
+		if err != nil {
+			return nil, err
+		}
+		h.fifo = info.Mode()&os.ModeNamedPipe != 0
+	}
+
+	go h.run()
+	return h, nil
+}
+
+func (h *streamHandle) name() string {
+	if h.listener != nil {
+		return h.listener.Addr().String()
+	}
+	if h.cfg.Source == "-" {
+		return "stdin"
+	}
+	return h.cfg.Source
+}
+
+// connect waits for the next stream.
+func (h *streamHandle) connect() (io.ReadCloser, error) {
+	switch src := h.cfg.Source; {
+	case h.listener != nil:
+		conn, err := h.listener.Accept()
+		if err != nil {
+			return nil, err
+		}
+		logp.Info("Accepted pcap stream from %s", conn.RemoteAddr())
+		return conn, nil
+	case src == "-":
+		return os.Stdin, nil
+	case strings.HasPrefix(src, "tcp://"):
+		return net.DialTimeout("tcp", strings.TrimPrefix(src, "tcp://"), streamDialTimeout)
+	default:
+		// blocks until a writer opens the named pipe
+		return os.Open(src)
+	}
+}
+
+func (h *streamHandle) run() {
+	backoff := h.cfg.Backoff
+	for {
+		src, err := h.connect()
+		if err == nil {
+			if !h.setSource(src) {
+				src.Close()
+				return
+			}
+			err = h.readStream(src)
+			h.setSource(nil)
+			src.Close()
+		}
+		if h.closed() {
+			return
+		}
+
+		if !h.cfg.ReconnectEnabled() {
+			if err == nil {
+				err = io.EOF
+			}
+			h.send(streamPacket{err: err})
+			return
+		}
+
+		if err != nil {
+			logp.Warn("Reading pcap stream from %s failed, retrying in %v: %v", h.name(), backoff, err)
+		} else {
+			logp.Info("Pcap stream from %s ended, waiting for the next stream", h.name())
+			backoff = h.cfg.Backoff
+		}
+		// new connections are accepted right away, other streams are
+		// reopened after the backoff, increased after each failure
+		if h.listener == nil {
+			select {
+			case <-h.done:
+				return
+			case <-time.After(backoff):
+			}
+		}
+		if err != nil {
+			backoff *= 2
+			if backoff > h.cfg.MaxBackoff {
+				backoff = h.cfg.MaxBackoff
+			}
+		}
+	}
+}
+
+// readStream forwards the packets of a stream until it ends. A stream ending
+// before any header has been read is not an error.
+func (h *streamHandle) readStream(src io.Reader) error {
+	r, err := newStreamReader(src)
+	if err != nil {
+		if err == io.EOF {
+			return nil
+		}
+		return err
+	}
+	defer r.Close()
+
+	h.interfaces.reset()
+	for {
+		data, ci, err := r.ReadPacketData()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+
+		ci.InterfaceIndex = h.interfaces.lookup(r, ci.InterfaceIndex)
+		if !h.send(streamPacket{data: data, ci: ci}) {
+			return nil
+		}
+	}
+}
+
+// setSource sets the stream read, such that Close can interrupt reading.
+// It returns false if the handle has been closed.
+func (h *streamHandle) setSource(src io.Closer) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if src != nil && h.closed() {
+		return false
+	}
+	h.src = src
+	return true
+}
+
+func (h *streamHandle) send(p streamPacket) bool {
+	select {
+	case h.packets <- p:
+		return true
+	case <-h.done:
+		return false
+	}
+}
+
+func (h *streamHandle) closed() bool {
+	select {
+	case <-h.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// ReadPacketData returns the next packet. If no packet is received within
+// streamTimeout, pcap.NextErrorTimeoutExpired is returned.
+func (h *streamHandle) ReadPacketData() ([]byte, gopacket_dpdk.CaptureInfo, error) {
+	var p streamPacket
+	select {
+	case p = <-h.packets:
+	default:
+		timer := time.NewTimer(streamTimeout)
+		defer timer.Stop()
+		select {
+		case p = <-h.packets:
+		case <-timer.C:
+			return nil, p.ci, pcap.NextErrorTimeoutExpired
+		}
+	}
+	return p.data, p.ci, p.err
+}
+
+// LinkType returns the link type of the first interface seen, Ethernet if
+// no packet has been read yet.
+func (h *streamHandle) LinkType() layers.LinkType {
+	if iface, ok := h.interfaces.Interface(0); ok {
+		return iface.LinkType
+	}
+	return layers.LinkTypeEthernet
+}
+
+// HasInterfaces returns true, as streams can have different link types.
+func (h *streamHandle) HasInterfaces() bool {
+	return true
+}
+
+// Interface returns the interface of the given CaptureInfo.InterfaceIndex.
+func (h *streamHandle) Interface(index int) (Interface, bool) {
+	return h.interfaces.Interface(index)
+}
+
+func (h *streamHandle) Close() {
+	h.once.Do(func() {
+		close(h.done)
+		if h.listener != nil {
+			h.listener.Close()
+		}
+
+		h.mu.Lock()
+		if h.src != nil {
+			h.src.Close()
+		}
+		h.mu.Unlock()
+
+		// a reader blocked opening a named pipe returns once a writer
+		// opens it
+		if h.fifo {
+			if f, err := os.OpenFile(h.cfg.Source, os.O_WRONLY|syscall.O_NONBLOCK, 0); err == nil {
+				f.Close()
+			}
+		}
+	})
+}
@@ -0,0 +1,217 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !integration
+// +build !integration
+
+package sniffer
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/njcx/gopacket_dpdk"
+	"github.com/njcx/gopacket_dpdk/layers"
+	"github.com/njcx/gopacket_dpdk/pcap"
+
+	"github.com/njcx/packetbeat7_dpdk/config"
+)
+
+// pcapStream returns a libpcap stream holding one packet per timestamp. The
+// packet data is the timestamp in seconds.
+func pcapStream(order binary.ByteOrder, magic uint32, linkType layers.LinkType, ts ...uint32) []byte {
+	var buf bytes.Buffer
+	binary.Write(&buf, order, magic)
+	binary.Write(&buf, order, uint16(2))
+	binary.Write(&buf, order, uint16(4))
+	binary.Write(&buf, order, int32(0))
+	binary.Write(&buf, order, uint32(0))
+	binary.Write(&buf, order, uint32(65535))
+	binary.Write(&buf, order, uint32(linkType))
+	for _, sec := range ts {
+		binary.Write(&buf, order, sec)
+		binary.Write(&buf, order, uint32(250))
+		binary.Write(&buf, order, uint32(1))
+		binary.Write(&buf, order, uint32(60))
+		buf.WriteByte(byte(sec))
+	}
+	return buf.Bytes()
+}
+
+func TestPcapStreamReader(t *testing.T) {
+	tests := map[string]struct {
+		order binary.ByteOrder
+		magic uint32
+		nsec  int64
+	}{
+		"little endian micros": {binary.LittleEndian, pcapMagicMicros, 250000},
+		"big endian micros":    {binary.BigEndian, pcapMagicMicros, 250000},
+		"little endian nanos":  {binary.LittleEndian, pcapMagicNanos, 250},
+		"big endian nanos":     {binary.BigEndian, pcapMagicNanos, 250},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			r, err := newStreamReader(bytes.NewReader(pcapStream(test.order, test.magic, layers.LinkTypeLinuxSLL, 1, 2)))
+			require.NoError(t, err)
+			assert.Equal(t, layers.LinkTypeLinuxSLL, r.LinkType())
+
+			for _, sec := range []int64{1, 2} {
+				data, ci, err := r.ReadPacketData()
+				require.NoError(t, err)
+				assert.Equal(t, []byte{byte(sec)}, data)
+				assert.Equal(t, time.Unix(sec, test.nsec), ci.Timestamp)
+				assert.Equal(t, 1, ci.CaptureLength)
+				assert.Equal(t, 60, ci.Length)
+			}
+			_, _, err = r.ReadPacketData()
+			assert.Equal(t, io.EOF, err)
+		})
+	}
+}
+
+func TestPcapStreamReader_invalid(t *testing.T) {
+	_, err := newStreamReader(bytes.NewReader(nil))
+	assert.Equal(t, io.EOF, err)
+
+	_, err = newStreamReader(bytes.NewReader([]byte("GET / HTTP/1.1\r\n\r\n        ")))
+	assert.Error(t, err)
+
+	// truncated packet
+	stream := pcapStream(binary.LittleEndian, pcapMagicMicros, layers.LinkTypeEthernet, 1)
+	r, err := newStreamReader(bytes.NewReader(stream[:len(stream)-1]))
+	require.NoError(t, err)
+	_, _, err = r.ReadPacketData()
+	assert.Error(t, err)
+	assert.NotEqual(t, io.EOF, err)
+}
+
+// readStreamPackets reads n packets from h, skipping timeouts.
+func readStreamPackets(t *testing.T, h *streamHandle, n int) (data []byte, ci []gopacket_dpdk.CaptureInfo) {
+	deadline := time.Now().Add(10 * time.Second)
+	for len(data) < n && time.Now().Before(deadline) {
+		d, c, err := h.ReadPacketData()
+		if err == pcap.NextErrorTimeoutExpired {
+			continue
+		}
+		require.NoError(t, err)
+		data = append(data, d...)
+		ci = append(ci, c)
+	}
+	require.Len(t, data, n)
+	return data, ci
+}
+
+func TestStreamHandle_listen(t *testing.T) {
+	h, err := openStream(&config.InterfacesConfig{Stream: config.StreamConfig{Listen: "127.0.0.1:0"}})
+	require.NoError(t, err)
+	defer h.Close()
+
+	send := func(stream []byte) {
+		conn, err := net.Dial("tcp", h.listener.Addr().String())
+		require.NoError(t, err)
+		_, err = conn.Write(stream)
+		require.NoError(t, err)
+		conn.Close()
+	}
+
+	send(pcapStream(binary.LittleEndian, pcapMagicMicros, layers.LinkTypeEthernet, 1, 2))
+	data, ci := readStreamPackets(t, h, 2)
+	assert.Equal(t, []byte{1, 2}, data)
+	assert.Equal(t, 0, ci[0].InterfaceIndex)
+
+	// the next stream is read after the first one ended, streams with
+	// another link type are reported as another interface
+	send(pcapStream(binary.BigEndian, pcapMagicNanos, layers.LinkTypeLinuxSLL, 3))
+	send(pcapStream(binary.BigEndian, pcapMagicNanos, layers.LinkTypeEthernet, 4))
+	data, ci = readStreamPackets(t, h, 2)
+	assert.Equal(t, []byte{3, 4}, data)
+	assert.Equal(t, 1, ci[0].InterfaceIndex)
+	assert.Equal(t, 0, ci[1].InterfaceIndex)
+
+	iface, ok := h.Interface(1)
+	assert.True(t, ok)
+	assert.Equal(t, layers.LinkTypeLinuxSLL, iface.LinkType)
+	assert.Equal(t, layers.LinkTypeEthernet, h.LinkType())
+
+	// closing the handle stops accepting streams
+	h.Close()
+	_, err = net.DialTimeout("tcp", h.listener.Addr().String(), time.Second)
+	assert.Error(t, err)
+}
+
+func TestStreamHandle_connect(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		conn.Write(pcapStream(binary.LittleEndian, pcapMagicMicros, layers.LinkTypeEthernet, 1, 2, 3))
+		conn.Close()
+	}()
+
+	reconnect := false
+	cfg := &config.InterfacesConfig{Stream: config.StreamConfig{
+		Source:    "tcp://" + l.Addr().String(),
+		Reconnect: &reconnect,
+	}}
+	setStreamDefaults(&cfg.Stream)
+	require.NoError(t, validateStreamConfig(cfg))
+	h, err := openStream(cfg)
+	require.NoError(t, err)
+	defer h.Close()
+
+	data, _ := readStreamPackets(t, h, 3)
+	assert.Equal(t, []byte{1, 2, 3}, data)
+
+	// without reconnecting the end of the stream is the end of the sniffer
+	for {
+		_, _, err = h.ReadPacketData()
+		if err != pcap.NextErrorTimeoutExpired {
+			break
+		}
+	}
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestValidateStreamConfig(t *testing.T) {
+	for _, s := range []config.StreamConfig{
+		{},
+		{Source: "-", Listen: ":57012"},
+		{Listen: "57012"},
+		{Source: "tcp://localhost"},
+	} {
+		assert.Error(t, validateStreamConfig(&config.InterfacesConfig{Stream: s}), "%+v", s)
+	}
+	for _, s := range []config.StreamConfig{
+		{Source: "-"},
+		{Source: "/var/run/packetbeat.fifo"},
+		{Source: "tcp://tap.example.com:57012"},
+		{Listen: ":57012"},
+	} {
+		assert.NoError(t, validateStreamConfig(&config.InterfacesConfig{Stream: s}), "%+v", s)
+	}
+}